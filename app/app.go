@@ -7,6 +7,7 @@ import (
 	
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -26,11 +27,17 @@ import (
 	"github.com/tendermint/tendermint/libs/common"
 	"github.com/tendermint/tendermint/libs/log"
 	db "github.com/tendermint/tm-db"
+	"google.golang.org/grpc"
 	
 	"github.com/sentinel-official/hub/types"
 	"github.com/sentinel-official/hub/version"
 	"github.com/sentinel-official/hub/x/deposit"
+	"github.com/sentinel-official/hub/x/deposit/depositpb"
+	"github.com/sentinel-official/hub/x/upgrade"
+	upgradeclient "github.com/sentinel-official/hub/x/upgrade/client"
 	"github.com/sentinel-official/hub/x/vpn"
+	vpnclient "github.com/sentinel-official/hub/x/vpn/client"
+	"github.com/sentinel-official/hub/x/vpn/vpnpb"
 )
 
 const (
@@ -49,13 +56,18 @@ var (
 		staking.AppModuleBasic{},
 		mint.AppModuleBasic{},
 		distribution.AppModuleBasic{},
-		gov.NewAppModuleBasic(client.ProposalHandler, distribution.ProposalHandler),
+		gov.NewAppModuleBasic(client.ProposalHandler, distribution.ProposalHandler,
+			vpnclient.ChangeParamsProposalHandler, vpnclient.BlacklistProposalHandler,
+			vpnclient.MaxPricesPerGBProposalHandler, vpnclient.ExchangeRatesProposalHandler,
+			upgradeclient.SoftwareUpgradeProposalHandler,
+			upgradeclient.CancelSoftwareUpgradeProposalHandler),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
 		slashing.AppModuleBasic{},
 		supply.AppModuleBasic{},
 		deposit.AppModuleBasic{},
 		vpn.AppModuleBasic{},
+		upgrade.AppModuleBasic{},
 	)
 	
 	moduleAccountPermissions = map[string][]string{
@@ -84,6 +96,7 @@ func MakeCodec() *codec.Codec {
 type HubApp struct {
 	*baseapp.BaseApp
 	cdc *codec.Codec
+	cms store.CommitMultiStore
 	
 	invCheckPeriod uint
 	
@@ -102,6 +115,7 @@ type HubApp struct {
 	paramsKeeper       params.Keeper
 	depositKeeper      deposit.Keeper
 	vpnKeeper          vpn.Keeper
+	upgradeKeeper      upgrade.Keeper
 	
 	mm *module.Manager
 }
@@ -111,6 +125,12 @@ func NewHubApp(logger log.Logger, db db.DB, traceStore io.Writer, loadLatest boo
 	invCheckPeriod uint, baseAppOptions ...func(*baseapp.BaseApp)) *HubApp {
 	cdc := MakeCodec()
 	
+	// The multi store is created here, so that the gRPC queries can read the committed state through it
+	cms := store.NewCommitMultiStore(db)
+	baseAppOptions = append([]func(*baseapp.BaseApp){
+		func(bApp *baseapp.BaseApp) { bApp.SetCMS(cms) },
+	}, baseAppOptions...)
+	
 	bApp := baseapp.NewBaseApp(appName, logger, db, auth.DefaultTxDecoder(cdc), baseAppOptions...)
 	bApp.SetCommitMultiStoreTracer(traceStore)
 	bApp.SetAppVersion(version.Version)
@@ -120,6 +140,7 @@ func NewHubApp(logger log.Logger, db db.DB, traceStore io.Writer, loadLatest boo
 		supply.StoreKey, mint.StoreKey, distribution.StoreKey, slashing.StoreKey,
 		gov.StoreKey, params.StoreKey, deposit.StoreKey,
 		vpn.StoreKeyNode, vpn.StoreKeySubscription, vpn.StoreKeySession, vpn.StoreKeyResolver,
+		upgrade.StoreKey,
 	)
 	
 	transientKeys := sdk.NewTransientStoreKeys(staking.TStoreKey, params.TStoreKey)
@@ -127,6 +148,7 @@ func NewHubApp(logger log.Logger, db db.DB, traceStore io.Writer, loadLatest boo
 	var app = &HubApp{
 		BaseApp:        bApp,
 		cdc:            cdc,
+		cms:            cms,
 		invCheckPeriod: invCheckPeriod,
 		keys:           keys,
 		transientKeys:  transientKeys,
@@ -188,10 +210,28 @@ func NewHubApp(logger log.Logger, db db.DB, traceStore io.Writer, loadLatest boo
 		app.supplyKeeper,
 		auth.FeeCollectorName)
 	
+	app.depositKeeper = deposit.NewKeeper(app.cdc,
+		keys[deposit.StoreKey],
+		app.supplyKeeper)
+	
+	app.vpnKeeper = vpn.NewKeeper(app.cdc,
+		keys[vpn.StoreKeyNode],
+		keys[vpn.StoreKeySubscription],
+		keys[vpn.StoreKeySession],
+		keys[vpn.StoreKeyResolver],
+		app.paramsKeeper.Subspace(vpn.DefaultParamspace),
+		app.depositKeeper)
+	
+	app.upgradeKeeper = upgrade.NewKeeper(app.cdc,
+		keys[upgrade.StoreKey])
+	app.registerUpgradeHandlers()
+	
 	govRouter := gov.NewRouter()
 	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
 		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
-		AddRoute(distribution.RouterKey, distribution.NewCommunityPoolSpendProposalHandler(app.distributionKeeper))
+		AddRoute(distribution.RouterKey, distribution.NewCommunityPoolSpendProposalHandler(app.distributionKeeper)).
+		AddRoute(vpn.RouterKey, vpn.NewProposalHandler(app.vpnKeeper)).
+		AddRoute(upgrade.RouterKey, upgrade.NewProposalHandler(app.upgradeKeeper))
 	
 	app.govKeeper = gov.NewKeeper(app.cdc,
 		keys[gov.StoreKey],
@@ -205,18 +245,6 @@ func NewHubApp(logger log.Logger, db db.DB, traceStore io.Writer, loadLatest boo
 	app.stakingKeeper = *stakingKeeper.SetHooks(
 		staking.NewMultiStakingHooks(app.distributionKeeper.Hooks(), app.slashingKeeper.Hooks()))
 	
-	app.depositKeeper = deposit.NewKeeper(app.cdc,
-		keys[deposit.StoreKey],
-		app.supplyKeeper)
-	
-	app.vpnKeeper = vpn.NewKeeper(app.cdc,
-		keys[vpn.StoreKeyNode],
-		keys[vpn.StoreKeySubscription],
-		keys[vpn.StoreKeySession],
-		keys[vpn.StoreKeyResolver],
-		app.paramsKeeper.Subspace(vpn.DefaultParamspace),
-		app.depositKeeper)
-	
 	app.mm = module.NewManager(
 		genaccounts.NewAppModule(app.accountKeeper),
 		genutil.NewAppModule(app.accountKeeper, app.stakingKeeper, app.BaseApp.DeliverTx),
@@ -231,15 +259,18 @@ func NewHubApp(logger log.Logger, db db.DB, traceStore io.Writer, loadLatest boo
 		staking.NewAppModule(app.stakingKeeper, app.distributionKeeper, app.accountKeeper, app.supplyKeeper),
 		deposit.NewAppModule(app.depositKeeper),
 		vpn.NewAppModule(app.vpnKeeper),
+		upgrade.NewAppModule(app.upgradeKeeper),
 	)
 	
-	app.mm.SetOrderBeginBlockers(mint.ModuleName, distribution.ModuleName, slashing.ModuleName)
+	app.mm.SetOrderBeginBlockers(upgrade.ModuleName, mint.ModuleName, distribution.ModuleName, slashing.ModuleName)
 	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName, vpn.ModuleName)
 	app.mm.SetOrderInitGenesis(
 		genaccounts.ModuleName, distribution.ModuleName, staking.ModuleName,
 		auth.ModuleName, bank.ModuleName, slashing.ModuleName, gov.ModuleName,
-		mint.ModuleName, supply.ModuleName, crisis.ModuleName, genutil.ModuleName,
-		deposit.ModuleName, vpn.ModuleName,
+		mint.ModuleName, supply.ModuleName, genutil.ModuleName,
+		deposit.ModuleName, vpn.ModuleName, upgrade.ModuleName,
+		// crisis needs to be last so that the genesis invariants are run against a fully initialized state
+		crisis.ModuleName,
 	)
 	
 	app.mm.RegisterInvariants(&app.crisisKeeper)
@@ -289,3 +320,20 @@ func (app *HubApp) ModuleAccountAddrs() map[string]bool {
 	
 	return moduleAccounts
 }
+
+// QueryContext returns a read-only context over the latest committed state, which is used to serve the gRPC queries
+func (app *HubApp) QueryContext() (sdk.Context, error) {
+	height := app.LastBlockHeight()
+	
+	ms, err := app.cms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return sdk.Context{}, err
+	}
+	
+	return sdk.NewContext(ms, abci.Header{Height: height}, true, app.Logger()), nil
+}
+
+func (app *HubApp) RegisterGRPCServer(server *grpc.Server) {
+	vpnpb.RegisterQueryServiceServer(server, vpn.NewGRPCServer(app.vpnKeeper, app.QueryContext))
+	depositpb.RegisterQueryServiceServer(server, deposit.NewGRPCServer(app.depositKeeper, app.QueryContext))
+}
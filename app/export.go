@@ -10,6 +10,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tm "github.com/tendermint/tendermint/types"
+	
+	"github.com/sentinel-official/hub/x/vpn"
 )
 
 func (app *HubApp) ExportAppStateAndValidators(forZeroHeight bool,
@@ -129,4 +131,6 @@ func (app *HubApp) prepForZeroHeightGenesis(
 			return false
 		},
 	)
+	
+	vpn.PrepForZeroHeightGenesis(ctx, app.vpnKeeper)
 }
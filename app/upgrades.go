@@ -0,0 +1,18 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/sentinel-official/hub/x/upgrade"
+	"github.com/sentinel-official/hub/x/vpn"
+)
+
+// UpgradeName is the name of the upgrade plan which this binary performs. The chain halts at the height of a
+// plan with any other name until it is restarted with the binary which performs that plan.
+const UpgradeName = "v0.2.0"
+
+func (app *HubApp) registerUpgradeHandlers() {
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeName, func(ctx sdk.Context, _ upgrade.Plan) error {
+		return vpn.RunMigrations(ctx, app.vpnKeeper, vpn.Migrations)
+	})
+}
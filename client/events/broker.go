@@ -0,0 +1,177 @@
+package events
+
+import (
+	"context"
+	"sync"
+	
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tm "github.com/tendermint/tendermint/types"
+)
+
+const (
+	subscriber         = "hub-events"
+	upstreamBufferSize = 100
+	clientBufferSize   = 64
+)
+
+// upstream is the part of the Tendermint RPC client which the broker subscribes with
+type upstream interface {
+	Start() error
+	Stop() error
+	Subscribe(ctx context.Context, subscriber, query string, outCapacity ...int) (<-chan ctypes.ResultEvent, error)
+}
+
+// broker holds a single subscription to the new transactions and block headers of the Tendermint RPC, and
+// fans out their events to the connected clients. The subscription is opened with the first client and closed
+// with the last one. A client which does not keep up with the events is dropped, instead of holding back the
+// others.
+type broker struct {
+	newUpstream func() upstream
+	
+	mtx     sync.Mutex
+	client  upstream
+	stop    chan struct{}
+	clients map[chan []Event]bool
+}
+
+func newBroker(nodeURI string) *broker {
+	return &broker{
+		newUpstream: func() upstream {
+			return rpcclient.NewHTTP(nodeURI, "/websocket")
+		},
+		clients: make(map[chan []Event]bool),
+	}
+}
+
+// subscribe returns the channel of the events for a new client and the function which removes the client.
+// The channel is closed when the client is removed or dropped, or the upstream subscription ends.
+func (b *broker) subscribe() (<-chan []Event, func(), error) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	
+	if b.client == nil {
+		if err := b.start(); err != nil {
+			return nil, nil, err
+		}
+	}
+	
+	ch := make(chan []Event, clientBufferSize)
+	b.clients[ch] = true
+	
+	return ch, func() { b.unsubscribe(ch) }, nil
+}
+
+func (b *broker) unsubscribe(ch chan []Event) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	
+	if b.clients[ch] {
+		delete(b.clients, ch)
+		close(ch)
+	}
+	
+	if len(b.clients) == 0 && b.client != nil {
+		b.shutdown()
+	}
+}
+
+// start opens the upstream subscription, the lock must be held by the caller
+func (b *broker) start() error {
+	client := b.newUpstream()
+	if err := client.Start(); err != nil {
+		return err
+	}
+	
+	txs, err := client.Subscribe(context.Background(), subscriber, tm.EventQueryTx.String(), upstreamBufferSize)
+	if err != nil {
+		_ = client.Stop()
+		return err
+	}
+	
+	blocks, err := client.Subscribe(context.Background(), subscriber, tm.EventQueryNewBlockHeader.String(),
+		upstreamBufferSize)
+	if err != nil {
+		_ = client.Stop()
+		return err
+	}
+	
+	b.client = client
+	b.stop = make(chan struct{})
+	go b.run(txs, blocks, b.stop)
+	
+	return nil
+}
+
+// shutdown closes the upstream subscription and the channels of the remaining clients, the lock must be held
+// by the caller
+func (b *broker) shutdown() {
+	close(b.stop)
+	_ = b.client.Stop()
+	
+	for ch := range b.clients {
+		delete(b.clients, ch)
+		close(ch)
+	}
+	
+	b.client = nil
+	b.stop = nil
+}
+
+func (b *broker) run(txs, blocks <-chan ctypes.ResultEvent, stop chan struct{}) {
+	for {
+		var items []Event
+		select {
+		case <-stop:
+			return
+		case result, ok := <-txs:
+			if !ok {
+				b.end(stop)
+				return
+			}
+			if data, ok := result.Data.(tm.EventDataTx); ok {
+				items = NewEventsFromTx(data)
+			}
+		case result, ok := <-blocks:
+			if !ok {
+				b.end(stop)
+				return
+			}
+			if data, ok := result.Data.(tm.EventDataNewBlockHeader); ok {
+				items = NewEventsFromBlockHeader(data)
+			}
+		}
+		
+		if len(items) > 0 {
+			b.broadcast(items, stop)
+		}
+	}
+}
+
+func (b *broker) broadcast(items []Event, stop chan struct{}) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	
+	if b.stop != stop {
+		return
+	}
+	
+	for ch := range b.clients {
+		select {
+		case ch <- items:
+		default:
+			delete(b.clients, ch)
+			close(ch)
+		}
+	}
+}
+
+// end shuts down the broker after the upstream subscription ended, so the next client opens a new one
+func (b *broker) end(stop chan struct{}) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	
+	if b.stop == stop {
+		b.shutdown()
+	}
+}
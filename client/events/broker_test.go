@@ -0,0 +1,143 @@
+package events
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tm "github.com/tendermint/tendermint/types"
+	
+	hub "github.com/sentinel-official/hub/types"
+	vpn "github.com/sentinel-official/hub/x/vpn/types"
+)
+
+type testUpstream struct {
+	started bool
+	subs    map[string]chan ctypes.ResultEvent
+}
+
+func (u *testUpstream) Start() error {
+	u.started = true
+	return nil
+}
+
+func (u *testUpstream) Stop() error {
+	u.started = false
+	return nil
+}
+
+func (u *testUpstream) Subscribe(_ context.Context, _, query string, _ ...int) (<-chan ctypes.ResultEvent, error) {
+	ch := make(chan ctypes.ResultEvent, 1)
+	u.subs[query] = ch
+	
+	return ch, nil
+}
+
+func testBlockHeaderEvent(height int64) ctypes.ResultEvent {
+	return ctypes.ResultEvent{Data: tm.EventDataNewBlockHeader{
+		Header: tm.Header{Height: height},
+		ResultEndBlock: abci.ResponseEndBlock{Events: sdk.Events{
+			sdk.NewEvent(vpn.EventTypeSettleSession,
+				sdk.NewAttribute(vpn.AttributeSessionID, hub.NewSessionID(0).String()),
+			),
+		}.ToABCIEvents()},
+	}}
+}
+
+func TestBroker(t *testing.T) {
+	var upstreams []*testUpstream
+	b := &broker{
+		newUpstream: func() upstream {
+			u := &testUpstream{subs: make(map[string]chan ctypes.ResultEvent)}
+			upstreams = append(upstreams, u)
+			return u
+		},
+		clients: make(map[chan []Event]bool),
+	}
+	
+	events1, unsubscribe1, err := b.subscribe()
+	require.Nil(t, err)
+	events2, unsubscribe2, err := b.subscribe()
+	require.Nil(t, err)
+	require.Len(t, upstreams, 1)
+	require.True(t, upstreams[0].started)
+	require.Len(t, upstreams[0].subs, 2)
+	
+	upstreams[0].subs[tm.EventQueryNewBlockHeader.String()] <- testBlockHeaderEvent(10)
+	items := <-events1
+	require.Len(t, items, 1)
+	require.Equal(t, int64(10), items[0].Height)
+	require.Equal(t, items, <-events2)
+	
+	unsubscribe1()
+	_, ok := <-events1
+	require.False(t, ok)
+	require.True(t, upstreams[0].started)
+	
+	unsubscribe2()
+	_, ok = <-events2
+	require.False(t, ok)
+	require.False(t, upstreams[0].started)
+	unsubscribe2()
+	
+	events3, unsubscribe3, err := b.subscribe()
+	require.Nil(t, err)
+	require.Len(t, upstreams, 2)
+	
+	close(upstreams[1].subs[tm.EventQueryTx.String()])
+	_, ok = <-events3
+	require.False(t, ok)
+	require.False(t, upstreams[1].started)
+	unsubscribe3()
+	
+	_, unsubscribe4, err := b.subscribe()
+	require.Nil(t, err)
+	require.Len(t, upstreams, 3)
+	require.True(t, upstreams[2].started)
+	unsubscribe4()
+}
+
+func TestBroker_SlowClient(t *testing.T) {
+	b := &broker{
+		clients: make(map[chan []Event]bool),
+		stop:    make(chan struct{}),
+	}
+	
+	slow := make(chan []Event)
+	fast := make(chan []Event, 1)
+	b.clients[slow] = true
+	b.clients[fast] = true
+	
+	b.broadcast([]Event{{Height: 10}}, b.stop)
+	require.Equal(t, []Event{{Height: 10}}, <-fast)
+	require.False(t, b.clients[slow])
+	require.True(t, b.clients[fast])
+	
+	_, ok := <-slow
+	require.False(t, ok)
+}
+
+func TestNewOriginChecker(t *testing.T) {
+	check := newOriginChecker([]string{"https://explorer.sentinel.co/"})
+	
+	r := httptest.NewRequest("GET", "http://localhost:1317/events", nil)
+	require.True(t, check(r))
+	
+	r.Header.Set("Origin", "http://localhost:1317")
+	require.True(t, check(r))
+	
+	r.Header.Set("Origin", "https://explorer.sentinel.co")
+	require.True(t, check(r))
+	
+	r.Header.Set("Origin", "https://example.com")
+	require.False(t, check(r))
+	
+	r.Header.Set("Origin", "://invalid")
+	require.False(t, check(r))
+	
+	require.False(t, newOriginChecker(nil)(r))
+}
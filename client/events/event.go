@@ -0,0 +1,137 @@
+package events
+
+import (
+	"fmt"
+	
+	abci "github.com/tendermint/tendermint/abci/types"
+	tm "github.com/tendermint/tendermint/types"
+	
+	deposit "github.com/sentinel-official/hub/x/deposit/types"
+	vpn "github.com/sentinel-official/hub/x/vpn/types"
+)
+
+var (
+	moduleOfEventType = map[string]string{}
+	
+	addressAttributeKeys = map[string]bool{
+		vpn.AttributeKeyClientAddress: true,
+		vpn.AttributeKeyFromAddress:   true,
+		vpn.AttributeKeyPayer:         true,
+		vpn.AttributeKeyNodePayee:     true,
+		vpn.AttributeKeyResolverPayee: true,
+		vpn.AttributeKeyVictim:        true,
+		deposit.AttributeKeyAddress:   true,
+		deposit.AttributeKeyToAddress: true,
+	}
+)
+
+func init() {
+	for _, _type := range []string{
+		vpn.EventTypeMsgRegisterNode, vpn.EventTypeMsgUpdateNodeInfo, vpn.EventTypeMsgUpdateNodePlans,
+		vpn.EventTypeMsgDeregisterNode, vpn.EventTypeMsgNodeHeartbeat, vpn.EventTypeInactivateNode,
+		vpn.EventTypeMsgTopUpNodeDeposit, vpn.EventTypeCompleteNodeUnbonding,
+		vpn.EventTypeMsgAddFreeClient, vpn.EventTypeMsgRemoveFreeClient, vpn.EventTypeFreeClientExhausted,
+		vpn.EventTypeFreeClientExpired,
+		vpn.EventTypeMsgRegisterVPNOnResolver, vpn.EventTypeMsgDeregisterVPNOnResolver,
+		vpn.EventTypeMsgAcceptVPNOnResolver, vpn.EventTypeMsgRejectVPNOnResolver,
+		vpn.EventTypeMsgStartSubscription, vpn.EventTypeMsgEndSubscription,
+		vpn.EventTypeMsgStartSession, vpn.EventTypeMsgUpdateSessionInfo, vpn.EventTypeMsgEndSession,
+		vpn.EventTypeMsgSubmitEvidence,
+		vpn.EventTypeMsgOpenChannel, vpn.EventTypeMsgRedeemVoucher, vpn.EventTypeMsgCloseChannel,
+		vpn.EventTypeSettleSession, vpn.EventTypeSettleSubscription, vpn.EventTypeSlashNode,
+		vpn.EventTypeMsgRegisterResolver, vpn.EventTypeMsgUpdateResolverInfo, vpn.EventTypeMsgDeregisterResolver,
+		vpn.EventTypeCompleteResolverUnbonding,
+		vpn.EventTypeChangeParams, vpn.EventTypeBlacklistNode, vpn.EventTypeBlacklistResolver,
+		vpn.EventTypeChangeMaxPricesPerGB, vpn.EventTypeChangeExchangeRates, vpn.EventTypeMigrateStore,
+	} {
+		moduleOfEventType[_type] = vpn.ModuleName
+	}
+	
+	for _, _type := range []string{
+		deposit.EventTypeAddDeposit, deposit.EventTypeSubtractDeposit,
+		deposit.EventTypeSendFromDeposit, deposit.EventTypeReceiveDeposit,
+		deposit.EventTypeLockDeposit, deposit.EventTypeUnlockDeposit,
+		deposit.EventTypeMsgDeposit, deposit.EventTypeMsgWithdraw,
+	} {
+		moduleOfEventType[_type] = deposit.ModuleName
+	}
+}
+
+// Event is an event of the vpn or deposit module, decoded from the result of a transaction or a block
+type Event struct {
+	Module         string            `json:"module"`
+	Type           string            `json:"type"`
+	Height         int64             `json:"height"`
+	TxHash         string            `json:"tx_hash,omitempty"`
+	NodeID         string            `json:"node_id,omitempty"`
+	SubscriptionID string            `json:"subscription_id,omitempty"`
+	SessionID      string            `json:"session_id,omitempty"`
+	ChannelID      string            `json:"channel_id,omitempty"`
+	ResolverID     string            `json:"resolver_id,omitempty"`
+	Addresses      []string          `json:"addresses,omitempty"`
+	Attributes     map[string]string `json:"attributes"`
+}
+
+// NewEvent decodes the ABCI event, and returns false if it is not an event of the vpn or deposit module
+func NewEvent(height int64, txHash string, event abci.Event) (Event, bool) {
+	module, ok := moduleOfEventType[event.Type]
+	if !ok {
+		return Event{}, false
+	}
+	
+	e := Event{
+		Module:     module,
+		Type:       event.Type,
+		Height:     height,
+		TxHash:     txHash,
+		Attributes: make(map[string]string, len(event.Attributes)),
+	}
+	
+	for _, attribute := range event.Attributes {
+		key, value := string(attribute.Key), string(attribute.Value)
+		e.Attributes[key] = value
+		
+		switch {
+		case key == vpn.AttributeKeyNodeID:
+			e.NodeID = value
+		case key == vpn.AttributeSubscriptionID:
+			e.SubscriptionID = value
+		case key == vpn.AttributeSessionID:
+			e.SessionID = value
+		case key == vpn.AttributeKeyChannelID:
+			e.ChannelID = value
+		case key == vpn.AttributeKeyResolverID:
+			e.ResolverID = value
+		case addressAttributeKeys[key] && value != "":
+			e.Addresses = append(e.Addresses, value)
+		}
+	}
+	
+	return e, true
+}
+
+func newEvents(height int64, txHash string, events []abci.Event) []Event {
+	var items []Event
+	for _, event := range events {
+		if e, ok := NewEvent(height, txHash, event); ok {
+			items = append(items, e)
+		}
+	}
+	
+	return items
+}
+
+// NewEventsFromTx returns the vpn and deposit events of a successful transaction
+func NewEventsFromTx(data tm.EventDataTx) []Event {
+	if !data.Result.IsOK() {
+		return nil
+	}
+	
+	return newEvents(data.Height, fmt.Sprintf("%X", tm.Tx(data.Tx).Hash()), data.Result.Events)
+}
+
+// NewEventsFromBlockHeader returns the vpn and deposit events emitted by the begin and end blockers
+func NewEventsFromBlockHeader(data tm.EventDataNewBlockHeader) []Event {
+	return append(newEvents(data.Header.Height, "", data.ResultBeginBlock.Events),
+		newEvents(data.Header.Height, "", data.ResultEndBlock.Events)...)
+}
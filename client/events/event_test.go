@@ -0,0 +1,81 @@
+package events
+
+import (
+	"testing"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tm "github.com/tendermint/tendermint/types"
+	
+	hub "github.com/sentinel-official/hub/types"
+	deposit "github.com/sentinel-official/hub/x/deposit/types"
+	vpn "github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func TestNewEventsFromTx(t *testing.T) {
+	events := sdk.Events{
+		sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeySender, vpn.TestAddress2.String())),
+		sdk.NewEvent(vpn.EventTypeMsgStartSubscription,
+			sdk.NewAttribute(vpn.AttributeSubscriptionID, hub.NewSubscriptionID(0).String()),
+			sdk.NewAttribute(vpn.AttributeKeyNodeID, hub.NewNodeID(0).String()),
+			sdk.NewAttribute(vpn.AttributeKeyFromAddress, vpn.TestAddress2.String()),
+			sdk.NewAttribute(vpn.AttributeKeyDeposit, "100stake"),
+		),
+		sdk.NewEvent(deposit.EventTypeAddDeposit,
+			sdk.NewAttribute(deposit.AttributeKeyAddress, vpn.TestAddress2.String()),
+			sdk.NewAttribute(deposit.AttributeKeyAmount, "100stake"),
+		),
+	}
+	
+	data := tm.EventDataTx{TxResult: tm.TxResult{
+		Height: 10,
+		Tx:     tm.Tx("tx"),
+		Result: abci.ResponseDeliverTx{Events: events.ToABCIEvents()},
+	}}
+	
+	items := NewEventsFromTx(data)
+	require.Len(t, items, 2)
+	require.Equal(t, Event{
+		Module:         vpn.ModuleName,
+		Type:           vpn.EventTypeMsgStartSubscription,
+		Height:         10,
+		TxHash:         "1B5B9CCB3E8D006A5230DE9BDA23FF91EDC794D4F56410560830B418528E446C",
+		NodeID:         hub.NewNodeID(0).String(),
+		SubscriptionID: hub.NewSubscriptionID(0).String(),
+		Addresses:      []string{vpn.TestAddress2.String()},
+		Attributes: map[string]string{
+			vpn.AttributeSubscriptionID: hub.NewSubscriptionID(0).String(),
+			vpn.AttributeKeyNodeID:      hub.NewNodeID(0).String(),
+			vpn.AttributeKeyFromAddress: vpn.TestAddress2.String(),
+			vpn.AttributeKeyDeposit:     "100stake",
+		},
+	}, items[0])
+	require.Equal(t, deposit.ModuleName, items[1].Module)
+	require.Equal(t, []string{vpn.TestAddress2.String()}, items[1].Addresses)
+	
+	data.Result.Code = 1
+	require.Len(t, NewEventsFromTx(data), 0)
+}
+
+func TestNewEventsFromBlockHeader(t *testing.T) {
+	data := tm.EventDataNewBlockHeader{
+		Header: tm.Header{Height: 20},
+		ResultEndBlock: abci.ResponseEndBlock{Events: sdk.Events{
+			sdk.NewEvent(vpn.EventTypeSettleSession,
+				sdk.NewAttribute(vpn.AttributeSessionID, hub.NewSessionID(0).String()),
+				sdk.NewAttribute(vpn.AttributeSubscriptionID, hub.NewSubscriptionID(0).String()),
+				sdk.NewAttribute(vpn.AttributeKeyPayer, vpn.TestAddress2.String()),
+				sdk.NewAttribute(vpn.AttributeKeyNodePayee, vpn.TestAddress1.String()),
+			),
+			sdk.NewEvent("transfer"),
+		}.ToABCIEvents()},
+	}
+	
+	items := NewEventsFromBlockHeader(data)
+	require.Len(t, items, 1)
+	require.Equal(t, int64(20), items[0].Height)
+	require.Equal(t, "", items[0].TxHash)
+	require.Equal(t, hub.NewSessionID(0).String(), items[0].SessionID)
+	require.Equal(t, []string{vpn.TestAddress2.String(), vpn.TestAddress1.String()}, items[0].Addresses)
+}
@@ -0,0 +1,70 @@
+package events
+
+import (
+	"net/http"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	hub "github.com/sentinel-official/hub/types"
+)
+
+// Filter selects the events which are pushed to a client. An empty field matches every event.
+type Filter struct {
+	NodeID         string
+	SubscriptionID string
+	Address        string
+}
+
+// NewFilterFromRequest reads the filter from the node_id, subscription_id and address query parameters
+func NewFilterFromRequest(r *http.Request) (filter Filter, err error) {
+	query := r.URL.Query()
+	
+	if s := query.Get("node_id"); s != "" {
+		id, err := hub.NewNodeIDFromString(s)
+		if err != nil {
+			return filter, err
+		}
+		
+		filter.NodeID = id.String()
+	}
+	
+	if s := query.Get("subscription_id"); s != "" {
+		id, err := hub.NewSubscriptionIDFromString(s)
+		if err != nil {
+			return filter, err
+		}
+		
+		filter.SubscriptionID = id.String()
+	}
+	
+	if s := query.Get("address"); s != "" {
+		address, err := sdk.AccAddressFromBech32(s)
+		if err != nil {
+			return filter, err
+		}
+		
+		filter.Address = address.String()
+	}
+	
+	return filter, nil
+}
+
+func (f Filter) Match(event Event) bool {
+	if f.NodeID != "" && event.NodeID != f.NodeID {
+		return false
+	}
+	if f.SubscriptionID != "" && event.SubscriptionID != f.SubscriptionID {
+		return false
+	}
+	if f.Address != "" {
+		for _, address := range event.Addresses {
+			if address == f.Address {
+				return true
+			}
+		}
+		
+		return false
+	}
+	
+	return true
+}
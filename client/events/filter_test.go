@@ -0,0 +1,46 @@
+package events
+
+import (
+	"net/http/httptest"
+	"testing"
+	
+	"github.com/stretchr/testify/require"
+	
+	hub "github.com/sentinel-official/hub/types"
+	vpn "github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func TestNewFilterFromRequest(t *testing.T) {
+	filter, err := NewFilterFromRequest(httptest.NewRequest("GET", "/events", nil))
+	require.Nil(t, err)
+	require.Equal(t, Filter{}, filter)
+	
+	_, err = NewFilterFromRequest(httptest.NewRequest("GET", "/events?node_id=invalid", nil))
+	require.NotNil(t, err)
+	_, err = NewFilterFromRequest(httptest.NewRequest("GET", "/events?subscription_id=invalid", nil))
+	require.NotNil(t, err)
+	_, err = NewFilterFromRequest(httptest.NewRequest("GET", "/events?address=invalid", nil))
+	require.NotNil(t, err)
+	
+	filter, err = NewFilterFromRequest(httptest.NewRequest("GET",
+		"/events?node_id="+hub.NewNodeID(1).String()+"&address="+vpn.TestAddress1.String(), nil))
+	require.Nil(t, err)
+	require.Equal(t, Filter{NodeID: hub.NewNodeID(1).String(), Address: vpn.TestAddress1.String()}, filter)
+}
+
+func TestFilter_Match(t *testing.T) {
+	event := Event{
+		NodeID:         hub.NewNodeID(0).String(),
+		SubscriptionID: hub.NewSubscriptionID(0).String(),
+		Addresses:      []string{vpn.TestAddress2.String()},
+	}
+	
+	require.True(t, Filter{}.Match(event))
+	require.True(t, Filter{NodeID: hub.NewNodeID(0).String()}.Match(event))
+	require.False(t, Filter{NodeID: hub.NewNodeID(1).String()}.Match(event))
+	require.True(t, Filter{SubscriptionID: hub.NewSubscriptionID(0).String()}.Match(event))
+	require.False(t, Filter{SubscriptionID: hub.NewSubscriptionID(1).String()}.Match(event))
+	require.True(t, Filter{Address: vpn.TestAddress2.String()}.Match(event))
+	require.False(t, Filter{Address: vpn.TestAddress1.String()}.Match(event))
+	require.False(t, Filter{NodeID: hub.NewNodeID(0).String(), Address: vpn.TestAddress1.String()}.Match(event))
+}
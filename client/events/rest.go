@@ -0,0 +1,152 @@
+package events
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+	
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	
+	"github.com/sentinel-official/hub/x/vpn/client/common"
+)
+
+const (
+	// FlagAllowedOrigins is the flag of the REST server with the origins, besides its own, of the browsers which
+	// are allowed to stream the events
+	FlagAllowedOrigins = "events-allowed-origins"
+	
+	writeTimeout = 10 * time.Second
+	pingPeriod   = 30 * time.Second
+)
+
+func RegisterRoutes(ctx cliContext.CLIContext, r *mux.Router, allowedOrigins []string) {
+	upgrader := websocket.Upgrader{
+		CheckOrigin: newOriginChecker(allowedOrigins),
+	}
+	
+	r.HandleFunc("/events", streamEventsHandlerFunc(ctx, upgrader, newBroker(ctx.NodeURI))).
+		Methods("GET")
+}
+
+// newOriginChecker allows the requests without an origin, which are not sent by browsers, the requests from
+// the origin of the server itself and the requests from the allowed origins
+func newOriginChecker(allowedOrigins []string) func(*http.Request) bool {
+	allowed := make(map[string]bool, len(allowedOrigins))
+	for _, origin := range allowedOrigins {
+		allowed[strings.ToLower(strings.TrimSuffix(origin, "/"))] = true
+	}
+	
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			return true
+		}
+		
+		u, err := url.Parse(origin)
+		if err != nil {
+			return false
+		}
+		if strings.EqualFold(u.Host, r.Host) {
+			return true
+		}
+		
+		return allowed[strings.ToLower(origin)]
+	}
+}
+
+// nodeResolver returns the node ID of the subscription of an event which carries only a subscription ID,
+// so that the session and settlement events can be filtered by the node ID
+type nodeResolver struct {
+	ctx   cliContext.CLIContext
+	cache map[string]string
+}
+
+func (n nodeResolver) resolve(event *Event) {
+	if event.NodeID != "" || event.SubscriptionID == "" {
+		return
+	}
+	
+	if id, ok := n.cache[event.SubscriptionID]; ok {
+		event.NodeID = id
+		return
+	}
+	
+	subscription, err := common.QuerySubscription(n.ctx, event.SubscriptionID)
+	if err != nil {
+		return
+	}
+	
+	n.cache[event.SubscriptionID] = subscription.NodeID.String()
+	event.NodeID = subscription.NodeID.String()
+}
+
+func streamEventsHandlerFunc(ctx cliContext.CLIContext, upgrader websocket.Upgrader, b *broker) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		filter, err := NewFilterFromRequest(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		
+		events, unsubscribe, err := b.subscribe()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		defer unsubscribe()
+		
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close() // nolint:errcheck
+		
+		// The messages of the client are discarded, reading them only detects the closed connection
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for {
+				if _, _, err := conn.ReadMessage(); err != nil {
+					return
+				}
+			}
+		}()
+		
+		resolver := nodeResolver{ctx: ctx, cache: make(map[string]string)}
+		ticker := time.NewTicker(pingPeriod)
+		defer ticker.Stop()
+		
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeTimeout)); err != nil {
+					return
+				}
+			case items, ok := <-events:
+				if !ok {
+					return
+				}
+				
+				for _, item := range items {
+					if filter.NodeID != "" {
+						resolver.resolve(&item)
+					}
+					if !filter.Match(item) {
+						continue
+					}
+					
+					_ = conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+					if err := conn.WriteJSON(item); err != nil {
+						return
+					}
+				}
+			}
+		}
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/tendermint/tendermint/libs/cli"
 	
 	"github.com/sentinel-official/hub/app"
+	"github.com/sentinel-official/hub/client/events"
 	"github.com/sentinel-official/hub/simapp"
 	"github.com/sentinel-official/hub/version"
 )
@@ -48,7 +49,7 @@ func main() {
 		queryCmd(cdc),
 		txCmd(cdc),
 		client.LineBreak,
-		lcd.ServeCommand(cdc, registerRoutes),
+		serveCmd(cdc),
 		client.LineBreak,
 		keys.Commands(),
 		client.LineBreak,
@@ -113,10 +114,19 @@ func txCmd(cdc *_amino.Codec) *cobra.Command {
 	return cmd
 }
 
+func serveCmd(cdc *_amino.Codec) *cobra.Command {
+	cmd := lcd.ServeCommand(cdc, registerRoutes)
+	cmd.Flags().StringSlice(events.FlagAllowedOrigins, nil,
+		"Origins of the browsers, besides the origin of the server, which are allowed to stream the events")
+	
+	return cmd
+}
+
 func registerRoutes(rs *lcd.RestServer) {
 	client.RegisterRoutes(rs.CliCtx, rs.Mux)
 	authRest.RegisterTxRoutes(rs.CliCtx, rs.Mux)
 	app.ModuleBasics.RegisterRESTRoutes(rs.CliCtx, rs.Mux)
+	events.RegisterRoutes(rs.CliCtx, rs.Mux, viper.GetStringSlice(events.FlagAllowedOrigins))
 }
 
 func initConfig(cmd *cobra.Command) error {
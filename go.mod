@@ -4,12 +4,15 @@ go 1.13
 
 require (
 	github.com/cosmos/cosmos-sdk v0.37.4
+	github.com/golang/protobuf v1.3.2
 	github.com/gorilla/mux v1.7.3
+	github.com/gorilla/websocket v1.4.1
 	github.com/spf13/cobra v0.0.5
 	github.com/spf13/viper v1.5.0
 	github.com/stretchr/testify v1.4.0
 	github.com/tendermint/go-amino v0.15.1
 	github.com/tendermint/tendermint v0.32.8
 	github.com/tendermint/tm-db v0.2.0
+	google.golang.org/grpc v1.25.1
 	gopkg.in/yaml.v2 v2.2.7
 )
@@ -0,0 +1,62 @@
+package server
+
+import (
+	"io"
+	"net"
+	
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/viper"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	db "github.com/tendermint/tm-db"
+	"google.golang.org/grpc"
+)
+
+const (
+	flagGRPCAddress = "grpc.address"
+)
+
+// GRPCApplication is an application which serves its module queries over gRPC
+type GRPCApplication interface {
+	RegisterGRPCServer(server *grpc.Server)
+}
+
+func StartGRPCServer(app GRPCApplication, address string, logger log.Logger) (*grpc.Server, error) {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+	
+	server := grpc.NewServer()
+	app.RegisterGRPCServer(server)
+	
+	go func() {
+		logger.Info("Starting gRPC server", "address", address)
+		if err := server.Serve(listener); err != nil {
+			logger.Error("gRPC server stopped", "error", err)
+		}
+	}()
+	
+	return server, nil
+}
+
+// withGRPCServer wraps the creator to start the gRPC server of the created application
+// if the gRPC address is not empty
+func withGRPCServer(creator server.AppCreator) server.AppCreator {
+	return func(logger log.Logger, db db.DB, traceStore io.Writer) abci.Application {
+		app := creator(logger, db, traceStore)
+		
+		address := viper.GetString(flagGRPCAddress)
+		if address == "" {
+			return app
+		}
+		
+		if grpcApp, ok := app.(GRPCApplication); ok {
+			if _, err := StartGRPCServer(grpcApp, address, logger.With("module", "grpc-server")); err != nil {
+				panic(err)
+			}
+		}
+		
+		return app
+	}
+}
@@ -25,8 +25,11 @@ func AddCommands(ctx *server.Context, cdc *codec.Codec, root *cobra.Command,
 		server.VersionCmd(ctx),
 	)
 	
+	startCmd := server.StartCmd(ctx, withGRPCServer(creator))
+	startCmd.Flags().String(flagGRPCAddress, "127.0.0.1:9090", "gRPC server listen address, empty to disable")
+	
 	root.AddCommand(
-		server.StartCmd(ctx, creator),
+		startCmd,
 		server.UnsafeResetAllCmd(ctx),
 		client.LineBreak,
 		cmd,
@@ -30,6 +30,7 @@ import (
 	"github.com/sentinel-official/hub/types"
 	"github.com/sentinel-official/hub/version"
 	"github.com/sentinel-official/hub/x/deposit"
+	"github.com/sentinel-official/hub/x/upgrade"
 	"github.com/sentinel-official/hub/x/vpn"
 )
 
@@ -56,6 +57,7 @@ var (
 		supply.AppModuleBasic{},
 		deposit.AppModuleBasic{},
 		vpn.AppModuleBasic{},
+		upgrade.AppModuleBasic{},
 	)
 	
 	moduleAccountPermissions = map[string][]string{
@@ -108,6 +110,7 @@ type SimApp struct {
 	paramsKeeper       params.Keeper
 	depositKeeper      deposit.Keeper
 	vpnKeeper          vpn.Keeper
+	upgradeKeeper      upgrade.Keeper
 	
 	mm *module.Manager
 }
@@ -126,7 +129,8 @@ func NewSimApp(logger log.Logger, db db.DB,
 		baseapp.MainStoreKey, auth.StoreKey, staking.StoreKey,
 		supply.StoreKey, mint.StoreKey, distribution.StoreKey, slashing.StoreKey,
 		gov.StoreKey, params.StoreKey, deposit.StoreKey,
-		vpn.StoreKeyNode, vpn.StoreKeySubscription, vpn.StoreKeySession,
+		vpn.StoreKeyNode, vpn.StoreKeySubscription, vpn.StoreKeySession, vpn.StoreKeyResolver,
+		upgrade.StoreKey,
 	)
 	
 	transientKeys := sdk.NewTransientStoreKeys(staking.TStoreKey, params.TStoreKey)
@@ -195,10 +199,27 @@ func NewSimApp(logger log.Logger, db db.DB,
 		app.supplyKeeper,
 		auth.FeeCollectorName)
 	
+	app.depositKeeper = deposit.NewKeeper(app.cdc,
+		keys[deposit.StoreKey],
+		app.supplyKeeper)
+	app.vpnKeeper = vpn.NewKeeper(app.cdc,
+		keys[vpn.StoreKeyNode],
+		keys[vpn.StoreKeySubscription],
+		keys[vpn.StoreKeySession],
+		keys[vpn.StoreKeyResolver],
+		app.paramsKeeper.Subspace(vpn.DefaultParamspace),
+		app.depositKeeper)
+	
+	app.upgradeKeeper = upgrade.NewKeeper(app.cdc,
+		keys[upgrade.StoreKey])
+	app.registerUpgradeHandlers()
+	
 	govRouter := gov.NewRouter()
 	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
 		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
-		AddRoute(distribution.RouterKey, distribution.NewCommunityPoolSpendProposalHandler(app.distributionKeeper))
+		AddRoute(distribution.RouterKey, distribution.NewCommunityPoolSpendProposalHandler(app.distributionKeeper)).
+		AddRoute(vpn.RouterKey, vpn.NewProposalHandler(app.vpnKeeper)).
+		AddRoute(upgrade.RouterKey, upgrade.NewProposalHandler(app.upgradeKeeper))
 	
 	app.govKeeper = gov.NewKeeper(app.cdc,
 		keys[gov.StoreKey],
@@ -212,17 +233,6 @@ func NewSimApp(logger log.Logger, db db.DB,
 	app.stakingKeeper = *stakingKeeper.SetHooks(
 		staking.NewMultiStakingHooks(app.distributionKeeper.Hooks(), app.slashingKeeper.Hooks()))
 	
-	app.depositKeeper = deposit.NewKeeper(app.cdc,
-		keys[deposit.StoreKey],
-		app.supplyKeeper)
-	app.vpnKeeper = vpn.NewKeeper(app.cdc,
-		keys[vpn.StoreKeyNode],
-		keys[vpn.StoreKeySubscription],
-		keys[vpn.StoreKeySession],
-		keys[vpn.StoreKeyResolver],
-		app.paramsKeeper.Subspace(vpn.DefaultParamspace),
-		app.depositKeeper)
-	
 	app.mm = module.NewManager(
 		genaccounts.NewAppModule(app.accountKeeper),
 		genutil.NewAppModule(app.accountKeeper, app.stakingKeeper, app.BaseApp.DeliverTx),
@@ -237,15 +247,18 @@ func NewSimApp(logger log.Logger, db db.DB,
 		staking.NewAppModule(app.stakingKeeper, app.distributionKeeper, app.accountKeeper, app.supplyKeeper),
 		deposit.NewAppModule(app.depositKeeper),
 		vpn.NewAppModule(app.vpnKeeper),
+		upgrade.NewAppModule(app.upgradeKeeper),
 	)
 	
-	app.mm.SetOrderBeginBlockers(mint.ModuleName, distribution.ModuleName, slashing.ModuleName)
+	app.mm.SetOrderBeginBlockers(upgrade.ModuleName, mint.ModuleName, distribution.ModuleName, slashing.ModuleName)
 	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName, vpn.ModuleName)
 	app.mm.SetOrderInitGenesis(
 		genaccounts.ModuleName, distribution.ModuleName, staking.ModuleName,
 		auth.ModuleName, bank.ModuleName, slashing.ModuleName, gov.ModuleName,
-		mint.ModuleName, supply.ModuleName, crisis.ModuleName, genutil.ModuleName,
-		deposit.ModuleName, vpn.ModuleName,
+		mint.ModuleName, supply.ModuleName, genutil.ModuleName,
+		deposit.ModuleName, vpn.ModuleName, upgrade.ModuleName,
+		// crisis needs to be last so that the genesis invariants are run against a fully initialized state
+		crisis.ModuleName,
 	)
 	
 	app.mm.RegisterInvariants(&app.crisisKeeper)
@@ -11,6 +11,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	
+	"github.com/sentinel-official/hub/x/vpn"
 )
 
 func (app *SimApp) ExportAppStateAndValidators(
@@ -131,4 +133,6 @@ func (app *SimApp) prepForZeroHeightGenesis(
 			return false
 		},
 	)
+	
+	vpn.PrepForZeroHeightGenesis(ctx, app.vpnKeeper)
 }
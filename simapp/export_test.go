@@ -0,0 +1,155 @@
+package simapp
+
+import (
+	"testing"
+	"time"
+	
+	"github.com/stretchr/testify/require"
+	
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn"
+	vpntypes "github.com/sentinel-official/hub/x/vpn/types"
+)
+
+// setupVPNState builds the state of the vpn module through its handler, with an accepted and a pending listing of
+// the nodes on a resolver, a settled and an active session, an open channel and a free client
+func setupVPNState(t *testing.T) (*SimApp, sdk.Context) {
+	app := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, 0)
+	
+	genesisTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	newContext := func(height int64) sdk.Context {
+		return app.NewContext(true, abci.Header{Height: height, Time: genesisTime.Add(time.Duration(height) * time.Minute)})
+	}
+	
+	ctx := newContext(0)
+	app.mm.InitGenesis(ctx, NewDefaultGenesisState())
+	
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10000))
+	for _, address := range []sdk.AccAddress{vpntypes.TestAddress1, vpntypes.TestAddress2, vpntypes.TestAddress3} {
+		require.Nil(t, app.supplyKeeper.MintCoins(ctx, mint.ModuleName, coins))
+		require.Nil(t, app.supplyKeeper.SendCoinsFromModuleToAccount(ctx, mint.ModuleName, address, coins))
+	}
+	
+	handler := vpn.NewHandler(app.vpnKeeper)
+	deliver := func(ctx sdk.Context, msg sdk.Msg) {
+		res := handler(ctx, msg)
+		require.True(t, res.IsOK(), res.Log)
+	}
+	
+	ctx = newContext(1)
+	deliver(ctx, vpn.NewMsgRegisterResolver(vpntypes.TestAddress3, "resolver", "https://resolver.sentinel.co",
+		[]string{"US"}, sdk.NewInt64Coin("stake", 100), sdk.NewDecWithPrec(1, 1),
+		sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2)))
+	for i := 0; i < 2; i++ {
+		deliver(ctx, *vpn.NewMsgRegisterNode(vpntypes.TestAddress1, "node-type", "version", "moniker",
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), vpntypes.TestBandwidthPos1, "encryption"))
+		deliver(ctx, *vpn.NewMsgRegisterVPNOnResolver(vpntypes.TestAddress1, hub.NewNodeID(uint64(i)), hub.NewResolverID(0)))
+	}
+	deliver(ctx, vpn.NewMsgAcceptVPNOnResolver(vpntypes.TestAddress3, hub.NewResolverID(0), hub.NewNodeID(0)))
+	deliver(ctx, *vpn.NewMsgAddFreeClient(vpntypes.TestAddress1, hub.NewNodeID(1), vpntypes.TestAddress3,
+		vpntypes.TestBandwidthPos1, 0, 1))
+	
+	ctx = newContext(2)
+	deliver(ctx, *vpn.NewMsgStartSubscription(vpntypes.TestAddress2, hub.NewResolverID(0), hub.NewNodeID(0),
+		sdk.Coins{sdk.NewInt64Coin("stake", 1000)}, 0))
+	deliver(ctx, *vpn.NewMsgStartSession(vpntypes.TestAddress2, hub.NewSubscriptionID(0)))
+	deliver(ctx, *vpn.NewMsgOpenChannel(vpntypes.TestAddress2, hub.NewNodeID(0),
+		sdk.NewInt64Coin("stake", 100), time.Hour))
+	
+	ctx = newContext(3)
+	deliver(ctx, *vpn.NewMsgUpdateSessionInfo(vpntypes.TestAddress1, hub.NewSessionID(0), vpntypes.TestBandwidthPos1,
+		vpntypes.TestNodeOwnerStdSignaturePos1, vpntypes.TestClientStdSignaturePos1))
+	deliver(ctx, *vpn.NewMsgNodeHeartbeat(vpntypes.TestAddress1, hub.NewNodeID(0)))
+	deliver(ctx, *vpn.NewMsgDeregisterNode(vpntypes.TestAddress1, hub.NewNodeID(1)))
+	
+	ctx = newContext(4)
+	deliver(ctx, *vpn.NewMsgEndSession(vpntypes.TestAddress1, hub.NewSessionID(0)))
+	deliver(ctx, *vpn.NewMsgStartSession(vpntypes.TestAddress2, hub.NewSubscriptionID(0)))
+	
+	return app, ctx
+}
+
+// TestVPNImportExport exports the app state and imports it into a new app, and checks that the stores of the vpn
+// module are equal byte for byte
+func TestVPNImportExport(t *testing.T) {
+	app, ctx := setupVPNState(t)
+	
+	appState, _, err := app.ExportAppStateAndValidators(false, nil)
+	require.Nil(t, err)
+	
+	var genesisState GenesisState
+	require.Nil(t, app.cdc.UnmarshalJSON(appState, &genesisState))
+	
+	var vpnGenesisState vpn.GenesisState
+	require.Nil(t, app.cdc.UnmarshalJSON(genesisState[vpn.ModuleName], &vpnGenesisState))
+	require.Nil(t, vpn.ValidateGenesis(vpnGenesisState))
+	require.Len(t, vpnGenesisState.ResolverNodes, 1)
+	require.Len(t, vpnGenesisState.PendingResolverNodes, 1)
+	require.Len(t, vpnGenesisState.Settlements, 1)
+	require.NotEmpty(t, vpnGenesisState.ActiveNodeIDs)
+	require.NotEmpty(t, vpnGenesisState.ActiveSessionIDs)
+	
+	newApp := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, 0)
+	newCtx := newApp.NewContext(true, abci.Header{Height: ctx.BlockHeight(), Time: ctx.BlockTime()})
+	newApp.mm.InitGenesis(newCtx, genesisState)
+	
+	for _, key := range []string{vpn.StoreKeyNode, vpn.StoreKeySubscription, vpn.StoreKeySession, vpn.StoreKeyResolver} {
+		storeA := ctx.KVStore(app.keys[key])
+		storeB := newCtx.KVStore(newApp.keys[key])
+		
+		kvA, kvB, count, equal := sdk.DiffKVStores(storeA, storeB, nil)
+		require.NotZero(t, count, key)
+		require.True(t, equal, GetSimulationLog(key, app.cdc, newApp.cdc, kvA, kvB))
+	}
+}
+
+// TestVPNZeroHeightExport checks that a zero height export settles the active sessions and moves the active nodes
+// to the height zero, so that the EndBlock of the new chain inactivates them after the inactive interval
+func TestVPNZeroHeightExport(t *testing.T) {
+	app, _ := setupVPNState(t)
+	
+	appState, _, err := app.ExportAppStateAndValidators(true, nil)
+	require.Nil(t, err)
+	
+	var genesisState GenesisState
+	require.Nil(t, app.cdc.UnmarshalJSON(appState, &genesisState))
+	
+	var vpnGenesisState vpn.GenesisState
+	require.Nil(t, app.cdc.UnmarshalJSON(genesisState[vpn.ModuleName], &vpnGenesisState))
+	require.Nil(t, vpn.ValidateGenesis(vpnGenesisState))
+	require.Len(t, vpnGenesisState.Settlements, 2)
+	require.Empty(t, vpnGenesisState.ActiveSessionIDs)
+	require.Equal(t, []vpn.ActiveNodeIDs{{Height: 0, IDs: []hub.NodeID{hub.NewNodeID(0)}}},
+		vpnGenesisState.ActiveNodeIDs)
+	
+	for _, session := range vpnGenesisState.Sessions {
+		require.Equal(t, vpn.StatusInactive, session.Status)
+		require.Equal(t, int64(0), session.StatusModifiedAt)
+	}
+	for _, node := range vpnGenesisState.Nodes {
+		require.Equal(t, int64(0), node.StatusModifiedAt)
+	}
+	
+	newApp := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, 0)
+	ctx := newApp.NewContext(true, abci.Header{})
+	newApp.mm.InitGenesis(ctx, genesisState)
+	
+	ctx = ctx.WithBlockHeight(newApp.vpnKeeper.NodeInactiveInterval(ctx) - 1)
+	vpn.EndBlock(ctx, newApp.vpnKeeper)
+	
+	node, _ := newApp.vpnKeeper.GetNode(ctx, hub.NewNodeID(0))
+	require.Equal(t, vpn.StatusRegistered, node.Status)
+	
+	ctx = ctx.WithBlockHeight(newApp.vpnKeeper.NodeInactiveInterval(ctx))
+	vpn.EndBlock(ctx, newApp.vpnKeeper)
+	
+	node, _ = newApp.vpnKeeper.GetNode(ctx, hub.NewNodeID(0))
+	require.Equal(t, vpn.StatusInactive, node.Status)
+}
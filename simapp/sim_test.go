@@ -429,7 +429,7 @@ func TestAppImportExport(t *testing.T) {
 	fmt.Printf("Importing genesis...\n")
 	
 	newDir, _ := ioutil.TempDir("", "goleveldb-app-sim-2")
-	newDB, _ := sdk.NewLevelDB("Simulation-2", dir)
+	newDB, _ := sdk.NewLevelDB("Simulation-2", newDir)
 	
 	defer func() {
 		newDB.Close()
@@ -466,6 +466,7 @@ func TestAppImportExport(t *testing.T) {
 		{app.keys[vpn.StoreKeyNode], newApp.keys[vpn.StoreKeyNode], [][]byte{}},
 		{app.keys[vpn.StoreKeySession], newApp.keys[vpn.StoreKeySession], [][]byte{}},
 		{app.keys[vpn.StoreKeySubscription], newApp.keys[vpn.StoreKeySubscription], [][]byte{}},
+		{app.keys[vpn.StoreKeyResolver], newApp.keys[vpn.StoreKeyResolver], [][]byte{}},
 	}
 	
 	for _, storeKeysPrefix := range storeKeysPrefixes {
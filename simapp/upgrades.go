@@ -0,0 +1,17 @@
+package simapp
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/sentinel-official/hub/x/upgrade"
+	"github.com/sentinel-official/hub/x/vpn"
+)
+
+// UpgradeName is the name of the upgrade plan which the simulation app performs
+const UpgradeName = "v0.2.0"
+
+func (app *SimApp) registerUpgradeHandlers() {
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeName, func(ctx sdk.Context, _ upgrade.Plan) error {
+		return vpn.RunMigrations(ctx, app.vpnKeeper, vpn.Migrations)
+	})
+}
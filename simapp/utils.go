@@ -415,6 +415,73 @@ func GenVpnGenesisState(cdc *codec.Codec, r *rand.Rand, accs []simulation.Accoun
 					})
 				return v
 			}(r),
+			func(r *rand.Rand) int64 {
+				var v int64
+				ap.GetOrGenerate(cdc, vpnsim.NodeInactiveInterval, &v, r,
+					func(r *rand.Rand) {
+						v = int64(simulation.RandIntBetween(r, 10, 1000))
+					})
+				return v
+			}(r),
+			func(r *rand.Rand) sdk.Dec {
+				var v sdk.Dec
+				ap.GetOrGenerate(cdc, vpnsim.SlashFraction, &v, r,
+					func(r *rand.Rand) {
+						v = sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 1, 100)), 2)
+					})
+				return v
+			}(r),
+			func(r *rand.Rand) time.Duration {
+				var v time.Duration
+				ap.GetOrGenerate(cdc, vpnsim.NodeUnbondingPeriod, &v, r,
+					func(r *rand.Rand) {
+						v = time.Duration(simulation.RandIntBetween(r, 60, 60*60*24*3)) * time.Second
+					})
+				return v
+			}(r),
+			func(r *rand.Rand) time.Duration {
+				var v time.Duration
+				ap.GetOrGenerate(cdc, vpnsim.ResolverUnbondingPeriod, &v, r,
+					func(r *rand.Rand) {
+						v = time.Duration(simulation.RandIntBetween(r, 60, 60*60*24*3)) * time.Second
+					})
+				return v
+			}(r),
+			func(r *rand.Rand) sdk.Coins {
+				var v sdk.Coins
+				ap.GetOrGenerate(cdc, vpnsim.MaxPricesPerGB, &v, r,
+					func(r *rand.Rand) {
+						v = sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simulation.RandIntBetween(r, 1e3, 1e6)))}
+					})
+				return v
+			}(r),
+			func(r *rand.Rand) sdk.Coin {
+				var v sdk.Coin
+				ap.GetOrGenerate(cdc, vpnsim.ResolverMinDeposit, &v, r,
+					func(r *rand.Rand) {
+						v = sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simulation.RandIntBetween(r, 1, 1e3)))
+					})
+				return v
+			}(r),
+			func(r *rand.Rand) int64 {
+				var v int64
+				ap.GetOrGenerate(cdc, vpnsim.CommissionChangeInterval, &v, r,
+					func(r *rand.Rand) {
+						v = int64(simulation.RandIntBetween(r, 1, 1e3))
+					})
+				return v
+			}(r),
+			func(r *rand.Rand) vpn.ExchangeRates {
+				var v vpn.ExchangeRates
+				ap.GetOrGenerate(cdc, vpnsim.ExchangeRates, &v, r,
+					func(r *rand.Rand) {
+						v = vpn.ExchangeRates{
+							vpn.NewExchangeRate(sdk.DefaultBondDenom,
+								sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 1, 1e3)), 2)),
+						}
+					})
+				return v
+			}(r),
 		),
 		Nodes:         nodes,
 		Subscriptions: subscriptions,
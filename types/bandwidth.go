@@ -113,14 +113,14 @@ func NewBandwidthFromInt64(upload, download int64) Bandwidth {
 
 type BandwidthSignatureData struct {
 	ID        SubscriptionID `json:"id"`
-	Index     uint64         `json:"index"`
+	SessionID SessionID      `json:"session_id"`
 	Bandwidth Bandwidth      `json:"bandwidth"`
 }
 
-func NewBandwidthSignatureData(id SubscriptionID, index uint64, bandwidth Bandwidth) BandwidthSignatureData {
+func NewBandwidthSignatureData(id SubscriptionID, sessionID SessionID, bandwidth Bandwidth) BandwidthSignatureData {
 	return BandwidthSignatureData{
 		ID:        id,
-		Index:     index,
+		SessionID: sessionID,
 		Bandwidth: bandwidth,
 	}
 }
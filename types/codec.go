@@ -9,4 +9,5 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(NodeID{}, "types/nodeID", nil)
 	cdc.RegisterConcrete(SessionID{}, "types/sessionID", nil)
 	cdc.RegisterConcrete(SubscriptionID{}, "types/subscriptionID", nil)
+	cdc.RegisterConcrete(ChannelID{}, "types/channelID", nil)
 }
@@ -0,0 +1,233 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: sentinel/hub/v1/common.proto
+
+package hubpb
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// Coin is an amount of a denomination. The amount is a decimal integer string.
+type Coin struct {
+	Denom                string   `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount               string   `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Coin) Reset()         { *m = Coin{} }
+func (m *Coin) String() string { return proto.CompactTextString(m) }
+func (*Coin) ProtoMessage()    {}
+func (*Coin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e76c928f1cfb9ab, []int{0}
+}
+
+func (m *Coin) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Coin.Unmarshal(m, b)
+}
+func (m *Coin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Coin.Marshal(b, m, deterministic)
+}
+func (m *Coin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Coin.Merge(m, src)
+}
+func (m *Coin) XXX_Size() int {
+	return xxx_messageInfo_Coin.Size(m)
+}
+func (m *Coin) XXX_DiscardUnknown() {
+	xxx_messageInfo_Coin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Coin proto.InternalMessageInfo
+
+func (m *Coin) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Coin) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+// Bandwidth is a number of uploaded and downloaded bytes, as decimal integer strings.
+type Bandwidth struct {
+	Upload               string   `protobuf:"bytes,1,opt,name=upload,proto3" json:"upload,omitempty"`
+	Download             string   `protobuf:"bytes,2,opt,name=download,proto3" json:"download,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Bandwidth) Reset()         { *m = Bandwidth{} }
+func (m *Bandwidth) String() string { return proto.CompactTextString(m) }
+func (*Bandwidth) ProtoMessage()    {}
+func (*Bandwidth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e76c928f1cfb9ab, []int{1}
+}
+
+func (m *Bandwidth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Bandwidth.Unmarshal(m, b)
+}
+func (m *Bandwidth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Bandwidth.Marshal(b, m, deterministic)
+}
+func (m *Bandwidth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bandwidth.Merge(m, src)
+}
+func (m *Bandwidth) XXX_Size() int {
+	return xxx_messageInfo_Bandwidth.Size(m)
+}
+func (m *Bandwidth) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bandwidth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Bandwidth proto.InternalMessageInfo
+
+func (m *Bandwidth) GetUpload() string {
+	if m != nil {
+		return m.Upload
+	}
+	return ""
+}
+
+func (m *Bandwidth) GetDownload() string {
+	if m != nil {
+		return m.Download
+	}
+	return ""
+}
+
+// PageRequest selects a page of a list. A zero limit selects every item after the offset.
+type PageRequest struct {
+	Offset               uint64   `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                uint64   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PageRequest) Reset()         { *m = PageRequest{} }
+func (m *PageRequest) String() string { return proto.CompactTextString(m) }
+func (*PageRequest) ProtoMessage()    {}
+func (*PageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e76c928f1cfb9ab, []int{2}
+}
+
+func (m *PageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PageRequest.Unmarshal(m, b)
+}
+func (m *PageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PageRequest.Marshal(b, m, deterministic)
+}
+func (m *PageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PageRequest.Merge(m, src)
+}
+func (m *PageRequest) XXX_Size() int {
+	return xxx_messageInfo_PageRequest.Size(m)
+}
+func (m *PageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PageRequest proto.InternalMessageInfo
+
+func (m *PageRequest) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *PageRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// PageResponse carries the number of items of the whole list.
+type PageResponse struct {
+	Total                uint64   `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PageResponse) Reset()         { *m = PageResponse{} }
+func (m *PageResponse) String() string { return proto.CompactTextString(m) }
+func (*PageResponse) ProtoMessage()    {}
+func (*PageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e76c928f1cfb9ab, []int{3}
+}
+
+func (m *PageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PageResponse.Unmarshal(m, b)
+}
+func (m *PageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PageResponse.Marshal(b, m, deterministic)
+}
+func (m *PageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PageResponse.Merge(m, src)
+}
+func (m *PageResponse) XXX_Size() int {
+	return xxx_messageInfo_PageResponse.Size(m)
+}
+func (m *PageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PageResponse proto.InternalMessageInfo
+
+func (m *PageResponse) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Coin)(nil), "sentinel.hub.v1.Coin")
+	proto.RegisterType((*Bandwidth)(nil), "sentinel.hub.v1.Bandwidth")
+	proto.RegisterType((*PageRequest)(nil), "sentinel.hub.v1.PageRequest")
+	proto.RegisterType((*PageResponse)(nil), "sentinel.hub.v1.PageResponse")
+}
+
+func init() { proto.RegisterFile("sentinel/hub/v1/common.proto", fileDescriptor_9e76c928f1cfb9ab) }
+
+var fileDescriptor_9e76c928f1cfb9ab = []byte{
+	// 234 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x90, 0xc1, 0x4a, 0xc4, 0x30,
+	0x10, 0x86, 0x59, 0xa9, 0x8b, 0x1b, 0x05, 0xa1, 0x88, 0x2c, 0xe2, 0x41, 0x8a, 0x07, 0x0f, 0xda,
+	0xb2, 0xe8, 0xcd, 0x83, 0xb0, 0xbe, 0x80, 0xf4, 0xe8, 0x2d, 0x6d, 0xa6, 0xdb, 0x81, 0x66, 0x26,
+	0x9a, 0xc9, 0x2e, 0xbe, 0xbd, 0xa4, 0xc9, 0xee, 0x2d, 0x5f, 0x66, 0xbe, 0x49, 0xe6, 0x57, 0xf7,
+	0x1e, 0x48, 0x90, 0x60, 0x6a, 0xc6, 0xd0, 0x35, 0xfb, 0x4d, 0xd3, 0xb3, 0xb5, 0x4c, 0xb5, 0xfb,
+	0x65, 0xe1, 0xf2, 0xfa, 0x58, 0xad, 0xc7, 0xd0, 0xd5, 0xfb, 0x4d, 0xf5, 0xa6, 0x8a, 0x4f, 0x46,
+	0x2a, 0x6f, 0xd4, 0xb9, 0x01, 0x62, 0xbb, 0x5e, 0x3c, 0x2c, 0x9e, 0x56, 0x6d, 0x82, 0xf2, 0x56,
+	0x2d, 0xb5, 0xe5, 0x40, 0xb2, 0x3e, 0x9b, 0xaf, 0x33, 0x55, 0x1f, 0x6a, 0xb5, 0xd5, 0x64, 0x0e,
+	0x68, 0x64, 0x8c, 0x4d, 0xc1, 0x4d, 0xac, 0x4d, 0x76, 0x33, 0x95, 0x77, 0xea, 0xc2, 0xf0, 0x81,
+	0xe6, 0x4a, 0xd2, 0x4f, 0x5c, 0xbd, 0xab, 0xcb, 0x2f, 0xbd, 0x83, 0x16, 0x7e, 0x02, 0x78, 0x89,
+	0x23, 0x78, 0x18, 0x3c, 0xc8, 0x3c, 0xa2, 0x68, 0x33, 0xc5, 0x5f, 0x4d, 0x68, 0x31, 0x3d, 0x5f,
+	0xb4, 0x09, 0xaa, 0x47, 0x75, 0x95, 0x64, 0xef, 0x98, 0x3c, 0xc4, 0x2e, 0x61, 0xd1, 0x53, 0x96,
+	0x13, 0x6c, 0xeb, 0xef, 0xe7, 0x1d, 0x4a, 0x5c, 0xb3, 0x67, 0xdb, 0x1c, 0xf7, 0x7e, 0xe1, 0x61,
+	0xc0, 0x1e, 0x75, 0x8a, 0x47, 0xfe, 0x1c, 0xf8, 0x78, 0x72, 0x5d, 0xb7, 0x9c, 0x13, 0x7a, 0xfd,
+	0x1f, 0x00, 0x39, 0x40, 0x79, 0x69, 0x41, 0x01, 0x00, 0x00,
+}
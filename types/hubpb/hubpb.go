@@ -0,0 +1,59 @@
+package hubpb
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	hub "github.com/sentinel-official/hub/types"
+)
+
+func NewCoin(coin sdk.Coin) *Coin {
+	if coin.Amount == (sdk.Int{}) {
+		return &Coin{Denom: coin.Denom}
+	}
+	
+	return &Coin{
+		Denom:  coin.Denom,
+		Amount: coin.Amount.String(),
+	}
+}
+
+func NewCoins(coins sdk.Coins) []*Coin {
+	items := make([]*Coin, 0, len(coins))
+	for _, coin := range coins {
+		items = append(items, NewCoin(coin))
+	}
+	
+	return items
+}
+
+func NewBandwidth(bandwidth hub.Bandwidth) *Bandwidth {
+	if bandwidth.AnyNil() {
+		return &Bandwidth{}
+	}
+	
+	return &Bandwidth{
+		Upload:   bandwidth.Upload.String(),
+		Download: bandwidth.Download.String(),
+	}
+}
+
+// Paginate returns the bounds of the page of a list with the length. A nil page selects the whole list.
+func Paginate(length int, page *PageRequest) (start, end int) {
+	offset, limit := page.GetOffset(), page.GetLimit()
+	if offset >= uint64(length) {
+		return length, length
+	}
+	
+	start, end = int(offset), length
+	if limit > 0 && limit < uint64(end-start) {
+		end = start + int(limit)
+	}
+	
+	return start, end
+}
+
+func NewPageResponse(total int) *PageResponse {
+	return &PageResponse{
+		Total: uint64(total),
+	}
+}
@@ -0,0 +1,38 @@
+package hubpb
+
+import (
+	"testing"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	
+	hub "github.com/sentinel-official/hub/types"
+)
+
+func TestPaginate(t *testing.T) {
+	start, end := Paginate(10, nil)
+	require.Equal(t, []int{0, 10}, []int{start, end})
+	
+	start, end = Paginate(10, &PageRequest{Offset: 2, Limit: 3})
+	require.Equal(t, []int{2, 5}, []int{start, end})
+	
+	start, end = Paginate(10, &PageRequest{Offset: 8, Limit: 3})
+	require.Equal(t, []int{8, 10}, []int{start, end})
+	
+	start, end = Paginate(10, &PageRequest{Offset: 10, Limit: 3})
+	require.Equal(t, []int{10, 10}, []int{start, end})
+	
+	start, end = Paginate(0, &PageRequest{Limit: 3})
+	require.Equal(t, []int{0, 0}, []int{start, end})
+}
+
+func TestNewCoin(t *testing.T) {
+	require.Equal(t, &Coin{Denom: "stake", Amount: "100"}, NewCoin(sdk.NewInt64Coin("stake", 100)))
+	require.Equal(t, &Coin{}, NewCoin(sdk.Coin{}))
+	require.Equal(t, []*Coin{}, NewCoins(nil))
+}
+
+func TestNewBandwidth(t *testing.T) {
+	require.Equal(t, &Bandwidth{Upload: "1", Download: "2"}, NewBandwidth(hub.NewBandwidthFromInt64(1, 2)))
+	require.Equal(t, &Bandwidth{}, NewBandwidth(hub.Bandwidth{}))
+}
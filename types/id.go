@@ -16,6 +16,7 @@ const (
 	SessionIDPrefix      = "sess"
 	SubscriptionIDPrefix = "subs"
 	ResolverIDPrefix     = "reso"
+	ChannelIDPrefix      = "chan"
 )
 
 type ID interface {
@@ -32,6 +33,7 @@ var (
 	_ ID = SessionID{}
 	_ ID = SubscriptionID{}
 	_ ID = ResolverID{}
+	_ ID = ChannelID{}
 )
 
 type NodeID []byte
@@ -270,6 +272,65 @@ func (id *ResolverID) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+type ChannelID []byte
+
+func NewChannelID(i uint64) ChannelID {
+	return types.Uint64ToBigEndian(i)
+}
+
+func NewChannelIDFromString(s string) (ChannelID, error) {
+	if len(s) < 5 {
+		return nil, fmt.Errorf("invalid channel id length")
+	}
+	
+	i, err := strconv.ParseUint(s[4:], 16, 64)
+	if err != nil {
+		return nil, err
+	}
+	
+	return NewChannelID(i), nil
+}
+
+func (id ChannelID) String() string {
+	return fmt.Sprintf("%s%x", ChannelIDPrefix, id.Uint64())
+}
+
+func (id ChannelID) Uint64() uint64 {
+	return binary.BigEndian.Uint64(id)
+}
+
+func (id ChannelID) Bytes() []byte {
+	return id
+}
+
+func (id ChannelID) Prefix() string {
+	return ChannelIDPrefix
+}
+
+func (id ChannelID) IsEqual(_id ID) bool {
+	return id.String() == _id.String()
+}
+
+func (id ChannelID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(id.String())
+}
+
+func (id *ChannelID) UnmarshalJSON(bytes []byte) error {
+	var s string
+	if err := json.Unmarshal(bytes, &s); err != nil {
+		return err
+	}
+	
+	_id, err := NewChannelIDFromString(s)
+	if err != nil {
+		return err
+	}
+	
+	*id = _id
+	
+	return nil
+}
+
 var _ sort.Interface = (*IDs)(nil)
 
 type IDs []ID
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"encoding/json"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VoucherSignatureData is signed by the client of a payment channel. The amount is cumulative,
+// so a voucher replaces all the vouchers of the channel signed before it.
+type VoucherSignatureData struct {
+	ID     ChannelID `json:"id"`
+	Amount sdk.Coin  `json:"amount"`
+}
+
+func NewVoucherSignatureData(id ChannelID, amount sdk.Coin) VoucherSignatureData {
+	return VoucherSignatureData{
+		ID:     id,
+		Amount: amount,
+	}
+}
+
+func (v VoucherSignatureData) Bytes() []byte {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	
+	return bz
+}
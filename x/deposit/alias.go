@@ -13,36 +13,93 @@ import (
 )
 
 const (
-	Codespace             = types.Codespace
-	ModuleName            = types.ModuleName
-	StoreKey              = types.StoreKey
-	RouterKey             = types.RouterKey
-	QuerierRoute          = types.QuerierRoute
-	QueryDepositOfAddress = types.QueryDepositOfAddress
-	QueryAllDeposits      = types.QueryAllDeposits
+	Codespace                   = types.Codespace
+	ModuleName                  = types.ModuleName
+	StoreKey                    = types.StoreKey
+	RouterKey                   = types.RouterKey
+	QuerierRoute                = types.QuerierRoute
+	QueryDepositOfAddress       = types.QueryDepositOfAddress
+	QueryAllDeposits            = types.QueryAllDeposits
+	QueryLedgerEntriesOfAddress = types.QueryLedgerEntriesOfAddress
+	ActionAdd                   = types.ActionAdd
+	ActionSubtract              = types.ActionSubtract
+	ActionSend                  = types.ActionSend
+	ActionReceive               = types.ActionReceive
+	ActionLock                  = types.ActionLock
+	ActionUnlock                = types.ActionUnlock
+	ReasonDeposit               = types.ReasonDeposit
+	ReasonWithdraw              = types.ReasonWithdraw
+	ReasonTransfer              = types.ReasonTransfer
+	ReasonNodeBond              = types.ReasonNodeBond
+	ReasonResolverBond          = types.ReasonResolverBond
+	ReasonSubscriptionEscrow    = types.ReasonSubscriptionEscrow
+	ReasonChannelEscrow         = types.ReasonChannelEscrow
+	ReasonSettlement            = types.ReasonSettlement
+	ReasonRefund                = types.ReasonRefund
+	ReasonSlash                 = types.ReasonSlash
 )
 
 var (
 	// functions aliases
-	ErrorMarshal                   = types.ErrorMarshal
-	ErrorUnmarshal                 = types.ErrorUnmarshal
-	ErrorInvalidQueryType          = types.ErrorInvalidQueryType
-	ErrorInsufficientDepositFunds  = types.ErrorInsufficientDepositFunds
-	NewGenesisState                = types.NewGenesisState
-	DefaultGenesisState            = types.DefaultGenesisState
-	DepositKey                     = types.DepositKey
-	NewQueryDepositOfAddressParams = types.NewQueryDepositOfAddressParams
-	NewKeeper                      = keeper.NewKeeper
-	NewQuerier                     = querier.NewQuerier
+	ErrorMarshal                         = types.ErrorMarshal
+	ErrorUnmarshal                       = types.ErrorUnmarshal
+	ErrorInvalidQueryType                = types.ErrorInvalidQueryType
+	ErrorInsufficientDepositFunds        = types.ErrorInsufficientDepositFunds
+	ErrorUnknownMsgType                  = types.ErrorUnknownMsgType
+	ErrorInvalidField                    = types.ErrorInvalidField
+	ErrorInsufficientLockedFunds         = types.ErrorInsufficientLockedFunds
+	NewGenesisState                      = types.NewGenesisState
+	DefaultGenesisState                  = types.DefaultGenesisState
+	DepositKey                           = types.DepositKey
+	LedgerEntryKey                       = types.LedgerEntryKey
+	LedgerEntryIDsByAddressKey           = types.LedgerEntryIDsByAddressKey
+	LedgerEntryIDByAddressKey            = types.LedgerEntryIDByAddressKey
+	NewLedgerEntry                       = types.NewLedgerEntry
+	NewQueryDepositOfAddressParams       = types.NewQueryDepositOfAddressParams
+	NewQueryLedgerEntriesOfAddressParams = types.NewQueryLedgerEntriesOfAddressParams
+	RegisterCodec                        = types.RegisterCodec
+	NewMsgDeposit                        = types.NewMsgDeposit
+	NewMsgWithdraw                       = types.NewMsgWithdraw
+	NewKeeper                            = keeper.NewKeeper
+	RegisterInvariants                   = keeper.RegisterInvariants
+	AllInvariants                        = keeper.AllInvariants
+	ModuleAccountInvariant               = keeper.ModuleAccountInvariant
+	LockedFundsInvariant                 = keeper.LockedFundsInvariant
+	NewQuerier                           = querier.NewQuerier
+	NewGRPCServer                        = querier.NewGRPCServer
 	
 	// variable aliases
-	ModuleCdc        = types.ModuleCdc
-	DepositKeyPrefix = types.DepositKeyPrefix
+	ModuleCdc                       = types.ModuleCdc
+	DepositKeyPrefix                = types.DepositKeyPrefix
+	LedgerEntriesCountKey           = types.LedgerEntriesCountKey
+	LedgerEntryKeyPrefix            = types.LedgerEntryKeyPrefix
+	LedgerEntryIDByAddressKeyPrefix = types.LedgerEntryIDByAddressKeyPrefix
+	EventTypeAddDeposit             = types.EventTypeAddDeposit
+	EventTypeSubtractDeposit        = types.EventTypeSubtractDeposit
+	EventTypeSendFromDeposit        = types.EventTypeSendFromDeposit
+	EventTypeReceiveDeposit         = types.EventTypeReceiveDeposit
+	EventTypeLockDeposit            = types.EventTypeLockDeposit
+	EventTypeUnlockDeposit          = types.EventTypeUnlockDeposit
+	EventTypeMsgDeposit             = types.EventTypeMsgDeposit
+	EventTypeMsgWithdraw            = types.EventTypeMsgWithdraw
+	AttributeKeyAddress             = types.AttributeKeyAddress
+	AttributeKeyFromAddress         = types.AttributeKeyFromAddress
+	AttributeKeyToAddress           = types.AttributeKeyToAddress
+	AttributeKeyAmount              = types.AttributeKeyAmount
+	AttributeKeyHolder              = types.AttributeKeyHolder
 )
 
 type (
-	Deposit                    = types.Deposit
-	GenesisState               = types.GenesisState
-	QueryDepositOfAddressPrams = types.QueryDepositOfAddressPrams
-	Keeper                     = keeper.Keeper
+	Deposit                           = types.Deposit
+	Lock                              = types.Lock
+	Locks                             = types.Locks
+	LedgerEntry                       = types.LedgerEntry
+	LedgerEntries                     = types.LedgerEntries
+	MsgDeposit                        = types.MsgDeposit
+	MsgWithdraw                       = types.MsgWithdraw
+	GenesisState                      = types.GenesisState
+	QueryDepositOfAddressPrams        = types.QueryDepositOfAddressPrams
+	QueryLedgerEntriesOfAddressParams = types.QueryLedgerEntriesOfAddressParams
+	Keeper                            = keeper.Keeper
+	GRPCServer                        = querier.GRPCServer
 )
@@ -1,10 +1,28 @@
 package cli
 
 import (
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/spf13/cobra"
 )
 
 func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
-	return QueryDepositsCmd(cdc)
+	cmd := QueryDepositsCmd(cdc)
+	cmd.AddCommand(QueryLedgerEntriesCmd(cdc))
+	
+	return cmd
+}
+
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit",
+		Short: "Deposit transactions subcommands",
+	}
+	
+	cmd.AddCommand(client.PostCommands(
+		DepositTxCmd(cdc),
+		WithdrawTxCmd(cdc),
+	)...)
+	
+	return cmd
 }
@@ -1,3 +1,10 @@
 package cli
 
-const flagAddress = "address"
+const (
+	flagAddress   = "address"
+	flagStartTime = "start-time"
+	flagEndTime   = "end-time"
+	flagPage      = "page"
+	flagLimit     = "limit"
+	flagCSV       = "csv"
+)
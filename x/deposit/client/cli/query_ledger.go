@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"time"
+	
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	
+	"github.com/sentinel-official/hub/x/deposit/client/common"
+	"github.com/sentinel-official/hub/x/deposit/types"
+)
+
+func QueryLedgerEntriesCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ledger-entries [address]",
+		Short: "Query the deposit ledger entries of an address",
+		Long: "Query the deposit ledger entries of an address, or of which the address is the counterparty, " +
+			"in the time range [start-time, end-time). Times are in RFC3339 format.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+			
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			
+			start, err := parseTime(viper.GetString(flagStartTime))
+			if err != nil {
+				return err
+			}
+			end, err := parseTime(viper.GetString(flagEndTime))
+			if err != nil {
+				return err
+			}
+			
+			params := types.NewQueryLedgerEntriesOfAddressParams(address, start, end,
+				viper.GetInt(flagPage), viper.GetInt(flagLimit))
+			
+			entries, err := common.QueryLedgerEntriesOfAddress(ctx, params)
+			if err != nil {
+				return err
+			}
+			
+			if viper.GetBool(flagCSV) {
+				return entries.WriteCSV(os.Stdout)
+			}
+			
+			for _, entry := range entries {
+				fmt.Println(entry)
+			}
+			
+			return nil
+		},
+	}
+	
+	cmd.Flags().String(flagStartTime, "", "Start of the time range, inclusive")
+	cmd.Flags().String(flagEndTime, "", "End of the time range, exclusive")
+	cmd.Flags().Int(flagPage, 1, "Page number")
+	cmd.Flags().Int(flagLimit, 100, "Number of entries per page")
+	cmd.Flags().Bool(flagCSV, false, "Print the entries as CSV")
+	
+	return client.GetCommands(cmd)[0]
+}
+
+func parseTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	
+	return time.Parse(time.RFC3339, s)
+}
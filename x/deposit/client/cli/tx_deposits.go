@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+	
+	"github.com/sentinel-official/hub/x/deposit/types"
+)
+
+func DepositTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add [coins]",
+		Short: "Add to the free deposit of the account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			ctx := context.NewCLIContext().WithCodec(cdc)
+			
+			coins, err := sdk.ParseCoins(args[0])
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgDeposit(ctx.GetFromAddress(), coins)
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+	
+	return cmd
+}
+
+func WithdrawTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw [coins]",
+		Short: "Withdraw from the free deposit of the account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			ctx := context.NewCLIContext().WithCodec(cdc)
+			
+			coins, err := sdk.ParseCoins(args[0])
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgWithdraw(ctx.GetFromAddress(), coins)
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+	
+	return cmd
+}
@@ -56,3 +56,24 @@ func QueryAllDeposits(ctx context.CLIContext) ([]types.Deposit, error) {
 	
 	return d, nil
 }
+
+func QueryLedgerEntriesOfAddress(ctx context.CLIContext,
+	params types.QueryLedgerEntriesOfAddressParams) (types.LedgerEntries, error) {
+	bytes, err := ctx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+	
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryLedgerEntriesOfAddress)
+	res, _, err := ctx.QueryWithData(path, bytes)
+	if err != nil {
+		return nil, err
+	}
+	
+	var entries types.LedgerEntries
+	if err = ctx.Codec.UnmarshalJSON(res, &entries); err != nil {
+		return nil, err
+	}
+	
+	return entries, nil
+}
@@ -0,0 +1,77 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+	
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+	
+	"github.com/sentinel-official/hub/x/deposit/client/common"
+	"github.com/sentinel-official/hub/x/deposit/types"
+)
+
+func parseTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	
+	return time.Parse(time.RFC3339, s)
+}
+
+// getLedgerEntriesOfAddressHandlerFunc writes the ledger entries of the address as JSON, or as CSV
+// when the format query parameter is csv
+func getLedgerEntriesOfAddressHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		
+		address, err := sdk.AccAddressFromBech32(vars["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		
+		query := r.URL.Query()
+		
+		start, err := parseTime(query.Get("start_time"))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid start_time")
+			return
+		}
+		end, err := parseTime(query.Get("end_time"))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid end_time")
+			return
+		}
+		
+		params := types.NewQueryLedgerEntriesOfAddressParams(address, start, end, page, limit)
+		
+		entries, err := common.QueryLedgerEntriesOfAddress(ctx, params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		
+		switch query.Get("format") {
+		case "csv":
+			w.Header().Set("Content-Type", "text/csv")
+			w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"ledger-%s.csv\"", address))
+			if err := entries.WriteCSV(w); err != nil {
+				rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			}
+		case "", "json":
+			rest.PostProcessResponse(w, ctx, entries)
+		default:
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid format")
+		}
+	}
+}
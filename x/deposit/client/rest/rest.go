@@ -6,12 +6,22 @@ import (
 )
 
 func RegisterRoutes(ctx context.CLIContext, r *mux.Router) {
+	registerTxRoutes(ctx, r)
 	registerQueryRoutes(ctx, r)
 }
 
+func registerTxRoutes(ctx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/deposits", depositHandlerFunc(ctx)).
+		Methods("POST")
+	r.HandleFunc("/deposits/withdraw", withdrawHandlerFunc(ctx)).
+		Methods("POST")
+}
+
 func registerQueryRoutes(ctx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/deposits", getAllDeposits(ctx)).
 		Methods("GET")
 	r.HandleFunc("/deposits/{address}", getDepositOfAddressHandlerFunc(ctx)).
 		Methods("GET")
+	r.HandleFunc("/deposits/{address}/ledger", getLedgerEntriesOfAddressHandlerFunc(ctx)).
+		Methods("GET")
 }
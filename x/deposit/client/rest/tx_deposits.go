@@ -0,0 +1,77 @@
+package rest
+
+import (
+	"net/http"
+	
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	
+	"github.com/sentinel-official/hub/x/deposit/types"
+)
+
+type msgDeposit struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Coins   string       `json:"coins"`
+}
+
+func parseDepositReq(w http.ResponseWriter, r *http.Request,
+	ctx context.CLIContext) (req msgDeposit, from sdk.AccAddress, coins sdk.Coins, ok bool) {
+	if !rest.ReadRESTReq(w, r, ctx.Codec, &req) {
+		return req, nil, nil, false
+	}
+	
+	req.BaseReq = req.BaseReq.Sanitize()
+	if !req.BaseReq.ValidateBasic(w) {
+		return req, nil, nil, false
+	}
+	
+	from, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return req, nil, nil, false
+	}
+	
+	coins, err = sdk.ParseCoins(req.Coins)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return req, nil, nil, false
+	}
+	
+	return req, from, coins, true
+}
+
+func depositHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, from, coins, ok := parseDepositReq(w, r, ctx)
+		if !ok {
+			return
+		}
+		
+		msg := types.NewMsgDeposit(from, coins)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		
+		utils.WriteGenerateStdTxResponse(w, ctx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func withdrawHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, from, coins, ok := parseDepositReq(w, r, ctx)
+		if !ok {
+			return
+		}
+		
+		msg := types.NewMsgWithdraw(from, coins)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		
+		utils.WriteGenerateStdTxResponse(w, ctx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
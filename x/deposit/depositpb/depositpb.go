@@ -0,0 +1,13 @@
+package depositpb
+
+import (
+	"github.com/sentinel-official/hub/types/hubpb"
+	"github.com/sentinel-official/hub/x/deposit/types"
+)
+
+func NewDeposit(deposit types.Deposit) *Deposit {
+	return &Deposit{
+		Address: deposit.Address.String(),
+		Coins:   hubpb.NewCoins(deposit.Coins),
+	}
+}
@@ -0,0 +1,389 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: sentinel/deposit/v1/query.proto
+
+package depositpb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	hubpb "github.com/sentinel-official/hub/types/hubpb"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type Deposit struct {
+	Address              string        `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Coins                []*hubpb.Coin `protobuf:"bytes,2,rep,name=coins,proto3" json:"coins,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *Deposit) Reset()         { *m = Deposit{} }
+func (m *Deposit) String() string { return proto.CompactTextString(m) }
+func (*Deposit) ProtoMessage()    {}
+func (*Deposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6109e203c1b1d1f2, []int{0}
+}
+
+func (m *Deposit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Deposit.Unmarshal(m, b)
+}
+func (m *Deposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Deposit.Marshal(b, m, deterministic)
+}
+func (m *Deposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Deposit.Merge(m, src)
+}
+func (m *Deposit) XXX_Size() int {
+	return xxx_messageInfo_Deposit.Size(m)
+}
+func (m *Deposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_Deposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Deposit proto.InternalMessageInfo
+
+func (m *Deposit) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Deposit) GetCoins() []*hubpb.Coin {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+type QueryDepositRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryDepositRequest) Reset()         { *m = QueryDepositRequest{} }
+func (m *QueryDepositRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositRequest) ProtoMessage()    {}
+func (*QueryDepositRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6109e203c1b1d1f2, []int{1}
+}
+
+func (m *QueryDepositRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryDepositRequest.Unmarshal(m, b)
+}
+func (m *QueryDepositRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryDepositRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryDepositRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositRequest.Merge(m, src)
+}
+func (m *QueryDepositRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryDepositRequest.Size(m)
+}
+func (m *QueryDepositRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDepositRequest proto.InternalMessageInfo
+
+func (m *QueryDepositRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryDepositResponse struct {
+	Deposit              *Deposit `protobuf:"bytes,1,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryDepositResponse) Reset()         { *m = QueryDepositResponse{} }
+func (m *QueryDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositResponse) ProtoMessage()    {}
+func (*QueryDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6109e203c1b1d1f2, []int{2}
+}
+
+func (m *QueryDepositResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryDepositResponse.Unmarshal(m, b)
+}
+func (m *QueryDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryDepositResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositResponse.Merge(m, src)
+}
+func (m *QueryDepositResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryDepositResponse.Size(m)
+}
+func (m *QueryDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDepositResponse proto.InternalMessageInfo
+
+func (m *QueryDepositResponse) GetDeposit() *Deposit {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+type QueryDepositsRequest struct {
+	Pagination           *hubpb.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *QueryDepositsRequest) Reset()         { *m = QueryDepositsRequest{} }
+func (m *QueryDepositsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositsRequest) ProtoMessage()    {}
+func (*QueryDepositsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6109e203c1b1d1f2, []int{3}
+}
+
+func (m *QueryDepositsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryDepositsRequest.Unmarshal(m, b)
+}
+func (m *QueryDepositsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryDepositsRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryDepositsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositsRequest.Merge(m, src)
+}
+func (m *QueryDepositsRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryDepositsRequest.Size(m)
+}
+func (m *QueryDepositsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDepositsRequest proto.InternalMessageInfo
+
+func (m *QueryDepositsRequest) GetPagination() *hubpb.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryDepositsResponse struct {
+	Deposits             []*Deposit          `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits,omitempty"`
+	Pagination           *hubpb.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *QueryDepositsResponse) Reset()         { *m = QueryDepositsResponse{} }
+func (m *QueryDepositsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositsResponse) ProtoMessage()    {}
+func (*QueryDepositsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6109e203c1b1d1f2, []int{4}
+}
+
+func (m *QueryDepositsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryDepositsResponse.Unmarshal(m, b)
+}
+func (m *QueryDepositsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryDepositsResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryDepositsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositsResponse.Merge(m, src)
+}
+func (m *QueryDepositsResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryDepositsResponse.Size(m)
+}
+func (m *QueryDepositsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDepositsResponse proto.InternalMessageInfo
+
+func (m *QueryDepositsResponse) GetDeposits() []*Deposit {
+	if m != nil {
+		return m.Deposits
+	}
+	return nil
+}
+
+func (m *QueryDepositsResponse) GetPagination() *hubpb.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Deposit)(nil), "sentinel.deposit.v1.Deposit")
+	proto.RegisterType((*QueryDepositRequest)(nil), "sentinel.deposit.v1.QueryDepositRequest")
+	proto.RegisterType((*QueryDepositResponse)(nil), "sentinel.deposit.v1.QueryDepositResponse")
+	proto.RegisterType((*QueryDepositsRequest)(nil), "sentinel.deposit.v1.QueryDepositsRequest")
+	proto.RegisterType((*QueryDepositsResponse)(nil), "sentinel.deposit.v1.QueryDepositsResponse")
+}
+
+func init() { proto.RegisterFile("sentinel/deposit/v1/query.proto", fileDescriptor_6109e203c1b1d1f2) }
+
+var fileDescriptor_6109e203c1b1d1f2 = []byte{
+	// 341 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x4f, 0xc2, 0x30,
+	0x14, 0xc6, 0x33, 0x88, 0x82, 0x0f, 0x4f, 0x45, 0x12, 0x42, 0x30, 0x92, 0x9d, 0x50, 0x63, 0x17,
+	0xd0, 0x10, 0x0f, 0x7a, 0x51, 0xcf, 0x06, 0xa7, 0x27, 0x0f, 0x26, 0xdd, 0x28, 0xd0, 0xc4, 0xb5,
+	0x63, 0xdd, 0x16, 0xfd, 0x2f, 0xfc, 0xd7, 0xfc, 0x8f, 0xcc, 0xd6, 0x76, 0x32, 0x9d, 0xc2, 0x69,
+	0x59, 0xf2, 0x7d, 0xdf, 0xfb, 0x7d, 0x7d, 0x0f, 0x8e, 0x24, 0xe5, 0x31, 0xe3, 0xf4, 0xd5, 0x99,
+	0xd1, 0x50, 0x48, 0x16, 0x3b, 0xe9, 0xc8, 0x59, 0x25, 0x34, 0x7a, 0xc7, 0x61, 0x24, 0x62, 0x81,
+	0xda, 0x46, 0x80, 0xb5, 0x00, 0xa7, 0xa3, 0x5e, 0xbf, 0x70, 0x2d, 0x13, 0x2f, 0x73, 0xf8, 0x22,
+	0x08, 0x04, 0x57, 0x16, 0x7b, 0x0a, 0x8d, 0x3b, 0xa5, 0x45, 0x5d, 0x68, 0x90, 0xd9, 0x2c, 0xa2,
+	0x52, 0x76, 0xad, 0x81, 0x35, 0xdc, 0x73, 0xcd, 0x2f, 0x3a, 0x85, 0x1d, 0x5f, 0x30, 0x2e, 0xbb,
+	0xb5, 0x41, 0x7d, 0xd8, 0x1a, 0x77, 0x70, 0x31, 0x67, 0x99, 0x78, 0x38, 0x1d, 0xe1, 0x5b, 0xc1,
+	0xb8, 0xab, 0x34, 0xb6, 0x03, 0xed, 0x87, 0x8c, 0x49, 0xc7, 0xba, 0x74, 0x95, 0x50, 0xf9, 0x4f,
+	0xba, 0x7d, 0x0f, 0x07, 0x65, 0x83, 0x0c, 0x05, 0x97, 0x14, 0x4d, 0xa0, 0xa1, 0x6b, 0xe4, 0x8e,
+	0xd6, 0xb8, 0x8f, 0x2b, 0xfa, 0x61, 0x63, 0x33, 0x62, 0xfb, 0xa9, 0x9c, 0x27, 0x0d, 0xc1, 0x15,
+	0x40, 0x48, 0x16, 0x8c, 0x93, 0x98, 0x09, 0xfe, 0x3b, 0x52, 0x57, 0x99, 0x92, 0x05, 0xd5, 0x0e,
+	0x77, 0x4d, 0x6f, 0x7f, 0x58, 0xd0, 0xf9, 0x11, 0xab, 0x39, 0x2f, 0xa1, 0xa9, 0x47, 0x67, 0xd5,
+	0xea, 0x1b, 0x41, 0x0b, 0x35, 0xba, 0x2e, 0x11, 0xd5, 0x72, 0xa2, 0xc3, 0x3f, 0x88, 0xd4, 0xb0,
+	0x75, 0xa4, 0xf1, 0xa7, 0x05, 0xfb, 0x39, 0xd2, 0x23, 0x8d, 0x52, 0xe6, 0x53, 0xf4, 0xf2, 0xbd,
+	0xcc, 0x61, 0x25, 0x42, 0xc5, 0x62, 0x7a, 0xc7, 0x5b, 0x28, 0x75, 0x53, 0x02, 0x4d, 0xd3, 0x1e,
+	0x6d, 0xb6, 0x99, 0x87, 0xef, 0x9d, 0x6c, 0x23, 0x55, 0x23, 0x6e, 0x26, 0xcf, 0x17, 0x0b, 0x16,
+	0x67, 0xcd, 0x7d, 0x11, 0x38, 0xc6, 0x77, 0x26, 0xe6, 0x73, 0xe6, 0x33, 0xa2, 0x6e, 0xf8, 0xad,
+	0xb8, 0x7f, 0xfd, 0x0d, 0x3d, 0x6f, 0x37, 0x3f, 0xe7, 0xf3, 0xaf, 0x01, 0x00, 0xb4, 0x8f, 0x64,
+	0xd4, 0x24, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryServiceClient is the client API for QueryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryServiceClient interface {
+	Deposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error)
+	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
+}
+
+type queryServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewQueryServiceClient(cc *grpc.ClientConn) QueryServiceClient {
+	return &queryServiceClient{cc}
+}
+
+func (c *queryServiceClient) Deposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error) {
+	out := new(QueryDepositResponse)
+	err := c.cc.Invoke(ctx, "/sentinel.deposit.v1.QueryService/Deposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error) {
+	out := new(QueryDepositsResponse)
+	err := c.cc.Invoke(ctx, "/sentinel.deposit.v1.QueryService/Deposits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServiceServer is the server API for QueryService service.
+type QueryServiceServer interface {
+	Deposit(context.Context, *QueryDepositRequest) (*QueryDepositResponse, error)
+	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
+}
+
+// UnimplementedQueryServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServiceServer struct {
+}
+
+func (*UnimplementedQueryServiceServer) Deposit(ctx context.Context, req *QueryDepositRequest) (*QueryDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+}
+func (*UnimplementedQueryServiceServer) Deposits(ctx context.Context, req *QueryDepositsRequest) (*QueryDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposits not implemented")
+}
+
+func RegisterQueryServiceServer(s *grpc.Server, srv QueryServiceServer) {
+	s.RegisterService(&_QueryService_serviceDesc, srv)
+}
+
+func _QueryService_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Deposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sentinel.deposit.v1.QueryService/Deposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Deposit(ctx, req.(*QueryDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_Deposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Deposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sentinel.deposit.v1.QueryService/Deposits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Deposits(ctx, req.(*QueryDepositsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _QueryService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sentinel.deposit.v1.QueryService",
+	HandlerType: (*QueryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Deposit",
+			Handler:    _QueryService_Deposit_Handler,
+		},
+		{
+			MethodName: "Deposits",
+			Handler:    _QueryService_Deposits_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sentinel/deposit/v1/query.proto",
+}
@@ -9,20 +9,28 @@ import (
 )
 
 func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
-	for _, deposit := range data {
+	for _, deposit := range data.Deposits {
 		k.SetDeposit(ctx, deposit)
 	}
+	
+	for _, entry := range data.LedgerEntries {
+		k.SetLedgerEntry(ctx, entry)
+	}
+	
+	k.SetLedgerEntriesCount(ctx, data.LedgerEntriesCount)
 }
 
 func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 	deposits := k.GetAllDeposits(ctx)
+	ledgerEntries := k.GetAllLedgerEntries(ctx)
+	ledgerEntriesCount := k.GetLedgerEntriesCount(ctx)
 	
-	return types.NewGenesisState(deposits)
+	return types.NewGenesisState(deposits, ledgerEntries, ledgerEntriesCount)
 }
 
 func ValidateGenesis(data types.GenesisState) error {
-	addressMap := make(map[string]bool, len(data))
-	for _, deposit := range data {
+	addressMap := make(map[string]bool, len(data.Deposits))
+	for _, deposit := range data.Deposits {
 		if err := deposit.IsValid(); err != nil {
 			return fmt.Errorf("%s for the %s", err.Error(), deposit)
 		}
@@ -35,5 +43,22 @@ func ValidateGenesis(data types.GenesisState) error {
 		addressMap[addressStr] = true
 	}
 	
+	entryIDsMap := make(map[uint64]bool, len(data.LedgerEntries))
+	for _, entry := range data.LedgerEntries {
+		if err := entry.IsValid(); err != nil {
+			return fmt.Errorf("%s for the %s", err.Error(), entry)
+		}
+		
+		if entry.ID >= data.LedgerEntriesCount {
+			return fmt.Errorf("id exceeds the ledger entries count %d for the %s", data.LedgerEntriesCount, entry)
+		}
+		
+		if entryIDsMap[entry.ID] {
+			return fmt.Errorf("duplicate id for the %s", entry)
+		}
+		
+		entryIDsMap[entry.ID] = true
+	}
+	
 	return nil
 }
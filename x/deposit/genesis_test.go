@@ -0,0 +1,57 @@
+package deposit
+
+import (
+	"testing"
+	"time"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	
+	"github.com/sentinel-official/hub/x/deposit/keeper"
+	"github.com/sentinel-official/hub/x/deposit/types"
+)
+
+func TestGenesis_LedgerEntries(t *testing.T) {
+	ctx, dk, bk := keeper.CreateTestInput(t, false)
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	
+	_, err := bk.AddCoins(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	require.Nil(t, dk.Add(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 100)}))
+	require.Nil(t, dk.Lock(ctx, types.TestAddress1, "subs0", sdk.Coins{sdk.NewInt64Coin("stake", 60)},
+		types.ReasonSubscriptionEscrow))
+	
+	state := ExportGenesis(ctx, dk)
+	require.Len(t, state.Deposits, 1)
+	require.Len(t, state.LedgerEntries, 2)
+	require.Equal(t, uint64(2), state.LedgerEntriesCount)
+	require.Nil(t, ValidateGenesis(state))
+	
+	ctx, dk, _ = keeper.CreateTestInput(t, false)
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC))
+	
+	InitGenesis(ctx, dk, state)
+	require.Equal(t, state, ExportGenesis(ctx, dk))
+	require.Equal(t, state.LedgerEntries, dk.GetLedgerEntriesOfAddress(ctx, types.TestAddress1,
+		time.Time{}, time.Time{}, 0, 0))
+	
+	require.Nil(t, dk.Unlock(ctx, types.TestAddress1, "subs0", sdk.Coins{sdk.NewInt64Coin("stake", 60)},
+		types.ReasonRefund))
+	entry, found := dk.GetLedgerEntry(ctx, 2)
+	require.Equal(t, true, found)
+	require.Equal(t, types.ActionUnlock, entry.Action)
+	
+	state.LedgerEntriesCount = 1
+	require.NotNil(t, ValidateGenesis(state))
+	
+	state.LedgerEntriesCount = 2
+	state.LedgerEntries = append(state.LedgerEntries, state.LedgerEntries[0])
+	require.NotNil(t, ValidateGenesis(state))
+	
+	entry = state.LedgerEntries[0]
+	entry.Action = "burn"
+	state.LedgerEntries = types.LedgerEntries{entry}
+	require.NotNil(t, ValidateGenesis(state))
+	
+	require.Nil(t, ValidateGenesis(types.DefaultGenesisState()))
+}
@@ -0,0 +1,57 @@
+package deposit
+
+import (
+	"reflect"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/sentinel-official/hub/x/deposit/keeper"
+	"github.com/sentinel-official/hub/x/deposit/types"
+)
+
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		
+		switch msg := msg.(type) {
+		case types.MsgDeposit:
+			return handleDeposit(ctx, k, msg)
+		case types.MsgWithdraw:
+			return handleWithdraw(ctx, k, msg)
+		default:
+			return types.ErrorUnknownMsgType(reflect.TypeOf(msg).Name()).Result()
+		}
+	}
+}
+
+func handleDeposit(ctx sdk.Context, k keeper.Keeper, msg types.MsgDeposit) sdk.Result {
+	if err := k.Add(ctx, msg.From, msg.Coins); err != nil {
+		return err.Result()
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMsgDeposit,
+			sdk.NewAttribute(types.AttributeKeyFromAddress, msg.From.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Coins.String()),
+		),
+	)
+	
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleWithdraw(ctx sdk.Context, k keeper.Keeper, msg types.MsgWithdraw) sdk.Result {
+	if err := k.Subtract(ctx, msg.From, msg.Coins); err != nil {
+		return err.Result()
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMsgWithdraw,
+			sdk.NewAttribute(types.AttributeKeyFromAddress, msg.From.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Coins.String()),
+		),
+	)
+	
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
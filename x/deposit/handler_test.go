@@ -0,0 +1,42 @@
+package deposit
+
+import (
+	"testing"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	
+	"github.com/sentinel-official/hub/x/deposit/keeper"
+	"github.com/sentinel-official/hub/x/deposit/types"
+)
+
+func TestHandler(t *testing.T) {
+	ctx, dk, bk := keeper.CreateTestInput(t, false)
+	handler := NewHandler(dk)
+	
+	res := handler(ctx, *types.NewMsgDeposit(types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 100)}))
+	require.False(t, res.IsOK())
+	
+	_, err := bk.AddCoins(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	
+	res = handler(ctx, *types.NewMsgDeposit(types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 100)}))
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.Coins(nil), bk.GetCoins(ctx, types.TestAddress1))
+	
+	require.Nil(t, dk.Lock(ctx, types.TestAddress1, "subs0", sdk.Coins{sdk.NewInt64Coin("stake", 60)}, types.ReasonSubscriptionEscrow))
+	
+	res = handler(ctx, *types.NewMsgWithdraw(types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 50)}))
+	require.False(t, res.IsOK())
+	
+	res = handler(ctx, *types.NewMsgWithdraw(types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 40)}))
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 40)}, bk.GetCoins(ctx, types.TestAddress1))
+	
+	deposit, _ := dk.GetDeposit(ctx, types.TestAddress1)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 60)}, deposit.Coins)
+	require.True(t, deposit.Free().IsZero())
+	
+	res = handler(ctx, sdk.NewTestMsg())
+	require.False(t, res.IsOK())
+}
@@ -42,6 +42,7 @@ func (k Keeper) GetAllDeposits(ctx sdk.Context) (deposits []types.Deposit) {
 	return deposits
 }
 
+// Add sends the coins from the account of the address to the free deposit of the address
 func (k Keeper) Add(ctx sdk.Context, address sdk.AccAddress, coins sdk.Coins) (err sdk.Error) {
 	if err := k.supply.SendCoinsFromAccountToModule(ctx, address, types.ModuleName, coins); err != nil {
 		return err
@@ -61,48 +62,82 @@ func (k Keeper) Add(ctx sdk.Context, address sdk.AccAddress, coins sdk.Coins) (e
 	}
 	
 	k.SetDeposit(ctx, deposit)
+	k.appendLedgerEntry(ctx, types.NewLedgerEntry(address, types.ActionAdd, types.ReasonDeposit, nil, "", coins))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAddDeposit,
+			sdk.NewAttribute(types.AttributeKeyAddress, address.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, coins.String()),
+		),
+	)
+	
 	return nil
 }
 
+// Subtract sends the coins from the free deposit of the address back to the address
 func (k Keeper) Subtract(ctx sdk.Context, address sdk.AccAddress, coins sdk.Coins) (err sdk.Error) {
 	deposit, found := k.GetDeposit(ctx, address)
 	if !found {
 		return types.ErrorInsufficientDepositFunds(coins, deposit.Coins)
 	}
-	
-	deposit.Coins, _ = deposit.Coins.SafeSub(coins)
-	if deposit.Coins.IsAnyNegative() {
-		return types.ErrorInsufficientDepositFunds(coins, deposit.Coins)
+	if !deposit.Free().IsAllGTE(coins) {
+		return types.ErrorInsufficientDepositFunds(coins, deposit.Free())
 	}
 	
+	deposit.Coins = deposit.Coins.Sub(coins)
+	
 	if err := k.supply.SendCoinsFromModuleToAccount(ctx, types.ModuleName, address, coins); err != nil {
 		return err
 	}
 	
 	k.SetDeposit(ctx, deposit)
+	k.appendLedgerEntry(ctx, types.NewLedgerEntry(address, types.ActionSubtract, types.ReasonWithdraw, nil, "", coins))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubtractDeposit,
+			sdk.NewAttribute(types.AttributeKeyAddress, address.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, coins.String()),
+		),
+	)
+	
 	return nil
 }
 
-func (k Keeper) SendFromDepositToAccount(ctx sdk.Context, from, to sdk.AccAddress, coins sdk.Coins) sdk.Error {
+// SendFromDepositToAccount sends the coins from the free deposit of the address from to the account of the address to
+func (k Keeper) SendFromDepositToAccount(ctx sdk.Context, from, to sdk.AccAddress,
+	coins sdk.Coins, reason string) sdk.Error {
 	deposit, found := k.GetDeposit(ctx, from)
 	if !found {
 		return types.ErrorInsufficientDepositFunds(coins, deposit.Coins)
 	}
-	
-	deposit.Coins, _ = deposit.Coins.SafeSub(coins)
-	if deposit.Coins.IsAnyNegative() {
-		return types.ErrorInsufficientDepositFunds(coins, deposit.Coins)
+	if !deposit.Free().IsAllGTE(coins) {
+		return types.ErrorInsufficientDepositFunds(coins, deposit.Free())
 	}
 	
+	deposit.Coins = deposit.Coins.Sub(coins)
+	
 	if err := k.supply.SendCoinsFromModuleToAccount(ctx, types.ModuleName, to, coins); err != nil {
 		return err
 	}
 	
 	k.SetDeposit(ctx, deposit)
+	k.appendLedgerEntry(ctx, types.NewLedgerEntry(from, types.ActionSend, reason, to, "", coins))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSendFromDeposit,
+			sdk.NewAttribute(types.AttributeKeyFromAddress, from.String()),
+			sdk.NewAttribute(types.AttributeKeyToAddress, to.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, coins.String()),
+		),
+	)
+	
 	return nil
 }
 
-func (k Keeper) ReceiveFromAccountToDeposit(ctx sdk.Context, from, to sdk.AccAddress, coins sdk.Coins) sdk.Error {
+// ReceiveFromAccountToDeposit sends the coins from the account of the address from
+// to the free deposit of the address to
+func (k Keeper) ReceiveFromAccountToDeposit(ctx sdk.Context, from, to sdk.AccAddress,
+	coins sdk.Coins, reason string) sdk.Error {
 	if err := k.supply.SendCoinsFromAccountToModule(ctx, from, types.ModuleName, coins); err != nil {
 		return err
 	}
@@ -121,6 +156,139 @@ func (k Keeper) ReceiveFromAccountToDeposit(ctx sdk.Context, from, to sdk.AccAdd
 	}
 	
 	k.SetDeposit(ctx, deposit)
+	k.appendLedgerEntry(ctx, types.NewLedgerEntry(to, types.ActionReceive, reason, from, "", coins))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReceiveDeposit,
+			sdk.NewAttribute(types.AttributeKeyFromAddress, from.String()),
+			sdk.NewAttribute(types.AttributeKeyToAddress, to.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, coins.String()),
+		),
+	)
+	
+	return nil
+}
+
+// Lock locks the coins of the deposit of the address for the holder. The coins are taken from the free deposit
+// first, and the rest is sent from the account of the address. The reason is written to the ledger.
+func (k Keeper) Lock(ctx sdk.Context, address sdk.AccAddress, holder string,
+	coins sdk.Coins, reason string) sdk.Error {
+	if !coins.IsValid() || !coins.IsAllPositive() {
+		return types.ErrorInvalidField("coins")
+	}
+	
+	deposit, found := k.GetDeposit(ctx, address)
+	if !found {
+		deposit = types.Deposit{
+			Address: address,
+			Coins:   sdk.Coins{},
+		}
+	}
+	
+	free := deposit.Free()
+	required := sdk.Coins{}
+	for _, coin := range coins {
+		if amount := free.AmountOf(coin.Denom); amount.LT(coin.Amount) {
+			required = required.Add(sdk.Coins{sdk.NewCoin(coin.Denom, coin.Amount.Sub(amount))})
+		}
+	}
+	
+	if !required.IsZero() {
+		if err := k.supply.SendCoinsFromAccountToModule(ctx, address, types.ModuleName, required); err != nil {
+			return err
+		}
+		
+		deposit.Coins = deposit.Coins.Add(required)
+		k.appendLedgerEntry(ctx, types.NewLedgerEntry(address, types.ActionAdd, reason, nil, holder, required))
+	}
+	
+	lock, _ := deposit.GetLock(holder)
+	lock.Coins = lock.Coins.Add(coins)
+	if lock.Coins.IsAnyNegative() {
+		return types.ErrorInsufficientLockedFunds(holder, lock.Coins, coins)
+	}
+	
+	deposit.SetLock(lock)
+	k.SetDeposit(ctx, deposit)
+	k.appendLedgerEntry(ctx, types.NewLedgerEntry(address, types.ActionLock, reason, nil, holder, coins))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeLockDeposit,
+			sdk.NewAttribute(types.AttributeKeyAddress, address.String()),
+			sdk.NewAttribute(types.AttributeKeyHolder, holder),
+			sdk.NewAttribute(types.AttributeKeyAmount, coins.String()),
+		),
+	)
+	
+	return nil
+}
+
+func (k Keeper) subtractLock(ctx sdk.Context, address sdk.AccAddress,
+	holder string, coins sdk.Coins) (types.Deposit, sdk.Error) {
+	deposit, _ := k.GetDeposit(ctx, address)
+	if !coins.IsValid() || !coins.IsAllPositive() {
+		return deposit, types.ErrorInvalidField("coins")
+	}
+	
+	lock, _ := deposit.GetLock(holder)
+	if !lock.Coins.IsAllGTE(coins) {
+		return deposit, types.ErrorInsufficientLockedFunds(holder, lock.Coins, coins)
+	}
+	
+	lock.Coins = lock.Coins.Sub(coins)
+	deposit.SetLock(lock)
+	
+	return deposit, nil
+}
+
+// Unlock releases the coins locked by the holder to the free deposit of the address
+func (k Keeper) Unlock(ctx sdk.Context, address sdk.AccAddress, holder string,
+	coins sdk.Coins, reason string) sdk.Error {
+	deposit, err := k.subtractLock(ctx, address, holder, coins)
+	if err != nil {
+		return err
+	}
+	
+	k.SetDeposit(ctx, deposit)
+	k.appendLedgerEntry(ctx, types.NewLedgerEntry(address, types.ActionUnlock, reason, nil, holder, coins))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnlockDeposit,
+			sdk.NewAttribute(types.AttributeKeyAddress, address.String()),
+			sdk.NewAttribute(types.AttributeKeyHolder, holder),
+			sdk.NewAttribute(types.AttributeKeyAmount, coins.String()),
+		),
+	)
+	
+	return nil
+}
+
+// SendFromLockToAccount sends the coins locked by the holder in the deposit of the address from
+// to the account of the address to
+func (k Keeper) SendFromLockToAccount(ctx sdk.Context, from sdk.AccAddress, holder string,
+	to sdk.AccAddress, coins sdk.Coins, reason string) sdk.Error {
+	deposit, err := k.subtractLock(ctx, from, holder, coins)
+	if err != nil {
+		return err
+	}
+	
+	if err := k.supply.SendCoinsFromModuleToAccount(ctx, types.ModuleName, to, coins); err != nil {
+		return err
+	}
+	
+	deposit.Coins = deposit.Coins.Sub(coins)
+	k.SetDeposit(ctx, deposit)
+	k.appendLedgerEntry(ctx, types.NewLedgerEntry(from, types.ActionSend, reason, to, holder, coins))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSendFromDeposit,
+			sdk.NewAttribute(types.AttributeKeyFromAddress, from.String()),
+			sdk.NewAttribute(types.AttributeKeyHolder, holder),
+			sdk.NewAttribute(types.AttributeKeyToAddress, to.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, coins.String()),
+		),
+	)
+	
 	return nil
 }
 
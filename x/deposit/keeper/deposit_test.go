@@ -28,20 +28,20 @@ func TestKeeper_SetDeposit(t *testing.T) {
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, false, found)
 	require.Equal(t, types.Deposit{}, deposit)
-	dk.SetDeposit(ctx, types.Deposit{types.TestAddress1, sdk.Coins{sdk.Coin{"stake", sdk.NewInt(-10)}}})
+	dk.SetDeposit(ctx, types.Deposit{Address: types.TestAddress1, Coins: sdk.Coins{sdk.Coin{"stake", sdk.NewInt(-10)}}})
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, true, found)
-	require.Equal(t, types.Deposit{types.TestAddress1, sdk.Coins{sdk.Coin{"stake", sdk.NewInt(-10)}}}, deposit)
+	require.Equal(t, types.Deposit{Address: types.TestAddress1, Coins: sdk.Coins{sdk.Coin{"stake", sdk.NewInt(-10)}}}, deposit)
 	
-	dk.SetDeposit(ctx, types.Deposit{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 0)}})
+	dk.SetDeposit(ctx, types.Deposit{Address: types.TestAddress1, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 0)}})
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, true, found)
-	require.Equal(t, types.Deposit{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 0)}}, deposit)
+	require.Equal(t, types.Deposit{Address: types.TestAddress1, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 0)}}, deposit)
 	
-	dk.SetDeposit(ctx, types.Deposit{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}})
-	deposit, found = dk.GetDeposit(ctx, types.Deposit{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}}.Address)
+	dk.SetDeposit(ctx, types.Deposit{Address: types.TestAddress1, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 10)}})
+	deposit, found = dk.GetDeposit(ctx, types.Deposit{Address: types.TestAddress1, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 10)}}.Address)
 	require.Equal(t, true, found)
-	require.Equal(t, types.Deposit{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}}, deposit)
+	require.Equal(t, types.Deposit{Address: types.TestAddress1, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 10)}}, deposit)
 }
 
 func TestKeeper_GetDeposit(t *testing.T) {
@@ -55,10 +55,10 @@ func TestKeeper_GetAllDeposits(t *testing.T) {
 	require.Len(t, deposits, 0)
 	require.Equal(t, []types.Deposit(nil), deposits)
 	
-	dk.SetDeposit(ctx, types.Deposit{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}})
+	dk.SetDeposit(ctx, types.Deposit{Address: types.TestAddress1, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 10)}})
 	deposits = dk.GetAllDeposits(ctx)
 	require.Len(t, deposits, 1)
-	require.Equal(t, []types.Deposit{{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}}}, deposits)
+	require.Equal(t, []types.Deposit{{Address: types.TestAddress1, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 10)}}}, deposits)
 	
 	depositPos2 := types.Deposit{Address: types.TestAddress2, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 10)}}
 	dk.SetDeposit(ctx, depositPos2)
@@ -73,31 +73,31 @@ func TestKeeper_Add(t *testing.T) {
 	require.Nil(t, err)
 	deposit, found := dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, true, found)
-	require.Equal(t, types.Deposit{types.TestAddress1, sdk.Coins(nil)}, deposit)
+	require.Equal(t, types.Deposit{Address: types.TestAddress1, Coins: sdk.Coins(nil)}, deposit)
 	
 	err = dk.Add(ctx, types.TestAddress1, sdk.Coins(nil))
 	require.Nil(t, err)
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, true, found)
-	require.Equal(t, types.Deposit{types.TestAddress1, sdk.Coins(nil)}, deposit)
+	require.Equal(t, types.Deposit{Address: types.TestAddress1, Coins: sdk.Coins(nil)}, deposit)
 	
 	err = dk.Add(ctx, types.TestAddress1, sdk.Coins{sdk.Coin{"stake", sdk.NewInt(-10)}})
 	require.NotNil(t, err)
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, true, found)
-	require.Equal(t, types.Deposit{types.TestAddress1, sdk.Coins(nil)}, deposit)
+	require.Equal(t, types.Deposit{Address: types.TestAddress1, Coins: sdk.Coins(nil)}, deposit)
 	
 	err = dk.Add(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 0)})
 	require.NotNil(t, err)
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, true, found)
-	require.Equal(t, types.Deposit{types.TestAddress1, sdk.Coins(nil)}, deposit)
+	require.Equal(t, types.Deposit{Address: types.TestAddress1, Coins: sdk.Coins(nil)}, deposit)
 	
 	err = dk.Add(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)})
 	require.NotNil(t, err)
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, true, found)
-	require.Equal(t, types.Deposit{types.TestAddress1, sdk.Coins(nil)}, deposit)
+	require.Equal(t, types.Deposit{Address: types.TestAddress1, Coins: sdk.Coins(nil)}, deposit)
 	
 	coins, err := bk.AddCoins(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)})
 	require.Nil(t, err)
@@ -108,14 +108,14 @@ func TestKeeper_Add(t *testing.T) {
 	require.Nil(t, err)
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, true, found)
-	require.Equal(t, types.Deposit{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}}, deposit)
+	require.Equal(t, types.Deposit{Address: types.TestAddress1, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 10)}}, deposit)
 	require.Equal(t, sdk.Coins(nil), bk.GetCoins(ctx, types.TestAddress1))
 	
 	err = dk.Add(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)})
 	require.NotNil(t, err)
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, true, found)
-	require.Equal(t, types.Deposit{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}}, deposit)
+	require.Equal(t, types.Deposit{Address: types.TestAddress1, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 10)}}, deposit)
 	require.Equal(t, sdk.Coins(nil), bk.GetCoins(ctx, types.TestAddress1))
 	
 	coinsPos2 := sdk.Coins{sdk.NewInt64Coin("stake", 10)}.Add(sdk.Coins{sdk.NewInt64Coin("stake", 10)})
@@ -164,34 +164,34 @@ func TestKeeper_Subtract(t *testing.T) {
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, false, found)
 	require.Equal(t, types.Deposit{}, deposit)
-	dk.SetDeposit(ctx, types.Deposit{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}})
+	dk.SetDeposit(ctx, types.Deposit{Address: types.TestAddress1, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 10)}})
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, true, found)
-	require.Equal(t, types.Deposit{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}}, deposit)
+	require.Equal(t, types.Deposit{Address: types.TestAddress1, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 10)}}, deposit)
 	
 	err = dk.Subtract(ctx, types.TestAddress1, sdk.Coins{})
 	require.Nil(t, err)
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, true, found)
-	require.Equal(t, types.Deposit{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}}, deposit)
+	require.Equal(t, types.Deposit{Address: types.TestAddress1, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 10)}}, deposit)
 	
 	err = dk.Subtract(ctx, types.TestAddress1, sdk.Coins(nil))
 	require.Nil(t, err)
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, true, found)
-	require.Equal(t, types.Deposit{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}}, deposit)
+	require.Equal(t, types.Deposit{Address: types.TestAddress1, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 10)}}, deposit)
 	
 	err = dk.Subtract(ctx, types.TestAddress1, sdk.Coins{sdk.Coin{"stake", sdk.NewInt(-10)}})
 	require.NotNil(t, err)
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, true, found)
-	require.Equal(t, types.Deposit{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}}, deposit)
+	require.Equal(t, types.Deposit{Address: types.TestAddress1, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 10)}}, deposit)
 	
 	err = dk.Subtract(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 0)})
 	require.NotNil(t, err)
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, true, found)
-	require.Equal(t, types.Deposit{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}}, deposit)
+	require.Equal(t, types.Deposit{Address: types.TestAddress1, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 10)}}, deposit)
 	
 	err = dk.Subtract(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)})
 	require.NotNil(t, err)
@@ -209,51 +209,51 @@ func TestKeeper_SendFromDepositToAccount(t *testing.T) {
 	require.Equal(t, false, found)
 	require.Equal(t, types.Deposit{}, deposit)
 	
-	err := dk.SendFromDepositToAccount(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{})
+	err := dk.SendFromDepositToAccount(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{}, types.ReasonTransfer)
 	require.NotNil(t, err)
-	err = dk.SendFromDepositToAccount(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins(nil))
+	err = dk.SendFromDepositToAccount(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins(nil), types.ReasonTransfer)
 	require.NotNil(t, err)
-	err = dk.SendFromDepositToAccount(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.Coin{"stake", sdk.NewInt(-10)}})
+	err = dk.SendFromDepositToAccount(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.Coin{"stake", sdk.NewInt(-10)}}, types.ReasonTransfer)
 	require.NotNil(t, err)
-	err = dk.SendFromDepositToAccount(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 0)})
+	err = dk.SendFromDepositToAccount(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 0)}, types.ReasonTransfer)
 	require.NotNil(t, err)
-	err = dk.SendFromDepositToAccount(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 10)})
+	err = dk.SendFromDepositToAccount(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, types.ReasonTransfer)
 	require.NotNil(t, err)
 	
-	dk.SetDeposit(ctx, types.Deposit{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}})
-	err = dk.SendFromDepositToAccount(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{})
+	dk.SetDeposit(ctx, types.Deposit{Address: types.TestAddress1, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 10)}})
+	err = dk.SendFromDepositToAccount(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{}, types.ReasonTransfer)
 	require.Nil(t, err)
 	coins := bk.GetCoins(ctx, types.TestAddress2)
 	require.Equal(t, sdk.Coins(nil), coins)
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, true, found)
-	require.Equal(t, types.Deposit{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}}, deposit)
+	require.Equal(t, types.Deposit{Address: types.TestAddress1, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 10)}}, deposit)
 	
-	err = dk.SendFromDepositToAccount(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins(nil))
+	err = dk.SendFromDepositToAccount(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins(nil), types.ReasonTransfer)
 	require.Nil(t, err)
 	coins = bk.GetCoins(ctx, types.TestAddress2)
 	require.Equal(t, sdk.Coins(nil), coins)
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, true, found)
-	require.Equal(t, types.Deposit{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}}, deposit)
+	require.Equal(t, types.Deposit{Address: types.TestAddress1, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 10)}}, deposit)
 	
-	err = dk.SendFromDepositToAccount(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.Coin{"stake", sdk.NewInt(-10)}})
+	err = dk.SendFromDepositToAccount(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.Coin{"stake", sdk.NewInt(-10)}}, types.ReasonTransfer)
 	require.NotNil(t, err)
 	coins = bk.GetCoins(ctx, types.TestAddress2)
 	require.Equal(t, sdk.Coins(nil), coins)
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, true, found)
-	require.Equal(t, types.Deposit{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}}, deposit)
+	require.Equal(t, types.Deposit{Address: types.TestAddress1, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 10)}}, deposit)
 	
-	err = dk.SendFromDepositToAccount(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 0)})
+	err = dk.SendFromDepositToAccount(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 0)}, types.ReasonTransfer)
 	require.NotNil(t, err)
 	coins = bk.GetCoins(ctx, types.TestAddress2)
 	require.Equal(t, sdk.Coins(nil), coins)
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, true, found)
-	require.Equal(t, types.Deposit{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}}, deposit)
+	require.Equal(t, types.Deposit{Address: types.TestAddress1, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 10)}}, deposit)
 	
-	err = dk.SendFromDepositToAccount(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 10)})
+	err = dk.SendFromDepositToAccount(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, types.ReasonTransfer)
 	require.NotNil(t, err)
 	coins = bk.GetCoins(ctx, types.TestAddress2)
 	require.Equal(t, sdk.Coins(nil), coins)
@@ -272,15 +272,15 @@ func TestKeeper_ReceiveFromAccountToDeposit(t *testing.T) {
 	coins := bk.GetCoins(ctx, types.TestAddress1)
 	require.Equal(t, sdk.Coins{}, coins)
 	
-	err := dk.ReceiveFromAccountToDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{})
+	err := dk.ReceiveFromAccountToDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{}, types.ReasonTransfer)
 	require.Nil(t, err)
-	err = dk.ReceiveFromAccountToDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins(nil))
+	err = dk.ReceiveFromAccountToDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins(nil), types.ReasonTransfer)
 	require.Nil(t, err)
-	err = dk.ReceiveFromAccountToDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.Coin{"stake", sdk.NewInt(-10)}})
+	err = dk.ReceiveFromAccountToDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.Coin{"stake", sdk.NewInt(-10)}}, types.ReasonTransfer)
 	require.NotNil(t, err)
-	err = dk.ReceiveFromAccountToDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 0)})
+	err = dk.ReceiveFromAccountToDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 0)}, types.ReasonTransfer)
 	require.NotNil(t, err)
-	err = dk.ReceiveFromAccountToDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 10)})
+	err = dk.ReceiveFromAccountToDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, types.ReasonTransfer)
 	require.NotNil(t, err)
 	
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress2)
@@ -290,7 +290,7 @@ func TestKeeper_ReceiveFromAccountToDeposit(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, coins)
 	
-	err = dk.ReceiveFromAccountToDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{})
+	err = dk.ReceiveFromAccountToDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{}, types.ReasonTransfer)
 	require.Nil(t, err)
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress2)
 	require.Equal(t, true, found)
@@ -298,7 +298,7 @@ func TestKeeper_ReceiveFromAccountToDeposit(t *testing.T) {
 	coins = bk.GetCoins(ctx, types.TestAddress1)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, coins)
 	
-	err = dk.ReceiveFromAccountToDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins(nil))
+	err = dk.ReceiveFromAccountToDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins(nil), types.ReasonTransfer)
 	require.Nil(t, err)
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress2)
 	require.Equal(t, true, found)
@@ -306,7 +306,7 @@ func TestKeeper_ReceiveFromAccountToDeposit(t *testing.T) {
 	coins = bk.GetCoins(ctx, types.TestAddress1)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, coins)
 	
-	err = dk.ReceiveFromAccountToDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.Coin{"stake", sdk.NewInt(-10)}})
+	err = dk.ReceiveFromAccountToDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.Coin{"stake", sdk.NewInt(-10)}}, types.ReasonTransfer)
 	require.NotNil(t, err)
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress2)
 	require.Equal(t, true, found)
@@ -314,7 +314,7 @@ func TestKeeper_ReceiveFromAccountToDeposit(t *testing.T) {
 	coins = bk.GetCoins(ctx, types.TestAddress1)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, coins)
 	
-	err = dk.ReceiveFromAccountToDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 0)})
+	err = dk.ReceiveFromAccountToDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 0)}, types.ReasonTransfer)
 	require.NotNil(t, err)
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress2)
 	require.Equal(t, true, found)
@@ -322,7 +322,7 @@ func TestKeeper_ReceiveFromAccountToDeposit(t *testing.T) {
 	coins = bk.GetCoins(ctx, types.TestAddress1)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, coins)
 	
-	err = dk.ReceiveFromAccountToDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 10)})
+	err = dk.ReceiveFromAccountToDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, types.ReasonTransfer)
 	require.Nil(t, err)
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress2)
 	require.Equal(t, true, found)
@@ -330,7 +330,7 @@ func TestKeeper_ReceiveFromAccountToDeposit(t *testing.T) {
 	coins = bk.GetCoins(ctx, types.TestAddress1)
 	require.Equal(t, sdk.Coins(nil), coins)
 	
-	err = dk.ReceiveFromAccountToDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 10)})
+	err = dk.ReceiveFromAccountToDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, types.ReasonTransfer)
 	require.NotNil(t, err)
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress2)
 	require.Equal(t, true, found)
@@ -341,7 +341,7 @@ func TestKeeper_ReceiveFromAccountToDeposit(t *testing.T) {
 	coins, err = bk.AddCoins(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}.Add(sdk.Coins{sdk.NewInt64Coin("stake", 10)}))
 	require.Nil(t, err)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 10)}.Add(sdk.Coins{sdk.NewInt64Coin("stake", 10)}), coins)
-	err = dk.ReceiveFromAccountToDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 10)})
+	err = dk.ReceiveFromAccountToDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, types.ReasonTransfer)
 	require.Nil(t, err)
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress2)
 	require.Equal(t, true, found)
@@ -349,3 +349,93 @@ func TestKeeper_ReceiveFromAccountToDeposit(t *testing.T) {
 	coins = bk.GetCoins(ctx, types.TestAddress1)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, coins)
 }
+
+func TestKeeper_Events(t *testing.T) {
+	ctx, dk, bk := CreateTestInput(t, false)
+	
+	_, err := bk.AddCoins(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)})
+	require.Nil(t, err)
+	
+	require.Nil(t, dk.Add(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}))
+	require.Nil(t, dk.SendFromDepositToAccount(ctx, types.TestAddress1, types.TestAddress2,
+		sdk.Coins{sdk.NewInt64Coin("stake", 4)}, types.ReasonTransfer))
+	require.Nil(t, dk.Subtract(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 6)}))
+	
+	var events sdk.Events
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != sdk.EventTypeMessage && event.Type != "transfer" {
+			events = append(events, event)
+		}
+	}
+	
+	require.Len(t, events, 3)
+	require.Equal(t, sdk.NewEvent(types.EventTypeAddDeposit,
+		sdk.NewAttribute(types.AttributeKeyAddress, types.TestAddress1.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, "10stake"),
+	), events[0])
+	require.Equal(t, sdk.NewEvent(types.EventTypeSendFromDeposit,
+		sdk.NewAttribute(types.AttributeKeyFromAddress, types.TestAddress1.String()),
+		sdk.NewAttribute(types.AttributeKeyToAddress, types.TestAddress2.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, "4stake"),
+	), events[1])
+	require.Equal(t, types.EventTypeSubtractDeposit, events[2].Type)
+}
+
+func TestKeeper_Lock(t *testing.T) {
+	ctx, dk, bk := CreateTestInput(t, false)
+	
+	_, err := bk.AddCoins(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	require.Nil(t, dk.Add(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 30)}))
+	
+	require.Nil(t, dk.Lock(ctx, types.TestAddress1, "subs0", sdk.Coins{sdk.NewInt64Coin("stake", 20)}, types.ReasonSubscriptionEscrow))
+	deposit, _ := dk.GetDeposit(ctx, types.TestAddress1)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 30)}, deposit.Coins)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, deposit.Free())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 70)}, bk.GetCoins(ctx, types.TestAddress1))
+	
+	require.Nil(t, dk.Lock(ctx, types.TestAddress1, "subs1", sdk.Coins{sdk.NewInt64Coin("stake", 40)}, types.ReasonSubscriptionEscrow))
+	deposit, _ = dk.GetDeposit(ctx, types.TestAddress1)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 60)}, deposit.Coins)
+	require.True(t, deposit.Free().IsZero())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 40)}, bk.GetCoins(ctx, types.TestAddress1))
+	
+	require.NotNil(t, dk.Lock(ctx, types.TestAddress1, "subs2", sdk.Coins{sdk.NewInt64Coin("stake", 50)}, types.ReasonSubscriptionEscrow))
+	
+	require.NotNil(t, dk.Subtract(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}))
+	require.NotNil(t, dk.Unlock(ctx, types.TestAddress1, "subs0", sdk.Coins{sdk.NewInt64Coin("stake", 30)}, types.ReasonRefund))
+	require.Nil(t, dk.Unlock(ctx, types.TestAddress1, "subs0", sdk.Coins{sdk.NewInt64Coin("stake", 20)}, types.ReasonRefund))
+	deposit, _ = dk.GetDeposit(ctx, types.TestAddress1)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 20)}, deposit.Free())
+	require.Equal(t, types.Locks{{Holder: "subs1", Coins: sdk.Coins{sdk.NewInt64Coin("stake", 40)}}}, deposit.Locks)
+	
+	require.Nil(t, dk.Subtract(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 20)}))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 60)}, bk.GetCoins(ctx, types.TestAddress1))
+	require.NotNil(t, dk.Subtract(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 1)}))
+}
+
+func TestKeeper_SendFromLockToAccount(t *testing.T) {
+	ctx, dk, bk := CreateTestInput(t, false)
+	
+	_, err := bk.AddCoins(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	require.Nil(t, dk.Lock(ctx, types.TestAddress1, "node0", sdk.Coins{sdk.NewInt64Coin("stake", 50)}, types.ReasonSubscriptionEscrow))
+	
+	require.NotNil(t, dk.SendFromLockToAccount(ctx, types.TestAddress1, "node1", types.TestAddress2,
+		sdk.Coins{sdk.NewInt64Coin("stake", 10)}, types.ReasonSettlement))
+	require.NotNil(t, dk.SendFromLockToAccount(ctx, types.TestAddress1, "node0", types.TestAddress2,
+		sdk.Coins{sdk.NewInt64Coin("stake", 60)}, types.ReasonSettlement))
+	require.Nil(t, dk.SendFromLockToAccount(ctx, types.TestAddress1, "node0", types.TestAddress2,
+		sdk.Coins{sdk.NewInt64Coin("stake", 10)}, types.ReasonSettlement))
+	
+	deposit, _ := dk.GetDeposit(ctx, types.TestAddress1)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 40)}, deposit.Coins)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 40)}, deposit.Locked())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, bk.GetCoins(ctx, types.TestAddress2))
+	
+	require.Nil(t, dk.SendFromLockToAccount(ctx, types.TestAddress1, "node0", types.TestAddress2,
+		sdk.Coins{sdk.NewInt64Coin("stake", 40)}, types.ReasonSettlement))
+	deposit, _ = dk.GetDeposit(ctx, types.TestAddress1)
+	require.Len(t, deposit.Locks, 0)
+	require.True(t, deposit.Coins.IsZero())
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sentinel-official/hub/x/deposit/types"
+)
+
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-account", ModuleAccountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "locked-funds", LockedFundsInvariant(k))
+}
+
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := ModuleAccountInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		
+		return LockedFundsInvariant(k)(ctx)
+	}
+}
+
+// ModuleAccountInvariant checks that the deposit module account holds exactly the sum of all the deposits
+func ModuleAccountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := sdk.Coins{}
+		k.IterateDeposits(ctx, func(_ int64, deposit types.Deposit) bool {
+			expected = expected.Add(deposit.Coins)
+			return false
+		})
+
+		balance := k.supply.GetModuleAccount(ctx, types.ModuleName).GetCoins()
+		broken := !expected.IsEqual(balance)
+
+		return sdk.FormatInvariant(types.ModuleName, "module account",
+			fmt.Sprintf("\tsum of deposits:        %v\n"+
+				"\tmodule account balance: %v\n", expected, balance)), broken
+	}
+}
+
+// LockedFundsInvariant checks that the locked coins of every deposit do not exceed the coins of that deposit
+func LockedFundsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+		
+		k.IterateDeposits(ctx, func(_ int64, deposit types.Deposit) bool {
+			if !deposit.Coins.IsAllGTE(deposit.Locked()) {
+				count++
+				msg += fmt.Sprintf("\t%s has deposit %s but locked %s\n",
+					deposit.Address, deposit.Coins, deposit.Locked())
+			}
+			
+			return false
+		})
+		
+		broken := count != 0
+		return sdk.FormatInvariant(types.ModuleName, "locked funds",
+			fmt.Sprintf("found %d deposits with over-locked funds\n%s", count, msg)), broken
+	}
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sentinel-official/hub/x/deposit/types"
+)
+
+func TestModuleAccountInvariant(t *testing.T) {
+	ctx, dk, bk := CreateTestInput(t, false)
+
+	_, broken := ModuleAccountInvariant(dk)(ctx)
+	require.False(t, broken)
+
+	_, err := bk.AddCoins(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	_, err = bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+
+	err = dk.Add(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	err = dk.ReceiveFromAccountToDeposit(ctx, types.TestAddress2, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 50)}, types.ReasonTransfer)
+	require.Nil(t, err)
+	err = dk.SendFromDepositToAccount(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 25)}, types.ReasonTransfer)
+	require.Nil(t, err)
+
+	_, broken = ModuleAccountInvariant(dk)(ctx)
+	require.False(t, broken)
+
+	dk.SetDeposit(ctx, types.Deposit{Address: types.TestAddress2, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 10)}})
+
+	_, broken = ModuleAccountInvariant(dk)(ctx)
+	require.True(t, broken)
+
+	_, broken = AllInvariants(dk)(ctx)
+	require.True(t, broken)
+}
+
+func TestLockedFundsInvariant(t *testing.T) {
+	ctx, dk, bk := CreateTestInput(t, false)
+	
+	_, err := bk.AddCoins(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	require.Nil(t, dk.Lock(ctx, types.TestAddress1, "subs0", sdk.Coins{sdk.NewInt64Coin("stake", 50)}, types.ReasonSubscriptionEscrow))
+	
+	_, broken := LockedFundsInvariant(dk)(ctx)
+	require.False(t, broken)
+	
+	deposit, _ := dk.GetDeposit(ctx, types.TestAddress1)
+	deposit.SetLock(types.Lock{Holder: "subs1", Coins: sdk.Coins{sdk.NewInt64Coin("stake", 10)}})
+	dk.SetDeposit(ctx, deposit)
+	
+	_, broken = LockedFundsInvariant(dk)(ctx)
+	require.True(t, broken)
+	
+	_, broken = AllInvariants(dk)(ctx)
+	require.True(t, broken)
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"time"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/sentinel-official/hub/x/deposit/types"
+)
+
+func (k Keeper) SetLedgerEntriesCount(ctx sdk.Context, count uint64) {
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(count)
+	
+	store := ctx.KVStore(k.key)
+	store.Set(types.LedgerEntriesCountKey, value)
+}
+
+func (k Keeper) GetLedgerEntriesCount(ctx sdk.Context) (count uint64) {
+	store := ctx.KVStore(k.key)
+	
+	value := store.Get(types.LedgerEntriesCountKey)
+	if value == nil {
+		return 0
+	}
+	
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &count)
+	return count
+}
+
+// SetLedgerEntry stores the entry and indexes it by its address and its counterparty, so the recipients of
+// the coins find the movements as well
+func (k Keeper) SetLedgerEntry(ctx sdk.Context, entry types.LedgerEntry) {
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(entry)
+	
+	store := ctx.KVStore(k.key)
+	store.Set(types.LedgerEntryKey(entry.ID), value)
+	store.Set(types.LedgerEntryIDByAddressKey(entry.Address, entry.ID), []byte{})
+	if !entry.Counterparty.Empty() {
+		store.Set(types.LedgerEntryIDByAddressKey(entry.Counterparty, entry.ID), []byte{})
+	}
+}
+
+func (k Keeper) GetLedgerEntry(ctx sdk.Context, id uint64) (entry types.LedgerEntry, found bool) {
+	store := ctx.KVStore(k.key)
+	
+	value := store.Get(types.LedgerEntryKey(id))
+	if value == nil {
+		return entry, false
+	}
+	
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &entry)
+	return entry, true
+}
+
+// appendLedgerEntry stores the entry with the next ID, at the height and the time of the block
+func (k Keeper) appendLedgerEntry(ctx sdk.Context, entry types.LedgerEntry) {
+	count := k.GetLedgerEntriesCount(ctx)
+	
+	entry.ID = count
+	entry.Height = ctx.BlockHeight()
+	entry.Time = ctx.BlockTime()
+	
+	k.SetLedgerEntry(ctx, entry)
+	k.SetLedgerEntriesCount(ctx, count+1)
+}
+
+func (k Keeper) GetAllLedgerEntries(ctx sdk.Context) (entries types.LedgerEntries) {
+	store := ctx.KVStore(k.key)
+	
+	iter := sdk.KVStorePrefixIterator(store, types.LedgerEntryKeyPrefix)
+	defer iter.Close()
+	
+	for ; iter.Valid(); iter.Next() {
+		var entry types.LedgerEntry
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &entry)
+		entries = append(entries, entry)
+	}
+	
+	return entries
+}
+
+// GetLedgerEntriesOfAddress returns the entries of the address, or of which the address is the counterparty,
+// in the order they were written. Zero times leave that end of the time range open. The first skip entries
+// in the range are skipped and at most limit entries are returned, or all of them if the limit is not positive,
+// so a page is read without loading the whole history of the address.
+func (k Keeper) GetLedgerEntriesOfAddress(ctx sdk.Context, address sdk.AccAddress,
+	from, to time.Time, skip, limit int) (entries types.LedgerEntries) {
+	store := ctx.KVStore(k.key)
+	
+	iter := sdk.KVStorePrefixIterator(store, types.LedgerEntryIDsByAddressKey(address))
+	defer iter.Close()
+	
+	for ; iter.Valid(); iter.Next() {
+		if limit > 0 && len(entries) >= limit {
+			break
+		}
+		
+		id := binary.BigEndian.Uint64(iter.Key()[len(iter.Key())-8:])
+		
+		entry, _ := k.GetLedgerEntry(ctx, id)
+		if !from.IsZero() && entry.Time.Before(from) {
+			continue
+		}
+		if !to.IsZero() && !entry.Time.Before(to) {
+			break
+		}
+		if skip > 0 {
+			skip--
+			continue
+		}
+		
+		entries = append(entries, entry)
+	}
+	
+	return entries
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	
+	"github.com/sentinel-official/hub/x/deposit/types"
+)
+
+func TestKeeper_LedgerEntries(t *testing.T) {
+	ctx, dk, bk := CreateTestInput(t, false)
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(start)
+	
+	_, found := dk.GetLedgerEntry(ctx, 0)
+	require.Equal(t, false, found)
+	require.Equal(t, uint64(0), dk.GetLedgerEntriesCount(ctx))
+	
+	_, err := bk.AddCoins(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	
+	require.Nil(t, dk.Add(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 50)}))
+	require.Nil(t, dk.Lock(ctx, types.TestAddress1, "subs0", sdk.Coins{sdk.NewInt64Coin("stake", 80)},
+		types.ReasonSubscriptionEscrow))
+	require.NotNil(t, dk.Subtract(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}))
+	require.Equal(t, uint64(3), dk.GetLedgerEntriesCount(ctx))
+	
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(start.Add(time.Hour))
+	require.Nil(t, dk.SendFromLockToAccount(ctx, types.TestAddress1, "subs0", types.TestAddress2,
+		sdk.Coins{sdk.NewInt64Coin("stake", 20)}, types.ReasonSettlement))
+	require.Nil(t, dk.Unlock(ctx, types.TestAddress1, "subs0", sdk.Coins{sdk.NewInt64Coin("stake", 60)},
+		types.ReasonRefund))
+	require.Nil(t, dk.Subtract(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 60)}))
+	require.Equal(t, uint64(6), dk.GetLedgerEntriesCount(ctx))
+	
+	entry := func(id uint64, height int64, t time.Time, action, reason string, counterparty sdk.AccAddress,
+		holder string, amount int64) types.LedgerEntry {
+		return types.LedgerEntry{
+			ID:           id,
+			Address:      types.TestAddress1,
+			Action:       action,
+			Reason:       reason,
+			Counterparty: counterparty,
+			Holder:       holder,
+			Coins:        sdk.Coins{sdk.NewInt64Coin("stake", amount)},
+			Height:       height,
+			Time:         t,
+		}
+	}
+	
+	entries := types.LedgerEntries{
+		entry(0, 1, start, types.ActionAdd, types.ReasonDeposit, nil, "", 50),
+		entry(1, 1, start, types.ActionAdd, types.ReasonSubscriptionEscrow, nil, "subs0", 30),
+		entry(2, 1, start, types.ActionLock, types.ReasonSubscriptionEscrow, nil, "subs0", 80),
+		entry(3, 2, start.Add(time.Hour), types.ActionSend, types.ReasonSettlement, types.TestAddress2, "subs0", 20),
+		entry(4, 2, start.Add(time.Hour), types.ActionUnlock, types.ReasonRefund, nil, "subs0", 60),
+		entry(5, 2, start.Add(time.Hour), types.ActionSubtract, types.ReasonWithdraw, nil, "", 60),
+	}
+	
+	require.Equal(t, entries, dk.GetAllLedgerEntries(ctx))
+	require.Equal(t, entries, dk.GetLedgerEntriesOfAddress(ctx, types.TestAddress1, time.Time{}, time.Time{}, 0, 0))
+	require.Equal(t, entries[3:4], dk.GetLedgerEntriesOfAddress(ctx, types.TestAddress2, time.Time{}, time.Time{}, 0, 0))
+	require.Equal(t, entries[:3], dk.GetLedgerEntriesOfAddress(ctx, types.TestAddress1, time.Time{}, start.Add(time.Hour), 0, 0))
+	require.Equal(t, entries[3:], dk.GetLedgerEntriesOfAddress(ctx, types.TestAddress1, start.Add(time.Hour), time.Time{}, 0, 0))
+	require.Len(t, dk.GetLedgerEntriesOfAddress(ctx, types.TestAddress1, start.Add(2*time.Hour), time.Time{}, 0, 0), 0)
+	require.Equal(t, entries[:2], dk.GetLedgerEntriesOfAddress(ctx, types.TestAddress1, time.Time{}, time.Time{}, 0, 2))
+	require.Equal(t, entries[2:4], dk.GetLedgerEntriesOfAddress(ctx, types.TestAddress1, time.Time{}, time.Time{}, 2, 2))
+	require.Equal(t, entries[4:], dk.GetLedgerEntriesOfAddress(ctx, types.TestAddress1, start.Add(time.Hour), time.Time{}, 1, 0))
+	require.Len(t, dk.GetLedgerEntriesOfAddress(ctx, types.TestAddress1, time.Time{}, time.Time{}, 6, 0), 0)
+}
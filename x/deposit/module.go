@@ -26,7 +26,9 @@ func (a AppModuleBasic) Name() string {
 	return ModuleName
 }
 
-func (a AppModuleBasic) RegisterCodec(*codec.Codec) {}
+func (a AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
+	RegisterCodec(cdc)
+}
 
 func (a AppModuleBasic) DefaultGenesis() json.RawMessage {
 	return ModuleCdc.MustMarshalJSON(DefaultGenesisState())
@@ -45,8 +47,8 @@ func (a AppModuleBasic) RegisterRESTRoutes(ctx context.CLIContext, r *mux.Router
 	rest.RegisterRoutes(ctx, r)
 }
 
-func (a AppModuleBasic) GetTxCmd(_ *codec.Codec) *cobra.Command {
-	return &cobra.Command{}
+func (a AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	return cli.GetTxCmd(cdc)
 }
 
 func (a AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
@@ -77,14 +79,16 @@ func (a AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
 	return ModuleCdc.MustMarshalJSON(state)
 }
 
-func (a AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (a AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	RegisterInvariants(ir, a.keeper)
+}
 
 func (a AppModule) Route() string {
 	return RouterKey
 }
 
 func (a AppModule) NewHandler() sdk.Handler {
-	return nil
+	return NewHandler(a.keeper)
 }
 
 func (a AppModule) QuerierRoute() string {
@@ -34,8 +34,8 @@ func Test_queryDepositOfAddress(t *testing.T) {
 	
 	var deposit types.Deposit
 	require.NotNil(t, cdc.UnmarshalJSON(res, &deposit))
-	require.NotEqual(t, types.Deposit{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}}, deposit)
-	dk.SetDeposit(ctx, types.Deposit{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}})
+	require.NotEqual(t, types.Deposit{Address: types.TestAddress1, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 10)}}, deposit)
+	dk.SetDeposit(ctx, types.Deposit{Address: types.TestAddress1, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 10)}})
 	
 	req.Data = cdc.MustMarshalJSON(types.NewQueryDepositOfAddressParams([]byte("")))
 	
@@ -45,7 +45,7 @@ func Test_queryDepositOfAddress(t *testing.T) {
 	require.Len(t, res, 0)
 	
 	require.NotNil(t, cdc.UnmarshalJSON(res, &deposit))
-	require.NotEqual(t, types.Deposit{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}}, deposit)
+	require.NotEqual(t, types.Deposit{Address: types.TestAddress1, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 10)}}, deposit)
 	
 	req.Data = cdc.MustMarshalJSON(types.NewQueryDepositOfAddressParams(types.TestAddress1))
 	require.Nil(t, err)
@@ -56,7 +56,7 @@ func Test_queryDepositOfAddress(t *testing.T) {
 	
 	cdc.MustUnmarshalJSON(res, &deposit)
 	require.Nil(t, err)
-	require.Equal(t, types.Deposit{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}}, deposit)
+	require.Equal(t, types.Deposit{Address: types.TestAddress1, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 10)}}, deposit)
 	
 	req.Data = cdc.MustMarshalJSON(types.NewQueryDepositOfAddressParams(types.TestAddress2))
 	require.Nil(t, err)
@@ -77,18 +77,18 @@ func Test_queryAllDeposits(t *testing.T) {
 	
 	var deposits []types.Deposit
 	cdc.MustUnmarshalJSON(res, &deposits)
-	require.NotEqual(t, []types.Deposit{{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}}}, deposits)
+	require.NotEqual(t, []types.Deposit{{Address: types.TestAddress1, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 10)}}}, deposits)
 	
-	dk.SetDeposit(ctx, types.Deposit{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}})
+	dk.SetDeposit(ctx, types.Deposit{Address: types.TestAddress1, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 10)}})
 	
 	res, err = queryAllDeposits(ctx, dk)
 	require.Nil(t, err)
 	require.NotEqual(t, []byte(nil), res)
 	
 	cdc.MustUnmarshalJSON(res, &deposits)
-	require.Equal(t, []types.Deposit{{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}}}, deposits)
+	require.Equal(t, []types.Deposit{{Address: types.TestAddress1, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 10)}}}, deposits)
 	
-	deposit := types.Deposit{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}}
+	deposit := types.Deposit{Address: types.TestAddress1, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 10)}}
 	deposit.Address = types.TestAddress2
 	dk.SetDeposit(ctx, deposit)
 	
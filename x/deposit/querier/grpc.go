@@ -0,0 +1,77 @@
+package querier
+
+import (
+	"context"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	
+	"github.com/sentinel-official/hub/types/hubpb"
+	"github.com/sentinel-official/hub/x/deposit/depositpb"
+	"github.com/sentinel-official/hub/x/deposit/keeper"
+)
+
+var (
+	_ depositpb.QueryServiceServer = GRPCServer{}
+)
+
+// GRPCServer serves the queries of the module over gRPC against the context returned by ctxFn.
+type GRPCServer struct {
+	k     keeper.Keeper
+	ctxFn func() (sdk.Context, error)
+}
+
+func NewGRPCServer(k keeper.Keeper, ctxFn func() (sdk.Context, error)) GRPCServer {
+	return GRPCServer{
+		k:     k,
+		ctxFn: ctxFn,
+	}
+}
+
+func (s GRPCServer) context() (sdk.Context, error) {
+	ctx, err := s.ctxFn()
+	if err != nil {
+		return ctx, status.Error(codes.Unavailable, err.Error())
+	}
+	
+	return ctx, nil
+}
+
+func (s GRPCServer) Deposit(_ context.Context,
+	req *depositpb.QueryDepositRequest) (*depositpb.QueryDepositResponse, error) {
+	address, err := sdk.AccAddressFromBech32(req.GetAddress())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+	
+	ctx, err := s.context()
+	if err != nil {
+		return nil, err
+	}
+	
+	deposit, found := s.k.GetDeposit(ctx, address)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "deposit of %s does not exist", address)
+	}
+	
+	return &depositpb.QueryDepositResponse{Deposit: depositpb.NewDeposit(deposit)}, nil
+}
+
+func (s GRPCServer) Deposits(_ context.Context,
+	req *depositpb.QueryDepositsRequest) (*depositpb.QueryDepositsResponse, error) {
+	ctx, err := s.context()
+	if err != nil {
+		return nil, err
+	}
+	
+	deposits := s.k.GetAllDeposits(ctx)
+	
+	start, end := hubpb.Paginate(len(deposits), req.GetPagination())
+	items := make([]*depositpb.Deposit, 0, end-start)
+	for _, deposit := range deposits[start:end] {
+		items = append(items, depositpb.NewDeposit(deposit))
+	}
+	
+	return &depositpb.QueryDepositsResponse{Deposits: items, Pagination: hubpb.NewPageResponse(len(deposits))}, nil
+}
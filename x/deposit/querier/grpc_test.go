@@ -0,0 +1,58 @@
+package querier
+
+import (
+	"context"
+	"errors"
+	"testing"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	
+	"github.com/sentinel-official/hub/types/hubpb"
+	"github.com/sentinel-official/hub/x/deposit/depositpb"
+	"github.com/sentinel-official/hub/x/deposit/keeper"
+	"github.com/sentinel-official/hub/x/deposit/types"
+)
+
+func TestGRPCServer_Deposit(t *testing.T) {
+	ctx, dk, _ := keeper.CreateTestInput(t, false)
+	server := NewGRPCServer(dk, func() (sdk.Context, error) { return ctx, nil })
+	
+	_, err := server.Deposit(context.Background(), &depositpb.QueryDepositRequest{Address: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	
+	req := &depositpb.QueryDepositRequest{Address: types.TestAddress1.String()}
+	_, err = server.Deposit(context.Background(), req)
+	require.Equal(t, codes.NotFound, status.Code(err))
+	
+	dk.SetDeposit(ctx, types.Deposit{Address: types.TestAddress1, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 10)}})
+	res, err := server.Deposit(context.Background(), req)
+	require.Nil(t, err)
+	require.Equal(t, types.TestAddress1.String(), res.GetDeposit().GetAddress())
+	require.Equal(t, []*hubpb.Coin{{Denom: "stake", Amount: "10"}}, res.GetDeposit().GetCoins())
+	
+	server = NewGRPCServer(dk, func() (sdk.Context, error) { return sdk.Context{}, errors.New("not ready") })
+	_, err = server.Deposit(context.Background(), req)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestGRPCServer_Deposits(t *testing.T) {
+	ctx, dk, _ := keeper.CreateTestInput(t, false)
+	server := NewGRPCServer(dk, func() (sdk.Context, error) { return ctx, nil })
+	
+	res, err := server.Deposits(context.Background(), &depositpb.QueryDepositsRequest{})
+	require.Nil(t, err)
+	require.Len(t, res.GetDeposits(), 0)
+	
+	dk.SetDeposit(ctx, types.Deposit{Address: types.TestAddress1, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 10)}})
+	dk.SetDeposit(ctx, types.Deposit{Address: types.TestAddress2, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 20)}})
+	
+	res, err = server.Deposits(context.Background(), &depositpb.QueryDepositsRequest{
+		Pagination: &hubpb.PageRequest{Offset: 1, Limit: 5},
+	})
+	require.Nil(t, err)
+	require.Len(t, res.GetDeposits(), 1)
+	require.Equal(t, uint64(2), res.GetPagination().GetTotal())
+}
@@ -0,0 +1,39 @@
+package querier
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	
+	"github.com/sentinel-official/hub/x/deposit/keeper"
+	"github.com/sentinel-official/hub/x/deposit/types"
+)
+
+func queryLedgerEntriesOfAddress(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryLedgerEntriesOfAddressParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, types.ErrorUnmarshal()
+	}
+	if !params.From.IsZero() && !params.To.IsZero() && !params.From.Before(params.To) {
+		return nil, types.ErrorInvalidField("to")
+	}
+	
+	limit := params.Limit
+	if limit <= 0 {
+		limit = 100
+	}
+	
+	entries := types.LedgerEntries{}
+	if params.Page > 0 {
+		if page := k.GetLedgerEntriesOfAddress(ctx, params.Address, params.From, params.To,
+			(params.Page-1)*limit, limit); page != nil {
+			entries = page
+		}
+	}
+	
+	res, err := types.ModuleCdc.MarshalJSON(entries)
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+	
+	return res, nil
+}
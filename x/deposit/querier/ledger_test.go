@@ -0,0 +1,69 @@
+package querier
+
+import (
+	"fmt"
+	"testing"
+	"time"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	
+	"github.com/sentinel-official/hub/x/deposit/keeper"
+	"github.com/sentinel-official/hub/x/deposit/types"
+)
+
+func Test_queryLedgerEntriesOfAddress(t *testing.T) {
+	ctx, dk, _ := keeper.CreateTestInput(t, false)
+	cdc := keeper.MakeTestCodec()
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryLedgerEntriesOfAddress),
+		Data: []byte{},
+	}
+	
+	res, err := queryLedgerEntriesOfAddress(ctx, req, dk)
+	require.NotNil(t, err)
+	require.Equal(t, []byte(nil), res)
+	
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		entry := types.NewLedgerEntry(types.TestAddress1, types.ActionAdd, types.ReasonDeposit, nil, "",
+			sdk.Coins{sdk.NewInt64Coin("stake", 10)})
+		entry.ID = uint64(i)
+		entry.Height = int64(i + 1)
+		entry.Time = start.Add(time.Duration(i) * time.Hour)
+		dk.SetLedgerEntry(ctx, entry)
+	}
+	
+	query := func(from, to time.Time, page, limit int) (entries types.LedgerEntries) {
+		req.Data = cdc.MustMarshalJSON(types.NewQueryLedgerEntriesOfAddressParams(types.TestAddress1,
+			from, to, page, limit))
+		
+		res, err := queryLedgerEntriesOfAddress(ctx, req, dk)
+		require.Nil(t, err)
+		
+		cdc.MustUnmarshalJSON(res, &entries)
+		return entries
+	}
+	
+	require.Len(t, query(time.Time{}, time.Time{}, 1, 0), 3)
+	require.Len(t, query(time.Time{}, time.Time{}, 1, 2), 2)
+	require.Equal(t, uint64(2), query(time.Time{}, time.Time{}, 2, 2)[0].ID)
+	require.Len(t, query(time.Time{}, time.Time{}, 3, 2), 0)
+	require.Len(t, query(start.Add(time.Hour), time.Time{}, 1, 0), 2)
+	require.Len(t, query(start, start.Add(time.Hour), 1, 0), 1)
+	require.Equal(t, uint64(2), query(start.Add(time.Hour), time.Time{}, 2, 1)[0].ID)
+	require.Len(t, query(time.Time{}, time.Time{}, 0, 2), 0)
+	
+	req.Data = cdc.MustMarshalJSON(types.NewQueryLedgerEntriesOfAddressParams(types.TestAddress1,
+		start.Add(time.Hour), start, 1, 0))
+	res, err = queryLedgerEntriesOfAddress(ctx, req, dk)
+	require.NotNil(t, err)
+	require.Equal(t, []byte(nil), res)
+	
+	req.Data = cdc.MustMarshalJSON(types.NewQueryLedgerEntriesOfAddressParams(types.TestAddress2,
+		time.Time{}, time.Time{}, 1, 0))
+	res, err = queryLedgerEntriesOfAddress(ctx, req, dk)
+	require.Nil(t, err)
+	require.Equal(t, []byte("[]"), res)
+}
@@ -15,6 +15,8 @@ func NewQuerier(k keeper.Keeper) sdk.Querier {
 			return queryDepositOfAddress(ctx, req, k)
 		case types.QueryAllDeposits:
 			return queryAllDeposits(ctx, k)
+		case types.QueryLedgerEntriesOfAddress:
+			return queryLedgerEntriesOfAddress(ctx, req, k)
 		default:
 			return nil, types.ErrorInvalidQueryType(path[0])
 		}
@@ -8,8 +8,14 @@ var (
 	ModuleCdc *codec.Codec
 )
 
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgDeposit{}, "x/deposit/MsgDeposit", nil)
+	cdc.RegisterConcrete(MsgWithdraw{}, "x/deposit/MsgWithdraw", nil)
+}
+
 func init() {
 	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
 	codec.RegisterCrypto(ModuleCdc)
 	ModuleCdc.Seal()
 }
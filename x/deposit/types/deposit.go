@@ -2,19 +2,84 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// Lock is the part of a deposit held by a node, resolver, subscription or channel, which is identified by its ID
+type Lock struct {
+	Holder string    `json:"holder"`
+	Coins  sdk.Coins `json:"coins"`
+}
+
+func (l Lock) String() string {
+	return fmt.Sprintf("%s: %s", l.Holder, l.Coins)
+}
+
+type Locks []Lock
+
+func (l Locks) Coins() sdk.Coins {
+	coins := sdk.Coins{}
+	for _, lock := range l {
+		coins = coins.Add(lock.Coins)
+	}
+	
+	return coins
+}
+
+// Deposit is the total of the coins an address has deposited, of which the locks are held by
+// the nodes, resolvers, subscriptions and channels and the rest is free to withdraw
 type Deposit struct {
 	Address sdk.AccAddress `json:"address"`
 	Coins   sdk.Coins      `json:"coins"`
+	Locks   Locks          `json:"locks"`
 }
 
 func (d Deposit) String() string {
+	locks := make([]string, 0, len(d.Locks))
+	for _, lock := range d.Locks {
+		locks = append(locks, lock.String())
+	}
+	
 	return fmt.Sprintf(`Deposit
   Address: %s
-  Coins:   %s`, d.Address, d.Coins)
+  Coins:   %s
+  Free:    %s
+  Locks:   [%s]`, d.Address, d.Coins, d.Free(), strings.Join(locks, ", "))
+}
+
+func (d Deposit) Locked() sdk.Coins {
+	return d.Locks.Coins()
+}
+
+func (d Deposit) Free() sdk.Coins {
+	free, _ := d.Coins.SafeSub(d.Locked())
+	return free
+}
+
+func (d Deposit) GetLock(holder string) (Lock, bool) {
+	for _, lock := range d.Locks {
+		if lock.Holder == holder {
+			return lock, true
+		}
+	}
+	
+	return Lock{Holder: holder, Coins: sdk.Coins{}}, false
+}
+
+// SetLock replaces the lock of the holder, and removes it if the coins of the lock are zero
+func (d *Deposit) SetLock(lock Lock) {
+	for i := range d.Locks {
+		if d.Locks[i].Holder == lock.Holder {
+			d.Locks = append(d.Locks[:i], d.Locks[i+1:]...)
+			break
+		}
+	}
+	
+	if !lock.Coins.IsZero() {
+		d.Locks = append(d.Locks, lock)
+	}
 }
 
 func (d Deposit) IsValid() error {
@@ -25,5 +90,21 @@ func (d Deposit) IsValid() error {
 		return fmt.Errorf("invalid coins")
 	}
 	
+	holders := make(map[string]bool, len(d.Locks))
+	for _, lock := range d.Locks {
+		if lock.Holder == "" || holders[lock.Holder] {
+			return fmt.Errorf("invalid lock holder %s", lock.Holder)
+		}
+		if !lock.Coins.IsValid() || lock.Coins.IsZero() {
+			return fmt.Errorf("invalid coins of lock %s", lock.Holder)
+		}
+		
+		holders[lock.Holder] = true
+	}
+	
+	if !d.Coins.IsAllGTE(d.Locked()) {
+		return fmt.Errorf("locked coins %s exceed coins %s", d.Locked(), d.Coins)
+	}
+	
 	return nil
 }
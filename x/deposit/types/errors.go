@@ -13,9 +13,15 @@ const (
 	
 	errCodeUnknownQueryType         = 101
 	errCodeInsufficientDepositFunds = 102
+	errCodeUnknownMsgType           = 103
+	errCodeInvalidField             = 104
+	errCodeInsufficientLockedFunds  = 105
 	
 	errMsgUnknownQueryType         = "Invalid query type: "
 	errMsgInsufficientDepositFunds = "insufficient deposit funds: %s < %s"
+	errMsgUnknownMsgType           = "Unknown message type: "
+	errMsgInvalidField             = "Invalid field: "
+	errMsgInsufficientLockedFunds  = "insufficient funds locked by %s: %s < %s"
 )
 
 func ErrorMarshal() sdk.Error {
@@ -33,3 +39,16 @@ func ErrorInvalidQueryType(queryType string) sdk.Error {
 func ErrorInsufficientDepositFunds(x, y sdk.Coins) sdk.Error {
 	return sdk.NewError(Codespace, errCodeInsufficientDepositFunds, fmt.Sprintf(errMsgInsufficientDepositFunds, x, y))
 }
+
+func ErrorUnknownMsgType(msgType string) sdk.Error {
+	return sdk.NewError(Codespace, errCodeUnknownMsgType, errMsgUnknownMsgType+msgType)
+}
+
+func ErrorInvalidField(field string) sdk.Error {
+	return sdk.NewError(Codespace, errCodeInvalidField, errMsgInvalidField+field)
+}
+
+func ErrorInsufficientLockedFunds(holder string, x, y sdk.Coins) sdk.Error {
+	return sdk.NewError(Codespace, errCodeInsufficientLockedFunds,
+		fmt.Sprintf(errMsgInsufficientLockedFunds, holder, x, y))
+}
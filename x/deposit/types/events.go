@@ -0,0 +1,19 @@
+package types
+
+var (
+	EventTypeAddDeposit      = "add_deposit"
+	EventTypeSubtractDeposit = "subtract_deposit"
+	EventTypeSendFromDeposit = "send_from_deposit"
+	EventTypeReceiveDeposit  = "receive_deposit"
+	EventTypeLockDeposit     = "lock_deposit"
+	EventTypeUnlockDeposit   = "unlock_deposit"
+	
+	EventTypeMsgDeposit  = "msg_deposit"
+	EventTypeMsgWithdraw = "msg_withdraw"
+	
+	AttributeKeyAddress     = "address"
+	AttributeKeyFromAddress = "from_address"
+	AttributeKeyToAddress   = "to_address"
+	AttributeKeyAmount      = "amount"
+	AttributeKeyHolder      = "holder"
+)
@@ -1,9 +1,19 @@
 package types
 
-type GenesisState []Deposit
+// GenesisState carries the deposits and the ledger of their movements. The count of the ledger entries is
+// the ID of the next entry, so it is exported as well instead of being derived from the entries.
+type GenesisState struct {
+	Deposits           []Deposit     `json:"deposits"`
+	LedgerEntries      LedgerEntries `json:"ledger_entries"`
+	LedgerEntriesCount uint64        `json:"ledger_entries_count"`
+}
 
-func NewGenesisState(deposits []Deposit) GenesisState {
-	return deposits
+func NewGenesisState(deposits []Deposit, ledgerEntries LedgerEntries, ledgerEntriesCount uint64) GenesisState {
+	return GenesisState{
+		Deposits:           deposits,
+		LedgerEntries:      ledgerEntries,
+		LedgerEntriesCount: ledgerEntriesCount,
+	}
 }
 
 func DefaultGenesisState() GenesisState {
@@ -12,9 +12,24 @@ const (
 )
 
 var (
-	DepositKeyPrefix = []byte{0x01}
+	DepositKeyPrefix                = []byte{0x01}
+	LedgerEntriesCountKey           = []byte{0x02}
+	LedgerEntryKeyPrefix            = []byte{0x03}
+	LedgerEntryIDByAddressKeyPrefix = []byte{0x04}
 )
 
 func DepositKey(address sdk.AccAddress) []byte {
 	return append(DepositKeyPrefix, address.Bytes()...)
 }
+
+func LedgerEntryKey(id uint64) []byte {
+	return append(LedgerEntryKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+func LedgerEntryIDsByAddressKey(address sdk.AccAddress) []byte {
+	return append(LedgerEntryIDByAddressKeyPrefix, address.Bytes()...)
+}
+
+func LedgerEntryIDByAddressKey(address sdk.AccAddress, id uint64) []byte {
+	return append(LedgerEntryIDsByAddressKey(address), sdk.Uint64ToBigEndian(id)...)
+}
@@ -0,0 +1,134 @@
+package types
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ActionAdd      = "add"
+	ActionSubtract = "subtract"
+	ActionSend     = "send"
+	ActionReceive  = "receive"
+	ActionLock     = "lock"
+	ActionUnlock   = "unlock"
+)
+
+const (
+	ReasonDeposit            = "deposit"
+	ReasonWithdraw           = "withdraw"
+	ReasonTransfer           = "transfer"
+	ReasonNodeBond           = "node_bond"
+	ReasonResolverBond       = "resolver_bond"
+	ReasonSubscriptionEscrow = "subscription_escrow"
+	ReasonChannelEscrow      = "channel_escrow"
+	ReasonSettlement         = "settlement"
+	ReasonRefund             = "refund"
+	ReasonSlash              = "slash"
+)
+
+// LedgerEntry records one movement of the deposit of an address. The counterparty is the account the coins
+// were sent to or received from, and the holder is the ID of the node, resolver, subscription or channel
+// the movement belongs to, if any.
+type LedgerEntry struct {
+	ID           uint64         `json:"id"`
+	Address      sdk.AccAddress `json:"address"`
+	Action       string         `json:"action"`
+	Reason       string         `json:"reason"`
+	Counterparty sdk.AccAddress `json:"counterparty"`
+	Holder       string         `json:"holder"`
+	Coins        sdk.Coins      `json:"coins"`
+	Height       int64          `json:"height"`
+	Time         time.Time      `json:"time"`
+}
+
+func NewLedgerEntry(address sdk.AccAddress, action, reason string, counterparty sdk.AccAddress,
+	holder string, coins sdk.Coins) LedgerEntry {
+	return LedgerEntry{
+		Address:      address,
+		Action:       action,
+		Reason:       reason,
+		Counterparty: counterparty,
+		Holder:       holder,
+		Coins:        coins,
+	}
+}
+
+func (e LedgerEntry) String() string {
+	return fmt.Sprintf(`LedgerEntry
+  ID:           %d
+  Address:      %s
+  Action:       %s
+  Reason:       %s
+  Counterparty: %s
+  Holder:       %s
+  Coins:        %s
+  Height:       %d
+  Time:         %s`, e.ID, e.Address, e.Action, e.Reason, e.Counterparty, e.Holder, e.Coins,
+		e.Height, e.Time.UTC().Format(time.RFC3339))
+}
+
+func (e LedgerEntry) IsValid() error {
+	if e.Address == nil || e.Address.Empty() {
+		return fmt.Errorf("invalid address")
+	}
+	
+	switch e.Action {
+	case ActionAdd, ActionSubtract, ActionSend, ActionReceive, ActionLock, ActionUnlock:
+	default:
+		return fmt.Errorf("invalid action")
+	}
+	
+	if !e.Coins.IsValid() {
+		return fmt.Errorf("invalid coins")
+	}
+	if e.Height < 0 {
+		return fmt.Errorf("invalid height")
+	}
+	
+	return nil
+}
+
+type LedgerEntries []LedgerEntry
+
+var ledgerCSVHeader = []string{
+	"id", "height", "time", "address", "action", "reason", "counterparty", "holder", "coins",
+}
+
+// WriteCSV writes the entries as CSV with a header row. Empty counterparties are written as empty fields.
+func (l LedgerEntries) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(ledgerCSVHeader); err != nil {
+		return err
+	}
+	
+	for _, entry := range l {
+		counterparty := ""
+		if !entry.Counterparty.Empty() {
+			counterparty = entry.Counterparty.String()
+		}
+		
+		record := []string{
+			strconv.FormatUint(entry.ID, 10),
+			strconv.FormatInt(entry.Height, 10),
+			entry.Time.UTC().Format(time.RFC3339),
+			entry.Address.String(),
+			entry.Action,
+			entry.Reason,
+			counterparty,
+			entry.Holder,
+			entry.Coins.String(),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	
+	writer.Flush()
+	return writer.Error()
+}
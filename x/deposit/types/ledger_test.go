@@ -0,0 +1,35 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+	"time"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLedgerEntries_WriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	require.Nil(t, LedgerEntries{}.WriteCSV(&buf))
+	require.Equal(t, "id,height,time,address,action,reason,counterparty,holder,coins\n", buf.String())
+	
+	entry := NewLedgerEntry(TestAddress1, ActionSend, ReasonSettlement, TestAddress2, "subs0",
+		sdk.Coins{sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("tsent", 5)})
+	entry.ID = 1
+	entry.Height = 10
+	entry.Time = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	
+	refund := NewLedgerEntry(TestAddress1, ActionUnlock, ReasonRefund, nil, "subs0",
+		sdk.Coins{sdk.NewInt64Coin("stake", 90)})
+	refund.ID = 2
+	refund.Height = 10
+	refund.Time = entry.Time
+	
+	buf.Reset()
+	require.Nil(t, LedgerEntries{entry, refund}.WriteCSV(&buf))
+	require.Equal(t, "id,height,time,address,action,reason,counterparty,holder,coins\n"+
+		"1,10,2020-01-01T00:00:00Z,"+TestAddress1.String()+",send,settlement,"+TestAddress2.String()+
+		",subs0,\"10stake,5tsent\"\n"+
+		"2,10,2020-01-01T00:00:00Z,"+TestAddress1.String()+",unlock,refund,,subs0,90stake\n", buf.String())
+}
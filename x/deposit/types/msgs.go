@@ -0,0 +1,102 @@
+package types
+
+import (
+	"encoding/json"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = (*MsgDeposit)(nil)
+
+// MsgDeposit adds the coins to the free deposit of the sender, which can be locked later by
+// the subscriptions and channels of the sender without sending the coins again
+type MsgDeposit struct {
+	From  sdk.AccAddress `json:"from"`
+	Coins sdk.Coins      `json:"coins"`
+}
+
+func (msg MsgDeposit) Type() string {
+	return "deposit"
+}
+
+func (msg MsgDeposit) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if msg.Coins == nil || !msg.Coins.IsValid() || !msg.Coins.IsAllPositive() {
+		return ErrorInvalidField("coins")
+	}
+	
+	return nil
+}
+
+func (msg MsgDeposit) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	
+	return bz
+}
+
+func (msg MsgDeposit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgDeposit) Route() string {
+	return RouterKey
+}
+
+func NewMsgDeposit(from sdk.AccAddress, coins sdk.Coins) *MsgDeposit {
+	return &MsgDeposit{
+		From:  from,
+		Coins: coins,
+	}
+}
+
+var _ sdk.Msg = (*MsgWithdraw)(nil)
+
+// MsgWithdraw sends the coins from the free deposit of the sender back to the sender, locked coins are never withdrawn
+type MsgWithdraw struct {
+	From  sdk.AccAddress `json:"from"`
+	Coins sdk.Coins      `json:"coins"`
+}
+
+func (msg MsgWithdraw) Type() string {
+	return "withdraw"
+}
+
+func (msg MsgWithdraw) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if msg.Coins == nil || !msg.Coins.IsValid() || !msg.Coins.IsAllPositive() {
+		return ErrorInvalidField("coins")
+	}
+	
+	return nil
+}
+
+func (msg MsgWithdraw) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	
+	return bz
+}
+
+func (msg MsgWithdraw) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgWithdraw) Route() string {
+	return RouterKey
+}
+
+func NewMsgWithdraw(from sdk.AccAddress, coins sdk.Coins) *MsgWithdraw {
+	return &MsgWithdraw{
+		From:  from,
+		Coins: coins,
+	}
+}
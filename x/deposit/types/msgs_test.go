@@ -0,0 +1,80 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgDeposit_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgDeposit
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgDeposit(nil, sdk.Coins{sdk.NewInt64Coin("stake", 10)}),
+			ErrorInvalidField("from"),
+		}, {
+			"coins is nil",
+			NewMsgDeposit(TestAddress1, nil),
+			ErrorInvalidField("coins"),
+		}, {
+			"coins is zero",
+			NewMsgDeposit(TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 0)}),
+			ErrorInvalidField("coins"),
+		}, {
+			"coins is negative",
+			NewMsgDeposit(TestAddress1, sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-10)}}),
+			ErrorInvalidField("coins"),
+		}, {
+			"valid",
+			NewMsgDeposit(TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}),
+			nil,
+		},
+	}
+	
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMsgWithdraw_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgWithdraw
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgWithdraw(nil, sdk.Coins{sdk.NewInt64Coin("stake", 10)}),
+			ErrorInvalidField("from"),
+		}, {
+			"coins is empty",
+			NewMsgWithdraw(TestAddress1, sdk.Coins{}),
+			ErrorInvalidField("coins"),
+		}, {
+			"coins is zero",
+			NewMsgWithdraw(TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 0)}),
+			ErrorInvalidField("coins"),
+		}, {
+			"valid",
+			NewMsgWithdraw(TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}),
+			nil,
+		},
+	}
+	
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -1,12 +1,16 @@
 package types
 
 import (
+	"time"
+	
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
 	QueryDepositOfAddress = "deposit_of_address"
 	QueryAllDeposits      = "all_deposits"
+	
+	QueryLedgerEntriesOfAddress = "ledger_entries_of_address"
 )
 
 type QueryDepositOfAddressPrams struct {
@@ -18,3 +22,24 @@ func NewQueryDepositOfAddressParams(address sdk.AccAddress) QueryDepositOfAddres
 		Address: address,
 	}
 }
+
+// QueryLedgerEntriesOfAddressParams selects a page of the ledger entries of the address in the time range
+// [From, To), zero times leave that end of the range open
+type QueryLedgerEntriesOfAddressParams struct {
+	Address sdk.AccAddress
+	From    time.Time
+	To      time.Time
+	Page    int
+	Limit   int
+}
+
+func NewQueryLedgerEntriesOfAddressParams(address sdk.AccAddress, from, to time.Time,
+	page, limit int) QueryLedgerEntriesOfAddressParams {
+	return QueryLedgerEntriesOfAddressParams{
+		Address: address,
+		From:    from,
+		To:      to,
+		Page:    page,
+		Limit:   limit,
+	}
+}
@@ -0,0 +1,39 @@
+package upgrade
+
+import (
+	"fmt"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/sentinel-official/hub/x/upgrade/keeper"
+)
+
+// BeginBlock applies the scheduled plan at its height. A binary without a handler for the plan halts the chain
+// there, so that it can be replaced by the binary which performs the upgrade, and a binary with a handler for
+// the plan refuses to run the blocks before it.
+func BeginBlock(ctx sdk.Context, k keeper.Keeper) {
+	plan, found := k.GetUpgradePlan(ctx)
+	if !found {
+		return
+	}
+	
+	if !plan.ShouldExecute(ctx.BlockHeight()) {
+		if k.HasUpgradeHandler(plan.Name) {
+			panic(fmt.Sprintf("binary of the upgrade %s was started before the height %d", plan.Name, plan.Height))
+		}
+		
+		return
+	}
+	
+	if !k.HasUpgradeHandler(plan.Name) {
+		msg := fmt.Sprintf("UPGRADE %s NEEDED at height %d: %s", plan.Name, plan.Height, plan.Info)
+		ctx.Logger().Error(msg)
+		panic(msg)
+	}
+	
+	if err := k.ApplyUpgrade(ctx, plan); err != nil {
+		panic(err)
+	}
+	
+	ctx.Logger().Info(fmt.Sprintf("applied the upgrade %s at height %d", plan.Name, ctx.BlockHeight()))
+}
@@ -0,0 +1,40 @@
+package upgrade
+
+import (
+	"testing"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	
+	"github.com/sentinel-official/hub/x/upgrade/keeper"
+)
+
+func TestBeginBlock(t *testing.T) {
+	ctx, k := keeper.CreateTestInput(t, false)
+	BeginBlock(ctx.WithBlockHeight(1), k)
+	
+	k.SetUpgradePlan(ctx, NewPlan("v1", 20, "info"))
+	BeginBlock(ctx.WithBlockHeight(19), k)
+	require.Panics(t, func() { BeginBlock(ctx.WithBlockHeight(20), k) })
+	
+	_, found := k.GetUpgradePlan(ctx)
+	require.Equal(t, true, found)
+	
+	var applied int64
+	k.SetUpgradeHandler("v1", func(ctx sdk.Context, _ Plan) error {
+		applied = ctx.BlockHeight()
+		return nil
+	})
+	require.Panics(t, func() { BeginBlock(ctx.WithBlockHeight(19), k) })
+	
+	BeginBlock(ctx.WithBlockHeight(20), k)
+	require.Equal(t, int64(20), applied)
+	
+	_, found = k.GetUpgradePlan(ctx)
+	require.Equal(t, false, found)
+	height, found := k.GetDoneHeight(ctx, "v1")
+	require.Equal(t, true, found)
+	require.Equal(t, int64(20), height)
+	
+	BeginBlock(ctx.WithBlockHeight(21), k)
+}
@@ -0,0 +1,67 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/sentinel-official/hub/x/upgrade/types/
+// ALIASGEN: github.com/sentinel-official/hub/x/upgrade/keeper/
+// ALIASGEN: github.com/sentinel-official/hub/x/upgrade/querier/
+package upgrade
+
+import (
+	"github.com/sentinel-official/hub/x/upgrade/keeper"
+	"github.com/sentinel-official/hub/x/upgrade/querier"
+	"github.com/sentinel-official/hub/x/upgrade/types"
+)
+
+const (
+	Codespace                         = types.Codespace
+	ModuleName                        = types.ModuleName
+	StoreKey                          = types.StoreKey
+	RouterKey                         = types.RouterKey
+	QuerierRoute                      = types.QuerierRoute
+	QueryPlan                         = types.QueryPlan
+	QueryApplied                      = types.QueryApplied
+	ProposalTypeSoftwareUpgrade       = types.ProposalTypeSoftwareUpgrade
+	ProposalTypeCancelSoftwareUpgrade = types.ProposalTypeCancelSoftwareUpgrade
+)
+
+var (
+	// functions aliases
+	ErrorMarshal                     = types.ErrorMarshal
+	ErrorUnmarshal                   = types.ErrorUnmarshal
+	ErrorInvalidQueryType            = types.ErrorInvalidQueryType
+	ErrorUnknownProposalType         = types.ErrorUnknownProposalType
+	ErrorInvalidField                = types.ErrorInvalidField
+	ErrorPlanHeightPassed            = types.ErrorPlanHeightPassed
+	ErrorUpgradeAlreadyDone          = types.ErrorUpgradeAlreadyDone
+	ErrorPlanDoesNotExist            = types.ErrorPlanDoesNotExist
+	RegisterCodec                    = types.RegisterCodec
+	NewGenesisState                  = types.NewGenesisState
+	DefaultGenesisState              = types.DefaultGenesisState
+	DoneKey                          = types.DoneKey
+	NewPlan                          = types.NewPlan
+	NewSoftwareUpgradeProposal       = types.NewSoftwareUpgradeProposal
+	NewCancelSoftwareUpgradeProposal = types.NewCancelSoftwareUpgradeProposal
+	NewQueryAppliedParams            = types.NewQueryAppliedParams
+	NewKeeper                        = keeper.NewKeeper
+	NewQuerier                       = querier.NewQuerier
+	
+	// variable aliases
+	ModuleCdc                = types.ModuleCdc
+	PlanKey                  = types.PlanKey
+	DoneKeyPrefix            = types.DoneKeyPrefix
+	EventTypeScheduleUpgrade = types.EventTypeScheduleUpgrade
+	EventTypeCancelUpgrade   = types.EventTypeCancelUpgrade
+	EventTypeUpgrade         = types.EventTypeUpgrade
+	AttributeKeyName         = types.AttributeKeyName
+	AttributeKeyHeight       = types.AttributeKeyHeight
+)
+
+type (
+	GenesisState                  = types.GenesisState
+	Plan                          = types.Plan
+	SoftwareUpgradeProposal       = types.SoftwareUpgradeProposal
+	CancelSoftwareUpgradeProposal = types.CancelSoftwareUpgradeProposal
+	QueryAppliedParams            = types.QueryAppliedParams
+	Keeper                        = keeper.Keeper
+	UpgradeHandler                = keeper.UpgradeHandler
+)
@@ -0,0 +1,21 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+)
+
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Querying commands for the upgrade module",
+	}
+	
+	cmd.AddCommand(client.GetCommands(
+		QueryPlanCmd(cdc),
+		QueryAppliedCmd(cdc),
+	)...)
+	
+	return cmd
+}
@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"io/ioutil"
+	
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/spf13/cobra"
+	
+	"github.com/sentinel-official/hub/x/upgrade/types"
+)
+
+type softwareUpgradeProposal struct {
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Plan        types.Plan `json:"plan"`
+	Deposit     sdk.Coins  `json:"deposit"`
+}
+
+type cancelSoftwareUpgradeProposal struct {
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	Deposit     sdk.Coins `json:"deposit"`
+}
+
+func readProposalFile(cdc *codec.Codec, path string, proposal interface{}) error {
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	
+	return cdc.UnmarshalJSON(bytes, proposal)
+}
+
+func submitProposal(cdc *codec.Codec, content gov.Content, deposit sdk.Coins) error {
+	txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+	ctx := context.NewCLIContext().WithCodec(cdc)
+	
+	msg := gov.NewMsgSubmitProposal(content, deposit, ctx.GetFromAddress())
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	
+	return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+}
+
+func SoftwareUpgradeProposalTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "software-upgrade [proposal-file]",
+		Short: "Submit a proposal to schedule the upgrade plan given in the JSON file",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var proposal softwareUpgradeProposal
+			if err := readProposalFile(cdc, args[0], &proposal); err != nil {
+				return err
+			}
+			
+			content := types.NewSoftwareUpgradeProposal(proposal.Title, proposal.Description, proposal.Plan)
+			return submitProposal(cdc, content, proposal.Deposit)
+		},
+	}
+	
+	return cmd
+}
+
+func CancelSoftwareUpgradeProposalTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-software-upgrade [proposal-file]",
+		Short: "Submit a proposal to cancel the scheduled upgrade plan",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var proposal cancelSoftwareUpgradeProposal
+			if err := readProposalFile(cdc, args[0], &proposal); err != nil {
+				return err
+			}
+			
+			content := types.NewCancelSoftwareUpgradeProposal(proposal.Title, proposal.Description)
+			return submitProposal(cdc, content, proposal.Deposit)
+		},
+	}
+	
+	return cmd
+}
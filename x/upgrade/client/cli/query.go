@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"fmt"
+	
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+	
+	"github.com/sentinel-official/hub/x/upgrade/client/common"
+)
+
+func QueryPlanCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "plan",
+		Args:  cobra.NoArgs,
+		Short: "Query the scheduled upgrade plan",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+			
+			plan, err := common.QueryPlan(ctx)
+			if err != nil {
+				return err
+			}
+			
+			fmt.Println(plan)
+			return nil
+		},
+	}
+}
+
+func QueryAppliedCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "applied [name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the height at which the upgrade was applied",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+			
+			height, err := common.QueryApplied(ctx, args[0])
+			if err != nil {
+				return err
+			}
+			
+			fmt.Println(height)
+			return nil
+		},
+	}
+}
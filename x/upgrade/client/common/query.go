@@ -0,0 +1,52 @@
+package common
+
+import (
+	"fmt"
+	
+	"github.com/cosmos/cosmos-sdk/client/context"
+	
+	"github.com/sentinel-official/hub/x/upgrade/types"
+)
+
+func QueryPlan(ctx context.CLIContext) (*types.Plan, error) {
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPlan)
+	res, _, err := ctx.QueryWithData(path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, fmt.Errorf("no upgrade plan found")
+	}
+	
+	var plan types.Plan
+	if err = ctx.Codec.UnmarshalJSON(res, &plan); err != nil {
+		return nil, err
+	}
+	
+	return &plan, nil
+}
+
+func QueryApplied(ctx context.CLIContext, name string) (int64, error) {
+	params := types.NewQueryAppliedParams(name)
+	
+	bytes, err := ctx.Codec.MarshalJSON(params)
+	if err != nil {
+		return 0, err
+	}
+	
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryApplied)
+	res, _, err := ctx.QueryWithData(path, bytes)
+	if err != nil {
+		return 0, err
+	}
+	if res == nil {
+		return 0, fmt.Errorf("upgrade %s is not applied", name)
+	}
+	
+	var height int64
+	if err = ctx.Codec.UnmarshalJSON(res, &height); err != nil {
+		return 0, err
+	}
+	
+	return height, nil
+}
@@ -0,0 +1,15 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	
+	"github.com/sentinel-official/hub/x/upgrade/client/cli"
+	"github.com/sentinel-official/hub/x/upgrade/client/rest"
+)
+
+var (
+	SoftwareUpgradeProposalHandler = govclient.NewProposalHandler(cli.SoftwareUpgradeProposalTxCmd,
+		rest.SoftwareUpgradeProposalRESTHandler)
+	CancelSoftwareUpgradeProposalHandler = govclient.NewProposalHandler(cli.CancelSoftwareUpgradeProposalTxCmd,
+		rest.CancelSoftwareUpgradeProposalRESTHandler)
+)
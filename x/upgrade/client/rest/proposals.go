@@ -0,0 +1,81 @@
+package rest
+
+import (
+	"net/http"
+	
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	
+	"github.com/sentinel-official/hub/x/upgrade/types"
+)
+
+type softwareUpgradeProposalReq struct {
+	BaseReq     rest.BaseReq `json:"base_req"`
+	Title       string       `json:"title"`
+	Description string       `json:"description"`
+	Plan        types.Plan   `json:"plan"`
+	Deposit     sdk.Coins    `json:"deposit"`
+}
+
+type cancelSoftwareUpgradeProposalReq struct {
+	BaseReq     rest.BaseReq `json:"base_req"`
+	Title       string       `json:"title"`
+	Description string       `json:"description"`
+	Deposit     sdk.Coins    `json:"deposit"`
+}
+
+func SoftwareUpgradeProposalRESTHandler(ctx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "software_upgrade",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req softwareUpgradeProposalReq
+			if !rest.ReadRESTReq(w, r, ctx.Codec, &req) {
+				return
+			}
+			
+			content := types.NewSoftwareUpgradeProposal(req.Title, req.Description, req.Plan)
+			writeProposal(w, ctx, req.BaseReq, content, req.Deposit)
+		},
+	}
+}
+
+func CancelSoftwareUpgradeProposalRESTHandler(ctx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "cancel_software_upgrade",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req cancelSoftwareUpgradeProposalReq
+			if !rest.ReadRESTReq(w, r, ctx.Codec, &req) {
+				return
+			}
+			
+			content := types.NewCancelSoftwareUpgradeProposal(req.Title, req.Description)
+			writeProposal(w, ctx, req.BaseReq, content, req.Deposit)
+		},
+	}
+}
+
+func writeProposal(w http.ResponseWriter, ctx context.CLIContext,
+	baseReq rest.BaseReq, content gov.Content, deposit sdk.Coins) {
+	baseReq = baseReq.Sanitize()
+	if !baseReq.ValidateBasic(w) {
+		return
+	}
+	
+	fromAddress, err := sdk.AccAddressFromBech32(baseReq.From)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	
+	msg := gov.NewMsgSubmitProposal(content, deposit, fromAddress)
+	if err := msg.ValidateBasic(); err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	
+	utils.WriteGenerateStdTxResponse(w, ctx, baseReq, []sdk.Msg{msg})
+}
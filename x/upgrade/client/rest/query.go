@@ -0,0 +1,37 @@
+package rest
+
+import (
+	"net/http"
+	
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+	
+	"github.com/sentinel-official/hub/x/upgrade/client/common"
+)
+
+func getPlanHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		plan, err := common.QueryPlan(ctx)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		
+		rest.PostProcessResponse(w, ctx, plan)
+	}
+}
+
+func getAppliedHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		
+		height, err := common.QueryApplied(ctx, vars["name"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		
+		rest.PostProcessResponse(w, ctx, height)
+	}
+}
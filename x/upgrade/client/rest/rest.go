@@ -0,0 +1,17 @@
+package rest
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+)
+
+func RegisterRoutes(ctx context.CLIContext, r *mux.Router) {
+	registerQueryRoutes(ctx, r)
+}
+
+func registerQueryRoutes(ctx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/upgrade/plan", getPlanHandlerFunc(ctx)).
+		Methods("GET")
+	r.HandleFunc("/upgrade/applied/{name}", getAppliedHandlerFunc(ctx)).
+		Methods("GET")
+}
@@ -0,0 +1,17 @@
+package upgrade
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/sentinel-official/hub/x/upgrade/types"
+)
+
+func InitGenesis(_ sdk.Context, _ Keeper, _ types.GenesisState) {}
+
+func ExportGenesis(_ sdk.Context, _ Keeper) types.GenesisState {
+	return types.NewGenesisState()
+}
+
+func ValidateGenesis(_ types.GenesisState) error {
+	return nil
+}
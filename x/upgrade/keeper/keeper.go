@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/sentinel-official/hub/x/upgrade/types"
+)
+
+// UpgradeHandler performs an upgrade, such as the migrations of the stores of the modules, at the height of its plan
+type UpgradeHandler func(ctx sdk.Context, plan types.Plan) error
+
+type Keeper struct {
+	key      sdk.StoreKey
+	cdc      *codec.Codec
+	handlers map[string]UpgradeHandler
+}
+
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey) Keeper {
+	return Keeper{
+		key:      key,
+		cdc:      cdc,
+		handlers: make(map[string]UpgradeHandler),
+	}
+}
+
+// SetUpgradeHandler registers the handler of the plan with the name. A binary registers the handlers of the
+// upgrades it performs before the chain starts.
+func (k Keeper) SetUpgradeHandler(name string, handler UpgradeHandler) {
+	k.handlers[name] = handler
+}
+
+func (k Keeper) HasUpgradeHandler(name string) bool {
+	_, found := k.handlers[name]
+	return found
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"fmt"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/sentinel-official/hub/x/upgrade/types"
+)
+
+func (k Keeper) SetUpgradePlan(ctx sdk.Context, plan types.Plan) {
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(plan)
+	
+	store := ctx.KVStore(k.key)
+	store.Set(types.PlanKey, value)
+}
+
+func (k Keeper) GetUpgradePlan(ctx sdk.Context) (plan types.Plan, found bool) {
+	store := ctx.KVStore(k.key)
+	
+	value := store.Get(types.PlanKey)
+	if value == nil {
+		return plan, false
+	}
+	
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &plan)
+	return plan, true
+}
+
+func (k Keeper) ClearUpgradePlan(ctx sdk.Context) {
+	store := ctx.KVStore(k.key)
+	store.Delete(types.PlanKey)
+}
+
+func (k Keeper) SetDoneHeight(ctx sdk.Context, name string, height int64) {
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(height)
+	
+	store := ctx.KVStore(k.key)
+	store.Set(types.DoneKey(name), value)
+}
+
+func (k Keeper) GetDoneHeight(ctx sdk.Context, name string) (height int64, found bool) {
+	store := ctx.KVStore(k.key)
+	
+	value := store.Get(types.DoneKey(name))
+	if value == nil {
+		return 0, false
+	}
+	
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &height)
+	return height, true
+}
+
+// ScheduleUpgrade stores the plan in place of the scheduled one. The plan must be above the current height
+// and must not name an upgrade which is done already.
+func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan types.Plan) sdk.Error {
+	if err := plan.IsValid(); err != nil {
+		return types.ErrorInvalidField("plan: " + err.Error())
+	}
+	if plan.Height <= ctx.BlockHeight() {
+		return types.ErrorPlanHeightPassed(plan.Height, ctx.BlockHeight())
+	}
+	if height, found := k.GetDoneHeight(ctx, plan.Name); found {
+		return types.ErrorUpgradeAlreadyDone(plan.Name, height)
+	}
+	
+	k.SetUpgradePlan(ctx, plan)
+	return nil
+}
+
+// ApplyUpgrade runs the handler of the plan and clears the plan. Nothing is written if the handler returns
+// an error.
+func (k Keeper) ApplyUpgrade(ctx sdk.Context, plan types.Plan) error {
+	handler, found := k.handlers[plan.Name]
+	if !found {
+		return fmt.Errorf("no handler for the upgrade %s", plan.Name)
+	}
+	
+	cacheCtx, write := ctx.CacheContext()
+	if err := handler(cacheCtx, plan); err != nil {
+		return fmt.Errorf("failed to apply the upgrade %s: %s", plan.Name, err)
+	}
+	
+	k.SetDoneHeight(cacheCtx, plan.Name, ctx.BlockHeight())
+	k.ClearUpgradePlan(cacheCtx)
+	write()
+	
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpgrade,
+			sdk.NewAttribute(types.AttributeKeyName, plan.Name),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
+		),
+	)
+	
+	return nil
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	
+	"github.com/sentinel-official/hub/x/upgrade/types"
+)
+
+func TestKeeper_ScheduleUpgrade(t *testing.T) {
+	ctx, k := CreateTestInput(t, false)
+	ctx = ctx.WithBlockHeight(10)
+	
+	_, found := k.GetUpgradePlan(ctx)
+	require.Equal(t, false, found)
+	
+	require.NotNil(t, k.ScheduleUpgrade(ctx, types.NewPlan("", 20, "")))
+	require.NotNil(t, k.ScheduleUpgrade(ctx, types.NewPlan("v1", 10, "")))
+	require.NotNil(t, k.ScheduleUpgrade(ctx, types.NewPlan("v1", 5, "")))
+	
+	require.Nil(t, k.ScheduleUpgrade(ctx, types.NewPlan("v1", 20, "")))
+	plan, found := k.GetUpgradePlan(ctx)
+	require.Equal(t, true, found)
+	require.Equal(t, types.NewPlan("v1", 20, ""), plan)
+	
+	require.Nil(t, k.ScheduleUpgrade(ctx, types.NewPlan("v2", 30, "info")))
+	plan, found = k.GetUpgradePlan(ctx)
+	require.Equal(t, true, found)
+	require.Equal(t, types.NewPlan("v2", 30, "info"), plan)
+	
+	k.SetDoneHeight(ctx, "v0", 5)
+	require.NotNil(t, k.ScheduleUpgrade(ctx, types.NewPlan("v0", 40, "")))
+	
+	k.ClearUpgradePlan(ctx)
+	_, found = k.GetUpgradePlan(ctx)
+	require.Equal(t, false, found)
+}
+
+func TestKeeper_ApplyUpgrade(t *testing.T) {
+	ctx, k := CreateTestInput(t, false)
+	ctx = ctx.WithBlockHeight(20)
+	
+	plan := types.NewPlan("v1", 20, "")
+	k.SetUpgradePlan(ctx, plan)
+	require.NotNil(t, k.ApplyUpgrade(ctx, plan))
+	
+	k.SetUpgradeHandler("v1", func(ctx sdk.Context, _ types.Plan) error {
+		k.SetDoneHeight(ctx, "migrated", ctx.BlockHeight())
+		return fmt.Errorf("failed")
+	})
+	require.Equal(t, true, k.HasUpgradeHandler("v1"))
+	require.NotNil(t, k.ApplyUpgrade(ctx, plan))
+	
+	_, found := k.GetDoneHeight(ctx, "migrated")
+	require.Equal(t, false, found)
+	_, found = k.GetUpgradePlan(ctx)
+	require.Equal(t, true, found)
+	
+	k.SetUpgradeHandler("v1", func(ctx sdk.Context, _ types.Plan) error {
+		k.SetDoneHeight(ctx, "migrated", ctx.BlockHeight())
+		return nil
+	})
+	require.Nil(t, k.ApplyUpgrade(ctx, plan))
+	
+	height, found := k.GetDoneHeight(ctx, "migrated")
+	require.Equal(t, true, found)
+	require.Equal(t, int64(20), height)
+	height, found = k.GetDoneHeight(ctx, "v1")
+	require.Equal(t, true, found)
+	require.Equal(t, int64(20), height)
+	_, found = k.GetUpgradePlan(ctx)
+	require.Equal(t, false, found)
+}
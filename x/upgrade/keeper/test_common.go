@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"testing"
+	
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	db "github.com/tendermint/tm-db"
+	
+	"github.com/sentinel-official/hub/x/upgrade/types"
+)
+
+func CreateTestInput(t *testing.T, isCheckTx bool) (sdk.Context, Keeper) {
+	keyUpgrade := sdk.NewKVStoreKey(types.StoreKey)
+	
+	mdb := db.NewMemDB()
+	ms := store.NewCommitMultiStore(mdb)
+	ms.MountStoreWithDB(keyUpgrade, sdk.StoreTypeIAVL, mdb)
+	require.Nil(t, ms.LoadLatestVersion())
+	
+	cdc := MakeTestCodec()
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "chain-id"}, isCheckTx, log.NewNopLogger())
+	
+	uk := NewKeeper(cdc, keyUpgrade)
+	
+	return ctx, uk
+}
+
+func MakeTestCodec() *codec.Codec {
+	var cdc = codec.New()
+	codec.RegisterCrypto(cdc)
+	types.RegisterCodec(cdc)
+	return cdc
+}
@@ -0,0 +1,106 @@
+package upgrade
+
+import (
+	"encoding/json"
+	
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+	
+	"github.com/sentinel-official/hub/x/upgrade/client/cli"
+	"github.com/sentinel-official/hub/x/upgrade/client/rest"
+)
+
+var (
+	_ module.AppModuleBasic = AppModuleBasic{}
+	_ module.AppModule      = AppModule{}
+)
+
+type AppModuleBasic struct{}
+
+func (a AppModuleBasic) Name() string {
+	return ModuleName
+}
+
+func (a AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
+	RegisterCodec(cdc)
+}
+
+func (a AppModuleBasic) DefaultGenesis() json.RawMessage {
+	return ModuleCdc.MustMarshalJSON(DefaultGenesisState())
+}
+
+func (a AppModuleBasic) ValidateGenesis(data json.RawMessage) error {
+	var state GenesisState
+	if err := ModuleCdc.UnmarshalJSON(data, &state); err != nil {
+		return err
+	}
+	
+	return ValidateGenesis(state)
+}
+
+func (a AppModuleBasic) RegisterRESTRoutes(ctx context.CLIContext, r *mux.Router) {
+	rest.RegisterRoutes(ctx, r)
+}
+
+func (a AppModuleBasic) GetTxCmd(_ *codec.Codec) *cobra.Command {
+	return &cobra.Command{}
+}
+
+func (a AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	return cli.GetQueryCmd(cdc)
+}
+
+type AppModule struct {
+	AppModuleBasic
+	keeper Keeper
+}
+
+func NewAppModule(k Keeper) AppModule {
+	return AppModule{
+		keeper: k,
+	}
+}
+
+func (a AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
+	var state GenesisState
+	ModuleCdc.MustUnmarshalJSON(data, &state)
+	InitGenesis(ctx, a.keeper, state)
+	
+	return nil
+}
+
+func (a AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
+	state := ExportGenesis(ctx, a.keeper)
+	return ModuleCdc.MustMarshalJSON(state)
+}
+
+func (a AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+
+func (a AppModule) Route() string {
+	return RouterKey
+}
+
+func (a AppModule) NewHandler() sdk.Handler {
+	return nil
+}
+
+func (a AppModule) QuerierRoute() string {
+	return QuerierRoute
+}
+
+func (a AppModule) NewQuerierHandler() sdk.Querier {
+	return NewQuerier(a.keeper)
+}
+
+func (a AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	BeginBlock(ctx, a.keeper)
+}
+
+func (a AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	return nil
+}
@@ -0,0 +1,61 @@
+package upgrade
+
+import (
+	"fmt"
+	"reflect"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	
+	"github.com/sentinel-official/hub/x/upgrade/keeper"
+	"github.com/sentinel-official/hub/x/upgrade/types"
+)
+
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) sdk.Error {
+		switch content := content.(type) {
+		case types.SoftwareUpgradeProposal:
+			return handleSoftwareUpgradeProposal(ctx, k, content)
+		case types.CancelSoftwareUpgradeProposal:
+			return handleCancelSoftwareUpgradeProposal(ctx, k)
+		
+		default:
+			return types.ErrorUnknownProposalType(reflect.TypeOf(content).Name())
+		}
+	}
+}
+
+func handleSoftwareUpgradeProposal(ctx sdk.Context, k keeper.Keeper, proposal types.SoftwareUpgradeProposal) sdk.Error {
+	if err := k.ScheduleUpgrade(ctx, proposal.Plan); err != nil {
+		return err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeScheduleUpgrade,
+			sdk.NewAttribute(types.AttributeKeyName, proposal.Plan.Name),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", proposal.Plan.Height)),
+		),
+	)
+	
+	return nil
+}
+
+func handleCancelSoftwareUpgradeProposal(ctx sdk.Context, k keeper.Keeper) sdk.Error {
+	plan, found := k.GetUpgradePlan(ctx)
+	if !found {
+		return types.ErrorPlanDoesNotExist()
+	}
+	
+	k.ClearUpgradePlan(ctx)
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelUpgrade,
+			sdk.NewAttribute(types.AttributeKeyName, plan.Name),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", plan.Height)),
+		),
+	)
+	
+	return nil
+}
@@ -0,0 +1,40 @@
+package upgrade
+
+import (
+	"testing"
+	
+	"github.com/stretchr/testify/require"
+	
+	"github.com/sentinel-official/hub/x/upgrade/keeper"
+)
+
+func Test_handleSoftwareUpgradeProposal(t *testing.T) {
+	ctx, k := keeper.CreateTestInput(t, false)
+	ctx = ctx.WithBlockHeight(10)
+	handler := NewProposalHandler(k)
+	
+	err := handler(ctx, NewSoftwareUpgradeProposal("title", "description", NewPlan("v1", 10, "")))
+	require.NotNil(t, err)
+	_, found := k.GetUpgradePlan(ctx)
+	require.Equal(t, false, found)
+	
+	err = handler(ctx, NewSoftwareUpgradeProposal("title", "description", NewPlan("v1", 20, "info")))
+	require.Nil(t, err)
+	plan, found := k.GetUpgradePlan(ctx)
+	require.Equal(t, true, found)
+	require.Equal(t, NewPlan("v1", 20, "info"), plan)
+}
+
+func Test_handleCancelSoftwareUpgradeProposal(t *testing.T) {
+	ctx, k := keeper.CreateTestInput(t, false)
+	handler := NewProposalHandler(k)
+	
+	err := handler(ctx, NewCancelSoftwareUpgradeProposal("title", "description"))
+	require.NotNil(t, err)
+	
+	k.SetUpgradePlan(ctx, NewPlan("v1", 20, ""))
+	err = handler(ctx, NewCancelSoftwareUpgradeProposal("title", "description"))
+	require.Nil(t, err)
+	_, found := k.GetUpgradePlan(ctx)
+	require.Equal(t, false, found)
+}
@@ -0,0 +1,42 @@
+package querier
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	
+	"github.com/sentinel-official/hub/x/upgrade/keeper"
+	"github.com/sentinel-official/hub/x/upgrade/types"
+)
+
+func queryPlan(ctx sdk.Context, k keeper.Keeper) ([]byte, sdk.Error) {
+	plan, found := k.GetUpgradePlan(ctx)
+	if !found {
+		return nil, nil
+	}
+	
+	res, err := types.ModuleCdc.MarshalJSON(plan)
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+	
+	return res, nil
+}
+
+func queryApplied(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAppliedParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, types.ErrorUnmarshal()
+	}
+	
+	height, found := k.GetDoneHeight(ctx, params.Name)
+	if !found {
+		return nil, nil
+	}
+	
+	res, err := types.ModuleCdc.MarshalJSON(height)
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+	
+	return res, nil
+}
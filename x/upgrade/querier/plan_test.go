@@ -0,0 +1,49 @@
+package querier
+
+import (
+	"testing"
+	
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	
+	"github.com/sentinel-official/hub/x/upgrade/keeper"
+	"github.com/sentinel-official/hub/x/upgrade/types"
+)
+
+func TestQuerier_Plan(t *testing.T) {
+	ctx, k := keeper.CreateTestInput(t, false)
+	querier := NewQuerier(k)
+	
+	res, err := querier(ctx, []string{types.QueryPlan}, abci.RequestQuery{})
+	require.Nil(t, err)
+	require.Nil(t, res)
+	
+	k.SetUpgradePlan(ctx, types.NewPlan("v1", 20, "info"))
+	res, err = querier(ctx, []string{types.QueryPlan}, abci.RequestQuery{})
+	require.Nil(t, err)
+	
+	var plan types.Plan
+	require.Nil(t, types.ModuleCdc.UnmarshalJSON(res, &plan))
+	require.Equal(t, types.NewPlan("v1", 20, "info"), plan)
+}
+
+func TestQuerier_Applied(t *testing.T) {
+	ctx, k := keeper.CreateTestInput(t, false)
+	querier := NewQuerier(k)
+	
+	req := abci.RequestQuery{Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryAppliedParams("v1"))}
+	res, err := querier(ctx, []string{types.QueryApplied}, req)
+	require.Nil(t, err)
+	require.Nil(t, res)
+	
+	k.SetDoneHeight(ctx, "v1", 20)
+	res, err = querier(ctx, []string{types.QueryApplied}, req)
+	require.Nil(t, err)
+	
+	var height int64
+	require.Nil(t, types.ModuleCdc.UnmarshalJSON(res, &height))
+	require.Equal(t, int64(20), height)
+	
+	_, err = querier(ctx, []string{"invalid"}, abci.RequestQuery{})
+	require.NotNil(t, err)
+}
@@ -0,0 +1,22 @@
+package querier
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	
+	"github.com/sentinel-official/hub/x/upgrade/keeper"
+	"github.com/sentinel-official/hub/x/upgrade/types"
+)
+
+func NewQuerier(k keeper.Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryPlan:
+			return queryPlan(ctx, k)
+		case types.QueryApplied:
+			return queryApplied(ctx, req, k)
+		default:
+			return nil, types.ErrorInvalidQueryType(path[0])
+		}
+	}
+}
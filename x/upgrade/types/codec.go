@@ -0,0 +1,21 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+var (
+	ModuleCdc *codec.Codec
+)
+
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(SoftwareUpgradeProposal{}, "x/upgrade/SoftwareUpgradeProposal", nil)
+	cdc.RegisterConcrete(CancelSoftwareUpgradeProposal{}, "x/upgrade/CancelSoftwareUpgradeProposal", nil)
+}
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
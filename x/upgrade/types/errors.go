@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	hub "github.com/sentinel-official/hub/types"
+)
+
+const (
+	Codespace = sdk.CodespaceType("upgrade")
+	
+	errCodeUnknownQueryType    = 103
+	errCodeUnknownProposalType = 104
+	errCodeInvalidField        = 105
+	errCodePlanHeightPassed    = 106
+	errCodeUpgradeAlreadyDone  = 107
+	errCodePlanDoesNotExist    = 108
+	
+	errMsgUnknownQueryType    = "Invalid query type: "
+	errMsgUnknownProposalType = "Unknown proposal type: "
+	errMsgInvalidField        = "Invalid field: "
+	errMsgPlanHeightPassed    = "plan height %d is not above the current height %d"
+	errMsgUpgradeAlreadyDone  = "upgrade %s was already done at height %d"
+	errMsgPlanDoesNotExist    = "Plan does not exist"
+)
+
+func ErrorMarshal() sdk.Error {
+	return sdk.NewError(Codespace, hub.ErrCodeMarshal, hub.ErrMsgMarshal)
+}
+
+func ErrorUnmarshal() sdk.Error {
+	return sdk.NewError(Codespace, hub.ErrCodeUnmarshal, hub.ErrMsgUnmarshal)
+}
+
+func ErrorInvalidQueryType(queryType string) sdk.Error {
+	return sdk.NewError(Codespace, errCodeUnknownQueryType, errMsgUnknownQueryType+queryType)
+}
+
+func ErrorUnknownProposalType(proposalType string) sdk.Error {
+	return sdk.NewError(Codespace, errCodeUnknownProposalType, errMsgUnknownProposalType+proposalType)
+}
+
+func ErrorInvalidField(field string) sdk.Error {
+	return sdk.NewError(Codespace, errCodeInvalidField, errMsgInvalidField+field)
+}
+
+func ErrorPlanHeightPassed(height, current int64) sdk.Error {
+	return sdk.NewError(Codespace, errCodePlanHeightPassed, fmt.Sprintf(errMsgPlanHeightPassed, height, current))
+}
+
+func ErrorUpgradeAlreadyDone(name string, height int64) sdk.Error {
+	return sdk.NewError(Codespace, errCodeUpgradeAlreadyDone, fmt.Sprintf(errMsgUpgradeAlreadyDone, name, height))
+}
+
+func ErrorPlanDoesNotExist() sdk.Error {
+	return sdk.NewError(Codespace, errCodePlanDoesNotExist, errMsgPlanDoesNotExist)
+}
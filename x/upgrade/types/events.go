@@ -0,0 +1,10 @@
+package types
+
+var (
+	EventTypeScheduleUpgrade = "schedule_upgrade"
+	EventTypeCancelUpgrade   = "cancel_upgrade"
+	EventTypeUpgrade         = "upgrade"
+	
+	AttributeKeyName   = "name"
+	AttributeKeyHeight = "height"
+)
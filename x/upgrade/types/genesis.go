@@ -0,0 +1,12 @@
+package types
+
+// GenesisState is empty, as a plan is not carried over to a new chain
+type GenesisState struct{}
+
+func NewGenesisState() GenesisState {
+	return GenesisState{}
+}
+
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState()
+}
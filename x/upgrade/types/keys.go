@@ -0,0 +1,17 @@
+package types
+
+const (
+	ModuleName   = "upgrade"
+	StoreKey     = ModuleName
+	RouterKey    = ModuleName
+	QuerierRoute = ModuleName
+)
+
+var (
+	PlanKey       = []byte{0x00}
+	DoneKeyPrefix = []byte{0x01}
+)
+
+func DoneKey(name string) []byte {
+	return append(DoneKeyPrefix, []byte(name)...)
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Plan is an upgrade scheduled by governance. The chain halts at the height of the plan unless the binary
+// which runs it has a handler registered under the name of the plan.
+type Plan struct {
+	Name   string `json:"name"`
+	Height int64  `json:"height"`
+	Info   string `json:"info"`
+}
+
+func NewPlan(name string, height int64, info string) Plan {
+	return Plan{
+		Name:   name,
+		Height: height,
+		Info:   info,
+	}
+}
+
+func (p Plan) String() string {
+	return fmt.Sprintf(`Upgrade Plan
+  Name:   %s
+  Height: %d
+  Info:   %s`, p.Name, p.Height, p.Info)
+}
+
+func (p Plan) IsValid() error {
+	if len(strings.TrimSpace(p.Name)) == 0 {
+		return fmt.Errorf("invalid name")
+	}
+	if p.Height <= 0 {
+		return fmt.Errorf("invalid height")
+	}
+	
+	return nil
+}
+
+// ShouldExecute returns true if the plan is due at the height
+func (p Plan) ShouldExecute(height int64) bool {
+	return p.Height > 0 && height >= p.Height
+}
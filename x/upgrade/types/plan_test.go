@@ -0,0 +1,22 @@
+package types
+
+import (
+	"testing"
+	
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlan_IsValid(t *testing.T) {
+	require.NotNil(t, NewPlan("", 10, "").IsValid())
+	require.NotNil(t, NewPlan(" ", 10, "").IsValid())
+	require.NotNil(t, NewPlan("v1", 0, "").IsValid())
+	require.NotNil(t, NewPlan("v1", -1, "").IsValid())
+	require.Nil(t, NewPlan("v1", 10, "").IsValid())
+}
+
+func TestPlan_ShouldExecute(t *testing.T) {
+	require.Equal(t, false, Plan{}.ShouldExecute(10))
+	require.Equal(t, false, NewPlan("v1", 10, "").ShouldExecute(9))
+	require.Equal(t, true, NewPlan("v1", 10, "").ShouldExecute(10))
+	require.Equal(t, true, NewPlan("v1", 10, "").ShouldExecute(11))
+}
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"fmt"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeSoftwareUpgrade       = "HubSoftwareUpgrade"
+	ProposalTypeCancelSoftwareUpgrade = "HubCancelSoftwareUpgrade"
+)
+
+var (
+	_ govtypes.Content = SoftwareUpgradeProposal{}
+	_ govtypes.Content = CancelSoftwareUpgradeProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSoftwareUpgrade)
+	govtypes.RegisterProposalTypeCodec(SoftwareUpgradeProposal{}, "x/upgrade/SoftwareUpgradeProposal")
+	govtypes.RegisterProposalType(ProposalTypeCancelSoftwareUpgrade)
+	govtypes.RegisterProposalTypeCodec(CancelSoftwareUpgradeProposal{}, "x/upgrade/CancelSoftwareUpgradeProposal")
+}
+
+// SoftwareUpgradeProposal schedules the plan, replacing the plan which is scheduled already
+type SoftwareUpgradeProposal struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Plan        Plan   `json:"plan"`
+}
+
+func NewSoftwareUpgradeProposal(title, description string, plan Plan) SoftwareUpgradeProposal {
+	return SoftwareUpgradeProposal{
+		Title:       title,
+		Description: description,
+		Plan:        plan,
+	}
+}
+
+func (p SoftwareUpgradeProposal) GetTitle() string       { return p.Title }
+func (p SoftwareUpgradeProposal) GetDescription() string { return p.Description }
+func (p SoftwareUpgradeProposal) ProposalRoute() string  { return RouterKey }
+func (p SoftwareUpgradeProposal) ProposalType() string   { return ProposalTypeSoftwareUpgrade }
+
+func (p SoftwareUpgradeProposal) ValidateBasic() sdk.Error {
+	if err := govtypes.ValidateAbstract(Codespace, p); err != nil {
+		return err
+	}
+	if err := p.Plan.IsValid(); err != nil {
+		return ErrorInvalidField("plan: " + err.Error())
+	}
+	
+	return nil
+}
+
+func (p SoftwareUpgradeProposal) String() string {
+	return fmt.Sprintf(`Software Upgrade Proposal
+  Title:               %s
+  Description:         %s
+%s`, p.Title, p.Description, p.Plan)
+}
+
+// CancelSoftwareUpgradeProposal removes the scheduled plan
+type CancelSoftwareUpgradeProposal struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+func NewCancelSoftwareUpgradeProposal(title, description string) CancelSoftwareUpgradeProposal {
+	return CancelSoftwareUpgradeProposal{
+		Title:       title,
+		Description: description,
+	}
+}
+
+func (p CancelSoftwareUpgradeProposal) GetTitle() string       { return p.Title }
+func (p CancelSoftwareUpgradeProposal) GetDescription() string { return p.Description }
+func (p CancelSoftwareUpgradeProposal) ProposalRoute() string  { return RouterKey }
+func (p CancelSoftwareUpgradeProposal) ProposalType() string   { return ProposalTypeCancelSoftwareUpgrade }
+
+func (p CancelSoftwareUpgradeProposal) ValidateBasic() sdk.Error {
+	return govtypes.ValidateAbstract(Codespace, p)
+}
+
+func (p CancelSoftwareUpgradeProposal) String() string {
+	return fmt.Sprintf(`Cancel Software Upgrade Proposal
+  Title:               %s
+  Description:         %s`, p.Title, p.Description)
+}
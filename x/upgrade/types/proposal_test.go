@@ -0,0 +1,21 @@
+package types
+
+import (
+	"testing"
+	
+	"github.com/stretchr/testify/require"
+)
+
+func TestSoftwareUpgradeProposal_ValidateBasic(t *testing.T) {
+	require.NotNil(t, NewSoftwareUpgradeProposal("", "description", NewPlan("v1", 10, "")).ValidateBasic())
+	require.NotNil(t, NewSoftwareUpgradeProposal("title", "", NewPlan("v1", 10, "")).ValidateBasic())
+	require.NotNil(t, NewSoftwareUpgradeProposal("title", "description", NewPlan("", 10, "")).ValidateBasic())
+	require.NotNil(t, NewSoftwareUpgradeProposal("title", "description", NewPlan("v1", 0, "")).ValidateBasic())
+	require.Nil(t, NewSoftwareUpgradeProposal("title", "description", NewPlan("v1", 10, "")).ValidateBasic())
+}
+
+func TestCancelSoftwareUpgradeProposal_ValidateBasic(t *testing.T) {
+	require.NotNil(t, NewCancelSoftwareUpgradeProposal("", "description").ValidateBasic())
+	require.NotNil(t, NewCancelSoftwareUpgradeProposal("title", "").ValidateBasic())
+	require.Nil(t, NewCancelSoftwareUpgradeProposal("title", "description").ValidateBasic())
+}
@@ -0,0 +1,16 @@
+package types
+
+const (
+	QueryPlan    = "plan"
+	QueryApplied = "applied"
+)
+
+type QueryAppliedParams struct {
+	Name string
+}
+
+func NewQueryAppliedParams(name string) QueryAppliedParams {
+	return QueryAppliedParams{
+		Name: name,
+	}
+}
@@ -21,18 +21,34 @@ const (
 	StoreKeyResolver                 = types.StoreKeyResolver
 	StoreKeyNode                     = types.StoreKeyNode
 	StoreKeySubscription             = types.StoreKeySubscription
+	ConsensusVersion                 = types.ConsensusVersion
 	StatusRegistered                 = types.StatusRegistered
+	StatusUnbonding                  = types.StatusUnbonding
 	StatusActive                     = types.StatusActive
 	StatusInactive                   = types.StatusInactive
+	PlanTypePerGB                    = types.PlanTypePerGB
+	PlanTypePerHour                  = types.PlanTypePerHour
+	PlanTypePerDay                   = types.PlanTypePerDay
+	PlanTypeFlatRate                 = types.PlanTypeFlatRate
+	MaxSubscriptionDuration          = types.MaxSubscriptionDuration
+	ProposalTypeChangeParams         = types.ProposalTypeChangeParams
+	ProposalTypeBlacklist            = types.ProposalTypeBlacklist
+	ProposalTypeMaxPricesPerGB       = types.ProposalTypeMaxPricesPerGB
+	ProposalTypeExchangeRates        = types.ProposalTypeExchangeRates
 	StatusDeRegistered               = types.StatusDeRegistered
 	QueryParams                      = types.QueryParams
 	QueryNode                        = types.QueryNode
 	QueryNodesOfAddress              = types.QueryNodesOfAddress
+	QueryNodes                       = types.QueryNodes
+	SortNodesByPrice                 = types.SortNodesByPrice
+	SortNodesBySpeed                 = types.SortNodesBySpeed
 	QueryAllNodes                    = types.QueryAllNodes
 	QueryFreeNodesOfClient           = types.QueryFreeNodesOfClient
 	QueryFreeClientsOfNode           = types.QueryFreeClientsOfNode
 	QueryResolversOfNode             = types.QueryResolversOfNode
 	QueryNodesOfResolver             = types.QueryNodesOfResolver
+	QueryCommissionsOfResolver       = types.QueryCommissionsOfResolver
+	QueryPendingNodesOfResolver      = types.QueryPendingNodesOfResolver
 	QueryResolvers                   = types.QueryResolvers
 	QuerySubscription                = types.QuerySubscription
 	QuerySubscriptionsOfNode         = types.QuerySubscriptionsOfNode
@@ -43,6 +59,10 @@ const (
 	QuerySessionOfSubscription       = types.QuerySessionOfSubscription
 	QuerySessionsOfSubscription      = types.QuerySessionsOfSubscription
 	QueryAllSessions                 = types.QueryAllSessions
+	QuerySettlement                  = types.QuerySettlement
+	QueryChannel                     = types.QueryChannel
+	QueryChannelsOfNode              = types.QueryChannelsOfNode
+	QueryChannelsOfAddress           = types.QueryChannelsOfAddress
 	DefaultParamspace                = keeper.DefaultParamspace
 )
 
@@ -65,6 +85,26 @@ var (
 	ErrorInvalidBandwidthSignature            = types.ErrorInvalidBandwidthSignature
 	ErrorSessionAlreadyExists                 = types.ErrorSessionAlreadyExists
 	ErrorInvalidSessionStatus                 = types.ErrorInvalidSessionStatus
+	ErrorPlanDoesNotExist                     = types.ErrorPlanDoesNotExist
+	ErrorInvalidEvidence                      = types.ErrorInvalidEvidence
+	ErrorDuplicateEvidence                    = types.ErrorDuplicateEvidence
+	ErrorNodeJailed                           = types.ErrorNodeJailed
+	ErrorUnknownProposalType                  = types.ErrorUnknownProposalType
+	ErrorNodeBlacklisted                      = types.ErrorNodeBlacklisted
+	ErrorResolverBlacklisted                  = types.ErrorResolverBlacklisted
+	ErrorPricesExceedMax                      = types.ErrorPricesExceedMax
+	ErrorSessionDoesNotExist                  = types.ErrorSessionDoesNotExist
+	ErrorSessionsLimitReached                 = types.ErrorSessionsLimitReached
+	ErrorChannelDoesNotExist                  = types.ErrorChannelDoesNotExist
+	ErrorInvalidChannelStatus                 = types.ErrorInvalidChannelStatus
+	ErrorInvalidVoucher                       = types.ErrorInvalidVoucher
+	ErrorChannelNotExpired                    = types.ErrorChannelNotExpired
+	ErrorCommissionRateTooHigh                = types.ErrorCommissionRateTooHigh
+	ErrorCommissionChangeTooHigh              = types.ErrorCommissionChangeTooHigh
+	ErrorCommissionUpdatedTooSoon             = types.ErrorCommissionUpdatedTooSoon
+	ErrorListingRequestDoesNotExist           = types.ErrorListingRequestDoesNotExist
+	ErrorListingAlreadyExists                 = types.ErrorListingAlreadyExists
+	ErrorListingDoesNotExist                  = types.ErrorListingDoesNotExist
 	NewGenesisState                           = types.NewGenesisState
 	DefaultGenesisState                       = types.DefaultGenesisState
 	NodeKey                                   = types.NodeKey
@@ -75,33 +115,72 @@ var (
 	SubscriptionIDByNodeIDKey                 = types.SubscriptionIDByNodeIDKey
 	SubscriptionsCountOfAddressKey            = types.SubscriptionsCountOfAddressKey
 	SubscriptionIDByAddressKey                = types.SubscriptionIDByAddressKey
+	SubscriptionExpiryTimeKey                 = types.SubscriptionExpiryTimeKey
+	SubscriptionExpiryQueueKey                = types.SubscriptionExpiryQueueKey
+	ChannelKey                                = types.ChannelKey
+	ChannelsCountOfNodeKey                    = types.ChannelsCountOfNodeKey
+	ChannelIDByNodeIDKey                      = types.ChannelIDByNodeIDKey
+	ChannelsCountOfAddressKey                 = types.ChannelsCountOfAddressKey
+	ChannelIDByAddressKey                     = types.ChannelIDByAddressKey
 	SessionKey                                = types.SessionKey
 	SessionsCountOfSubscriptionKey            = types.SessionsCountOfSubscriptionKey
 	SessionIDBySubscriptionIDKey              = types.SessionIDBySubscriptionIDKey
 	ActiveNodeIDsKey                          = types.ActiveNodeIDsKey
 	ActiveSessionIDsKey                       = types.ActiveSessionIDsKey
+	SettlementKey                             = types.SettlementKey
+	NodeUnbondingTimeKey                      = types.NodeUnbondingTimeKey
+	NodeUnbondingQueueKey                     = types.NodeUnbondingQueueKey
+	ResolverUnbondingTimeKey                  = types.ResolverUnbondingTimeKey
+	ResolverUnbondingQueueKey                 = types.ResolverUnbondingQueueKey
+	ResolverCommissionsKey                    = types.ResolverCommissionsKey
+	ResolverCommissionKey                     = types.ResolverCommissionKey
+	EvidenceKey                               = types.EvidenceKey
 	NewMsgRegisterNode                        = types.NewMsgRegisterNode
 	NewMsgAddFreeClient                       = types.NewMsgAddFreeClient
 	NewMsgRemoveFreeClient                    = types.NewMsgRemoveFreeClient
+	NewFreeClient                             = types.NewFreeClient
 	NewMsgRegisterVPNOnResolver               = types.NewMsgRegisterVPNOnResolver
 	NewMsgDeregisterVPNOnResolver             = types.NewMsgDeregisterVPNOnResolver
+	NewMsgAcceptVPNOnResolver                 = types.NewMsgAcceptVPNOnResolver
+	NewMsgRejectVPNOnResolver                 = types.NewMsgRejectVPNOnResolver
 	NewMsgUpdateNodeInfo                      = types.NewMsgUpdateNodeInfo
+	NewMsgUpdateNodePlans                     = types.NewMsgUpdateNodePlans
+	NewPerGBPlan                              = types.NewPerGBPlan
+	IsValidResolverEndpoint                   = types.IsValidResolverEndpoint
+	IsValidResolverRegions                    = types.IsValidResolverRegions
 	NewMsgDeregisterNode                      = types.NewMsgDeregisterNode
+	NewMsgNodeHeartbeat                       = types.NewMsgNodeHeartbeat
+	NewMsgTopUpNodeDeposit                    = types.NewMsgTopUpNodeDeposit
 	NewMsgRegisterResolver                    = types.NewMsgRegisterResolver
 	NewMsgUpdateResolverInfo                  = types.NewMsgUpdateResolverInfo
 	NewMsgUpdateSessionInfo                   = types.NewMsgUpdateSessionInfo
+	NewMsgStartSession                        = types.NewMsgStartSession
+	NewMsgBatchUpdateSessions                 = types.NewMsgBatchUpdateSessions
+	NewSessionUpdate                          = types.NewSessionUpdate
 	NewMsgStartSubscription                   = types.NewMsgStartSubscription
 	NewMsgEndSubscription                     = types.NewMsgEndSubscription
 	NewMsgEndSession                          = types.NewMsgEndSession
+	NewMsgSubmitEvidence                      = types.NewMsgSubmitEvidence
+	NewEvidence                               = types.NewEvidence
+	NewMsgOpenChannel                         = types.NewMsgOpenChannel
+	NewMsgRedeemVoucher                       = types.NewMsgRedeemVoucher
+	NewMsgCloseChannel                        = types.NewMsgCloseChannel
+	NewChangeParamsProposal                   = types.NewChangeParamsProposal
+	NewBlacklistProposal                      = types.NewBlacklistProposal
+	NewMaxPricesPerGBProposal                 = types.NewMaxPricesPerGBProposal
+	NewExchangeRatesProposal                  = types.NewExchangeRatesProposal
+	NewExchangeRate                           = types.NewExchangeRate
 	NewMsgDeregisterResolver                  = types.NewMsgDeregisterResolver
 	NewParams                                 = types.NewParams
 	DefaultParams                             = types.DefaultParams
 	NewQueryNodeParams                        = types.NewQueryNodeParams
 	NewQueryNodesOfAddressParams              = types.NewQueryNodesOfAddressParams
+	NewQueryNodesParams                       = types.NewQueryNodesParams
 	NewQueryFreeClientsOfNodeParams           = types.NewQueryFreeClientsOfNodeParams
 	NewQueryNodesOfFreeClientPrams            = types.NewQueryNodesOfFreeClientPrams
 	NewQueryResolversOfNodeParams             = types.NewQueryResolversOfNodeParams
 	NewQueryNodesOfResolverPrams              = types.NewQueryNodesOfResolverPrams
+	NewQueryCommissionsOfResolverParams       = types.NewQueryCommissionsOfResolverParams
 	NewQuerySubscriptionParams                = types.NewQuerySubscriptionParams
 	NewQuerySubscriptionsOfNodePrams          = types.NewQuerySubscriptionsOfNodePrams
 	NewQuerySubscriptionsOfAddressParams      = types.NewQuerySubscriptionsOfAddressParams
@@ -109,13 +188,29 @@ var (
 	NewQuerySessionParams                     = types.NewQuerySessionParams
 	NewQuerySessionOfSubscriptionPrams        = types.NewQuerySessionOfSubscriptionPrams
 	NewQuerySessionsOfSubscriptionPrams       = types.NewQuerySessionsOfSubscriptionPrams
+	NewQuerySettlementParams                  = types.NewQuerySettlementParams
+	NewQueryChannelParams                     = types.NewQueryChannelParams
+	NewQueryChannelsOfNodeParams              = types.NewQueryChannelsOfNodeParams
+	NewQueryChannelsOfAddressParams           = types.NewQueryChannelsOfAddressParams
 	NewKeeper                                 = keeper.NewKeeper
 	ParamKeyTable                             = keeper.ParamKeyTable
 	NewQuerier                                = querier.NewQuerier
+	NewGRPCServer                             = querier.NewGRPCServer
 	RandomNode                                = keeper.RandomNode
 	RandomSubscription                        = keeper.RandomSubscription
 	RandomSession                             = keeper.RandomSession
 	RandomResolver                            = keeper.RandomResolver
+	RegisterInvariants                        = keeper.RegisterInvariants
+	MigrateFreeClients                        = keeper.MigrateFreeClients
+	MigrateLegacyRecords                      = keeper.MigrateLegacyRecords
+	MigrateDepositLocks                       = keeper.MigrateDepositLocks
+	MigrateSessionsOfSubscriptions            = keeper.MigrateSessionsOfSubscriptions
+	RunMigrations                             = keeper.RunMigrations
+	Migrations                                = keeper.Migrations
+	AllInvariants                             = keeper.AllInvariants
+	SubscriptionDepositsInvariant             = keeper.SubscriptionDepositsInvariant
+	NodesCountInvariant                       = keeper.NodesCountInvariant
+	ActiveSessionsInvariant                   = keeper.ActiveSessionsInvariant
 
 	// variable aliases
 	ModuleCdc                            = types.ModuleCdc
@@ -129,30 +224,85 @@ var (
 	SubscriptionIDByNodeIDKeyPrefix      = types.SubscriptionIDByNodeIDKeyPrefix
 	SubscriptionsCountOfAddressKeyPrefix = types.SubscriptionsCountOfAddressKeyPrefix
 	SubscriptionIDByAddressKeyPrefix     = types.SubscriptionIDByAddressKeyPrefix
+	SubscriptionExpiryQueueKeyPrefix     = types.SubscriptionExpiryQueueKeyPrefix
+	ChannelsCountKey                     = types.ChannelsCountKey
+	ChannelKeyPrefix                     = types.ChannelKeyPrefix
+	ChannelsCountOfNodeKeyPrefix         = types.ChannelsCountOfNodeKeyPrefix
+	ChannelIDByNodeIDKeyPrefix           = types.ChannelIDByNodeIDKeyPrefix
+	ChannelsCountOfAddressKeyPrefix      = types.ChannelsCountOfAddressKeyPrefix
+	ChannelIDByAddressKeyPrefix          = types.ChannelIDByAddressKeyPrefix
 	SessionsCountKey                     = types.SessionsCountKey
 	SessionKeyPrefix                     = types.SessionKeyPrefix
 	SessionsCountOfSubscriptionKeyPrefix = types.SessionsCountOfSubscriptionKeyPrefix
 	SessionIDBySubscriptionIDKeyPrefix   = types.SessionIDBySubscriptionIDKeyPrefix
+	SettlementKeyPrefix                  = types.SettlementKeyPrefix
+	EvidenceKeyPrefix                    = types.EvidenceKeyPrefix
+	NodeUnbondingQueueKeyPrefix          = types.NodeUnbondingQueueKeyPrefix
+	ResolverUnbondingQueueKeyPrefix      = types.ResolverUnbondingQueueKeyPrefix
+	ResolverCommissionKeyPrefix          = types.ResolverCommissionKeyPrefix
 	DefaultFreeNodesCount                = types.DefaultFreeNodesCount
 	DefaultDeposit                       = types.DefaultDeposit
 	DefaultSessionInactiveInterval       = types.DefaultSessionInactiveInterval
+	DefaultNodeInactiveInterval          = types.DefaultNodeInactiveInterval
+	DefaultSlashFraction                 = types.DefaultSlashFraction
+	DefaultNodeUnbondingPeriod           = types.DefaultNodeUnbondingPeriod
+	DefaultResolverUnbondingPeriod       = types.DefaultResolverUnbondingPeriod
+	DefaultMaxPricesPerGB                = types.DefaultMaxPricesPerGB
+	DefaultResolverMinDeposit            = types.DefaultResolverMinDeposit
+	DefaultCommissionChangeInterval      = types.DefaultCommissionChangeInterval
+	DefaultExchangeRates                 = types.DefaultExchangeRates
 	KeyFreeNodesCount                    = types.KeyFreeNodesCount
 	KeyDeposit                           = types.KeyDeposit
 	KeySessionInactiveInterval           = types.KeySessionInactiveInterval
+	KeyNodeInactiveInterval              = types.KeyNodeInactiveInterval
+	KeySlashFraction                     = types.KeySlashFraction
+	KeyNodeUnbondingPeriod               = types.KeyNodeUnbondingPeriod
+	KeyResolverUnbondingPeriod           = types.KeyResolverUnbondingPeriod
+	KeyMaxPricesPerGB                    = types.KeyMaxPricesPerGB
+	KeyResolverMinDeposit                = types.KeyResolverMinDeposit
+	KeyCommissionChangeInterval          = types.KeyCommissionChangeInterval
+	KeyExchangeRates                     = types.KeyExchangeRates
 
 	EventTypeMsgRegisterNode            = types.EventTypeMsgRegisterNode
 	EventTypeMsgUpdateNodeInfo          = types.EventTypeMsgUpdateNodeInfo
+	EventTypeMsgUpdateNodePlans         = types.EventTypeMsgUpdateNodePlans
 	EventTypeMsgDeregisterNode          = types.EventTypeMsgDeregisterNode
+	EventTypeMsgNodeHeartbeat           = types.EventTypeMsgNodeHeartbeat
+	EventTypeInactivateNode             = types.EventTypeInactivateNode
+	EventTypeMsgTopUpNodeDeposit        = types.EventTypeMsgTopUpNodeDeposit
+	EventTypeCompleteNodeUnbonding      = types.EventTypeCompleteNodeUnbonding
 	EventTypeMsgRegisterResolver        = types.EventTypeMsgRegisterResolver
 	EventTypeMsgUpdateResolverInfo      = types.EventTypeMsgUpdateResolverInfo
 	EventTypeMsgDeregisterResolver      = types.EventTypeMsgDeregisterResolver
+	EventTypeCompleteResolverUnbonding  = types.EventTypeCompleteResolverUnbonding
 	EventTypeMsgAddFreeClient           = types.EventTypeMsgAddFreeClient
 	EventTypeMsgRemoveFreeClient        = types.EventTypeMsgRemoveFreeClient
+	EventTypeFreeClientExhausted        = types.EventTypeFreeClientExhausted
+	EventTypeFreeClientExpired          = types.EventTypeFreeClientExpired
+	EventTypeMigrateStore               = types.EventTypeMigrateStore
 	EventTypeMsgRegisterVPNOnResolver   = types.EventTypeMsgRegisterVPNOnResolver
 	EventTypeMsgDeregisterVPNOnResolver = types.EventTypeMsgDeregisterVPNOnResolver
+	EventTypeMsgAcceptVPNOnResolver     = types.EventTypeMsgAcceptVPNOnResolver
+	EventTypeMsgRejectVPNOnResolver     = types.EventTypeMsgRejectVPNOnResolver
 	EventTypeMsgStartSubscription       = types.EventTypeMsgStartSubscription
 	EventTypeMsgEndSubscription         = types.EventTypeMsgEndSubscription
 	EventTypeMsgUpdateSessionInfo       = types.EventTypeMsgUpdateSessionInfo
+	EventTypeMsgStartSession            = types.EventTypeMsgStartSession
+	EventTypeMsgEndSession              = types.EventTypeMsgEndSession
+	EventTypeMsgSubmitEvidence          = types.EventTypeMsgSubmitEvidence
+	EventTypeMsgOpenChannel             = types.EventTypeMsgOpenChannel
+	EventTypeMsgRedeemVoucher           = types.EventTypeMsgRedeemVoucher
+	EventTypeMsgCloseChannel            = types.EventTypeMsgCloseChannel
+	EventTypeSettleSession              = types.EventTypeSettleSession
+	EventTypeSettleSubscription         = types.EventTypeSettleSubscription
+	EventTypeAbortSession               = types.EventTypeAbortSession
+	EventTypeAbortSubscription          = types.EventTypeAbortSubscription
+	EventTypeSlashNode                  = types.EventTypeSlashNode
+	EventTypeChangeParams               = types.EventTypeChangeParams
+	EventTypeBlacklistNode              = types.EventTypeBlacklistNode
+	EventTypeBlacklistResolver          = types.EventTypeBlacklistResolver
+	EventTypeChangeMaxPricesPerGB       = types.EventTypeChangeMaxPricesPerGB
+	EventTypeChangeExchangeRates        = types.EventTypeChangeExchangeRates
 
 	AttributeKeyClientAddress = types.AttributeKeyClientAddress
 	AttributeKeyFromAddress   = types.AttributeKeyFromAddress
@@ -160,20 +310,42 @@ var (
 	AttributeKeyResolverID    = types.AttributeKeyResolverID
 	AttributeSubscriptionID   = types.AttributeSubscriptionID
 	AttributeSessionID        = types.AttributeSessionID
+	AttributeKeyChannelID     = types.AttributeKeyChannelID
 	AttributeKeyStatus        = types.AttributeKeyStatus
 	AttributeKeyCommission    = types.AttributeKeyCommission
 	AttributeKeyDeposit       = types.AttributeKeyDeposit
+	AttributeKeyPayer         = types.AttributeKeyPayer
+	AttributeKeyNodePayee     = types.AttributeKeyNodePayee
+	AttributeKeyResolverPayee = types.AttributeKeyResolverPayee
+	AttributeKeyAmount        = types.AttributeKeyAmount
+	AttributeKeyBandwidth     = types.AttributeKeyBandwidth
+	AttributeKeyRefund        = types.AttributeKeyRefund
+	AttributeKeyVictim        = types.AttributeKeyVictim
+	AttributeKeyJailed        = types.AttributeKeyJailed
+	AttributeKeyVersion       = types.AttributeKeyVersion
+	AttributeKeyExchangeRates = types.AttributeKeyExchangeRates
+	AttributeKeyBlacklisted   = types.AttributeKeyBlacklisted
+	AttributeKeyPricesPerGB   = types.AttributeKeyPricesPerGB
 )
 
 type (
 	GenesisState                           = types.GenesisState
+	ActiveNodeIDs                          = types.ActiveNodeIDs
+	ActiveSessionIDs                       = types.ActiveSessionIDs
+	ResolverNode                           = types.ResolverNode
 	Node                                   = types.Node
 	MsgRegisterNode                        = types.MsgRegisterNode
 	MsgUpdateNodeInfo                      = types.MsgUpdateNodeInfo
+	MsgUpdateNodePlans                     = types.MsgUpdateNodePlans
+	Plan                                   = types.Plan
+	Migration                              = keeper.Migration
 	MsgDeregisterNode                      = types.MsgDeregisterNode
+	MsgNodeHeartbeat                       = types.MsgNodeHeartbeat
+	MsgTopUpNodeDeposit                    = types.MsgTopUpNodeDeposit
 	Params                                 = types.Params
 	QueryNodeParams                        = types.QueryNodeParams
 	QueryNodesOfAddressPrams               = types.QueryNodesOfAddressPrams
+	QueryNodesParams                       = types.QueryNodesParams
 	QuerySubscriptionParams                = types.QuerySubscriptionParams
 	QuerySubscriptionsOfNodePrams          = types.QuerySubscriptionsOfNodePrams
 	QuerySubscriptionsOfAddressParams      = types.QuerySubscriptionsOfAddressParams
@@ -181,11 +353,34 @@ type (
 	QuerySessionParams                     = types.QuerySessionParams
 	QuerySessionOfSubscriptionPrams        = types.QuerySessionOfSubscriptionPrams
 	QuerySessionsOfSubscriptionPrams       = types.QuerySessionsOfSubscriptionPrams
+	QuerySettlementParams                  = types.QuerySettlementParams
+	QueryChannelParams                     = types.QueryChannelParams
+	QueryChannelsOfNodeParams              = types.QueryChannelsOfNodeParams
+	QueryChannelsOfAddressParams           = types.QueryChannelsOfAddressParams
+	QueryCommissionsOfResolverParams       = types.QueryCommissionsOfResolverParams
 	Session                                = types.Session
+	SessionUpdate                          = types.SessionUpdate
+	SessionUpdateResult                    = types.SessionUpdateResult
+	Settlement                             = types.Settlement
 	MsgUpdateSessionInfo                   = types.MsgUpdateSessionInfo
+	MsgStartSession                        = types.MsgStartSession
+	MsgBatchUpdateSessions                 = types.MsgBatchUpdateSessions
 	Subscription                           = types.Subscription
 	MsgStartSubscription                   = types.MsgStartSubscription
 	MsgEndSubscription                     = types.MsgEndSubscription
 	MsgEndSession                          = types.MsgEndSession
+	MsgSubmitEvidence                      = types.MsgSubmitEvidence
+	Evidence                               = types.Evidence
+	Channel                                = types.Channel
+	MsgOpenChannel                         = types.MsgOpenChannel
+	MsgRedeemVoucher                       = types.MsgRedeemVoucher
+	MsgCloseChannel                        = types.MsgCloseChannel
+	ChangeParamsProposal                   = types.ChangeParamsProposal
+	BlacklistProposal                      = types.BlacklistProposal
+	MaxPricesPerGBProposal                 = types.MaxPricesPerGBProposal
+	ExchangeRatesProposal                  = types.ExchangeRatesProposal
+	ExchangeRate                           = types.ExchangeRate
+	ExchangeRates                          = types.ExchangeRates
 	Keeper                                 = keeper.Keeper
+	GRPCServer                             = querier.GRPCServer
 )
@@ -31,7 +31,9 @@ func AddFreeClientTxCmd(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 			
-			msg := types.NewMsgAddFreeClient(ctx.FromAddress, nodeID, address)
+			bandwidth := hub.NewBandwidthFromInt64(viper.GetInt64(flagUpload), viper.GetInt64(flagDownload))
+			msg := types.NewMsgAddFreeClient(ctx.FromAddress, nodeID, address,
+				bandwidth, viper.GetInt64(flagExpiresAt), viper.GetUint64(flagMaxSubscriptions))
 			
 			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
 		},
@@ -39,6 +41,10 @@ func AddFreeClientTxCmd(cdc *codec.Codec) *cobra.Command {
 	
 	cmd.Flags().String(flagNodeID, "", "VPN node id")
 	cmd.Flags().String(flagAddress, "", "Client address")
+	cmd.Flags().Int64(flagUpload, 0, "Upload quota in bytes, zero for no quota")
+	cmd.Flags().Int64(flagDownload, 0, "Download quota in bytes, zero for no quota")
+	cmd.Flags().Int64(flagExpiresAt, 0, "Block height at which the grant expires, zero for never")
+	cmd.Flags().Uint64(flagMaxSubscriptions, 0, "Maximum concurrent subscriptions, zero for no limit")
 	
 	_ = cmd.MarkFlagRequired(flagNodeID)
 	_ = cmd.MarkFlagRequired(flagAddress)
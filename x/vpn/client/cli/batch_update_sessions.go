@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"io/ioutil"
+	
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+	
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func BatchUpdateSessionsTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-update [updates-file]",
+		Short: "Update the signed bandwidths of the sessions in the JSON file in a single transaction",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			ctx := context.NewCLIContext().WithCodec(cdc)
+			
+			bytes, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			
+			var updates []types.SessionUpdate
+			if err := cdc.UnmarshalJSON(bytes, &updates); err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgBatchUpdateSessions(ctx.GetFromAddress(), updates)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+	
+	return cmd
+}
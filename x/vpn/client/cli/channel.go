@@ -0,0 +1,170 @@
+package cli
+
+import (
+	"fmt"
+	
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func OpenChannelTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open",
+		Short: "Open a payment channel to a node",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			ctx := context.NewCLIContext().WithCodec(cdc)
+			
+			nodeID, err := hub.NewNodeIDFromString(viper.GetString(flagNodeID))
+			if err != nil {
+				return err
+			}
+			
+			deposit, err := sdk.ParseCoin(viper.GetString(flagDeposit))
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgOpenChannel(ctx.GetFromAddress(), nodeID, deposit, viper.GetDuration(flagTimeout))
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+	
+	cmd.Flags().String(flagNodeID, "", "Node ID")
+	cmd.Flags().String(flagDeposit, "", "Deposit")
+	cmd.Flags().Duration(flagTimeout, 0, "Duration after which the client can reclaim the unredeemed deposit")
+	
+	_ = cmd.MarkFlagRequired(flagNodeID)
+	_ = cmd.MarkFlagRequired(flagDeposit)
+	_ = cmd.MarkFlagRequired(flagTimeout)
+	
+	return cmd
+}
+
+func SignVoucherTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-voucher",
+		Short: "Sign a voucher for the cumulative amount paid through the channel",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+			
+			id, err := hub.NewChannelIDFromString(viper.GetString(flagChannelID))
+			if err != nil {
+				return err
+			}
+			
+			amount, err := sdk.ParseCoin(viper.GetString(flagAmount))
+			if err != nil {
+				return err
+			}
+			
+			data := hub.NewVoucherSignatureData(id, amount).Bytes()
+			
+			passphrase, err := keys.GetPassphrase(ctx.FromName)
+			if err != nil {
+				return err
+			}
+			
+			kb, err := keys.NewKeyBaseFromHomeFlag()
+			if err != nil {
+				return err
+			}
+			
+			sigBytes, pubKey, err := kb.Sign(ctx.FromName, passphrase, data)
+			if err != nil {
+				return err
+			}
+			
+			stdSignature := auth.StdSignature{
+				PubKey:    pubKey,
+				Signature: sigBytes,
+			}
+			
+			bytes, err := cdc.MarshalJSON(stdSignature)
+			if err != nil {
+				return err
+			}
+			
+			fmt.Println(string(bytes))
+			return nil
+		},
+	}
+	
+	cmd.Flags().String(flagChannelID, "", "Channel ID")
+	cmd.Flags().String(flagAmount, "", "Cumulative amount")
+	
+	_ = cmd.MarkFlagRequired(flagChannelID)
+	_ = cmd.MarkFlagRequired(flagAmount)
+	
+	return cmd
+}
+
+func RedeemVoucherTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redeem",
+		Short: "Redeem the latest voucher of a payment channel",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			ctx := context.NewCLIContext().WithCodec(cdc)
+			
+			id, err := hub.NewChannelIDFromString(viper.GetString(flagChannelID))
+			if err != nil {
+				return err
+			}
+			
+			amount, err := sdk.ParseCoin(viper.GetString(flagAmount))
+			if err != nil {
+				return err
+			}
+			
+			var clientSignature auth.StdSignature
+			if err := cdc.UnmarshalJSON([]byte(viper.GetString(flagClientSign)), &clientSignature); err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgRedeemVoucher(ctx.GetFromAddress(), id, amount, clientSignature)
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+	
+	cmd.Flags().String(flagChannelID, "", "Channel ID")
+	cmd.Flags().String(flagAmount, "", "Cumulative amount")
+	cmd.Flags().String(flagClientSign, "", "Signature of the client")
+	
+	_ = cmd.MarkFlagRequired(flagChannelID)
+	_ = cmd.MarkFlagRequired(flagAmount)
+	_ = cmd.MarkFlagRequired(flagClientSign)
+	
+	return cmd
+}
+
+func CloseChannelTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "close",
+		Short: "Close a payment channel",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			ctx := context.NewCLIContext().WithCodec(cdc)
+			
+			id, err := hub.NewChannelIDFromString(args[0])
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgCloseChannel(ctx.GetFromAddress(), id)
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+	
+	return cmd
+}
@@ -19,11 +19,16 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		QuerySubscriptionsCmd(cdc),
 		QuerySessionCmd(cdc),
 		QuerySessionsCmd(cdc),
+		QuerySettlementCmd(cdc),
+		QueryChannelCmd(cdc),
+		QueryChannelsCmd(cdc),
 		QueryFreeClientsCmd(cdc),
 		QueryFreeNodesCmd(cdc),
 		QueryResolversOfNodeCmd(cdc),
 		QueryNodesOfResolverCmd(cdc),
+		QueryPendingNodesOfResolverCmd(cdc),
 		QueryResolversCmd(cdc),
+		QueryCommissionsOfResolverCmd(cdc),
 		QueryParams(cdc),
 	)...)
 
@@ -40,6 +45,7 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		nodeTxCmd(cdc),
 		subscriptionTxCmd(cdc),
 		sessionTxCmd(cdc),
+		channelTxCmd(cdc),
 		resolverTxCmd(cdc),
 	)
 
@@ -55,11 +61,14 @@ func nodeTxCmd(cdc *codec.Codec) *cobra.Command {
 	cmd.AddCommand(client.PostCommands(
 		RegisterNodeTxCmd(cdc),
 		UpdateNodeInfoTxCmd(cdc),
+		UpdateNodePlansTxCmd(cdc),
 		AddFreeClientTxCmd(cdc),
 		RemoveFreeClientTxCmd(cdc),
 		RegisterVPNOnResolverTxCmd(cdc),
 		RemoveVPNOnResolverTxCmd(cdc),
 		DeregisterNodeTxCmd(cdc),
+		NodeHeartbeatTxCmd(cdc),
+		TopUpNodeDepositTxCmd(cdc),
 	)...)
 
 	return cmd
@@ -86,14 +95,33 @@ func sessionTxCmd(cdc *codec.Codec) *cobra.Command {
 	}
 
 	cmd.AddCommand(client.PostCommands(
+		StartSessionTxCmd(cdc),
 		SignSessionBandwidthTxCmd(cdc),
 		UpdateSessionInfoTxCmd(cdc),
+		BatchUpdateSessionsTxCmd(cdc),
 		EndSessionTxCmd(cdc),
+		SubmitEvidenceTxCmd(cdc),
 	)...)
 
 	return cmd
 }
 
+func channelTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "channel",
+		Short: "Payment channel subcommands",
+	}
+	
+	cmd.AddCommand(client.PostCommands(
+		OpenChannelTxCmd(cdc),
+		SignVoucherTxCmd(cdc),
+		RedeemVoucherTxCmd(cdc),
+		CloseChannelTxCmd(cdc),
+	)...)
+	
+	return cmd
+}
+
 func resolverTxCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "resolver",
@@ -104,6 +132,8 @@ func resolverTxCmd(cdc *codec.Codec) *cobra.Command {
 		RegisterResolverTxCmd(cdc),
 		UpdateResolverInfoTxCmd(cdc),
 		DeregisterResolverTxCmd(cdc),
+		AcceptVPNOnResolverTxCmd(cdc),
+		RejectVPNOnResolverTxCmd(cdc),
 	)...)
 
 	return cmd
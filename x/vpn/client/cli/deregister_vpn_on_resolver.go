@@ -16,7 +16,7 @@ import (
 func RemoveVPNOnResolverTxCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "deregister-vpn-on-resolver",
-		Short: "Deregister vpn node on resolver node",
+		Short: "Revoke a listing of the vpn node on the resolver, by the node or the resolver owner",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
 			ctx := context.NewCLIContext().WithCodec(cdc)
@@ -21,7 +21,7 @@ func EndSessionTxCmd(cdc *codec.Codec) *cobra.Command {
 			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
 			ctx := context.NewCLIContext().WithCodec(cdc)
 
-			id, err := hub.NewSubscriptionIDFromString(viper.GetString(flagSubscriptionID))
+			id, err := hub.NewSessionIDFromString(viper.GetString(flagSessionID))
 			if err != nil {
 				return err
 			}
@@ -32,9 +32,9 @@ func EndSessionTxCmd(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 
-	cmd.Flags().String(flagSubscriptionID, "", "Subscription ID")
+	cmd.Flags().String(flagSessionID, "", "Session ID")
 
-	_ = cmd.MarkFlagRequired(flagSubscriptionID)
+	_ = cmd.MarkFlagRequired(flagSessionID)
 
 	return cmd
 }
@@ -1,20 +1,39 @@
 package cli
 
 const (
-	flagMoniker        = "moniker"
-	flagDeposit        = "deposit"
-	flagUpload         = "upload"
-	flagUploadSpeed    = "upload-speed"
-	flagDownload       = "download"
-	flagDownloadSpeed  = "download-speed"
-	flagEncryption     = "encryption"
-	flagPricesPerGB    = "prices-per-gb"
-	flagType           = "type"
-	flagVersion        = "version"
-	flagNodeID         = "node-id"
-	flagAddress        = "address"
-	flagClientSign     = "client-sign"
-	flagNodeOwnerSign  = "node-owner-sign"
-	flagSubscriptionID = "subscription-id"
-	flagResolverID     = "resolver-id"
+	flagMoniker                 = "moniker"
+	flagDeposit                 = "deposit"
+	flagUpload                  = "upload"
+	flagUploadSpeed             = "upload-speed"
+	flagDownload                = "download"
+	flagDownloadSpeed           = "download-speed"
+	flagEncryption              = "encryption"
+	flagPricesPerGB             = "prices-per-gb"
+	flagType                    = "type"
+	flagVersion                 = "version"
+	flagNodeID                  = "node-id"
+	flagAddress                 = "address"
+	flagClientSign              = "client-sign"
+	flagNodeOwnerSign           = "node-owner-sign"
+	flagSubscriptionID          = "subscription-id"
+	flagSessionID               = "session-id"
+	flagResolverID              = "resolver-id"
+	flagPlan                    = "plan"
+	flagPage                    = "page"
+	flagLimit                   = "limit"
+	flagStatus                  = "status"
+	flagMaxPricesPerGB          = "max-prices-per-gb"
+	flagSortBy                  = "sort-by"
+	flagChannelID               = "channel-id"
+	flagAmount                  = "amount"
+	flagTimeout                 = "timeout"
+	flagEndpoint                = "endpoint"
+	flagRegions                 = "regions"
+	flagRegion                  = "region"
+	flagMaxCommissionRate       = "max-commission-rate"
+	flagMaxCommissionChangeRate = "max-commission-change-rate"
+	flagExpiresAt               = "expires-at"
+	flagMaxSubscriptions        = "max-subscriptions"
+	flagMaxConcurrentSessions   = "max-concurrent-sessions"
+	flagCommission              = "commission"
 )
@@ -0,0 +1,145 @@
+package cli
+
+import (
+	"io/ioutil"
+	
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/spf13/cobra"
+	
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+type changeParamsProposal struct {
+	Title       string       `json:"title"`
+	Description string       `json:"description"`
+	Params      types.Params `json:"params"`
+	Deposit     sdk.Coins    `json:"deposit"`
+}
+
+type blacklistProposal struct {
+	Title       string           `json:"title"`
+	Description string           `json:"description"`
+	NodeIDs     []hub.NodeID     `json:"node_ids"`
+	ResolverIDs []hub.ResolverID `json:"resolver_ids"`
+	Blacklisted bool             `json:"blacklisted"`
+	Deposit     sdk.Coins        `json:"deposit"`
+}
+
+type maxPricesPerGBProposal struct {
+	Title          string    `json:"title"`
+	Description    string    `json:"description"`
+	MaxPricesPerGB sdk.Coins `json:"max_prices_per_gb"`
+	Deposit        sdk.Coins `json:"deposit"`
+}
+
+type exchangeRatesProposal struct {
+	Title         string              `json:"title"`
+	Description   string              `json:"description"`
+	ExchangeRates types.ExchangeRates `json:"exchange_rates"`
+	Deposit       sdk.Coins           `json:"deposit"`
+}
+
+func readProposalFile(cdc *codec.Codec, path string, proposal interface{}) error {
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	
+	return cdc.UnmarshalJSON(bytes, proposal)
+}
+
+func submitProposal(cdc *codec.Codec, content gov.Content, deposit sdk.Coins) error {
+	txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+	ctx := context.NewCLIContext().WithCodec(cdc)
+	
+	msg := gov.NewMsgSubmitProposal(content, deposit, ctx.GetFromAddress())
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	
+	return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+}
+
+func ChangeParamsProposalTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vpn-params [proposal-file]",
+		Short: "Submit a proposal to change the vpn params given in the JSON file",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var proposal changeParamsProposal
+			if err := readProposalFile(cdc, args[0], &proposal); err != nil {
+				return err
+			}
+			
+			content := types.NewChangeParamsProposal(proposal.Title, proposal.Description, proposal.Params)
+			return submitProposal(cdc, content, proposal.Deposit)
+		},
+	}
+	
+	return cmd
+}
+
+func BlacklistProposalTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vpn-blacklist [proposal-file]",
+		Short: "Submit a proposal to blacklist, or lift the blacklisting of, the nodes and resolvers given in the JSON file",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var proposal blacklistProposal
+			if err := readProposalFile(cdc, args[0], &proposal); err != nil {
+				return err
+			}
+			
+			content := types.NewBlacklistProposal(proposal.Title, proposal.Description,
+				proposal.NodeIDs, proposal.ResolverIDs, proposal.Blacklisted)
+			return submitProposal(cdc, content, proposal.Deposit)
+		},
+	}
+	
+	return cmd
+}
+
+func MaxPricesPerGBProposalTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vpn-max-prices-per-gb [proposal-file]",
+		Short: "Submit a proposal to set the maximum prices per GB given in the JSON file",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var proposal maxPricesPerGBProposal
+			if err := readProposalFile(cdc, args[0], &proposal); err != nil {
+				return err
+			}
+			
+			content := types.NewMaxPricesPerGBProposal(proposal.Title, proposal.Description, proposal.MaxPricesPerGB)
+			return submitProposal(cdc, content, proposal.Deposit)
+		},
+	}
+	
+	return cmd
+}
+
+func ExchangeRatesProposalTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vpn-exchange-rates [proposal-file]",
+		Short: "Submit a proposal to set the exchange rates given in the JSON file",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var proposal exchangeRatesProposal
+			if err := readProposalFile(cdc, args[0], &proposal); err != nil {
+				return err
+			}
+			
+			content := types.NewExchangeRatesProposal(proposal.Title, proposal.Description,
+				proposal.ExchangeRates.Sort())
+			return submitProposal(cdc, content, proposal.Deposit)
+		},
+	}
+	
+	return cmd
+}
@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"fmt"
+	
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	
+	"github.com/sentinel-official/hub/x/vpn/client/common"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func QueryChannelCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "channel",
+		Short: "Query payment channel",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+			
+			channel, err := common.QueryChannel(ctx, args[0])
+			if err != nil {
+				return err
+			}
+			
+			fmt.Println(channel)
+			return nil
+		},
+	}
+	
+	return cmd
+}
+
+func QueryChannelsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "channels",
+		Short: "Query payment channels of a node or an address",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+			
+			id := viper.GetString(flagNodeID)
+			address := viper.GetString(flagAddress)
+			
+			var channels []types.Channel
+			if id != "" {
+				channels, err = common.QueryChannelsOfNode(ctx, id)
+			} else if address != "" {
+				channels, err = common.QueryChannelsOfAddress(ctx, address)
+			} else {
+				return fmt.Errorf("either %s or %s is required", flagNodeID, flagAddress)
+			}
+			
+			if err != nil {
+				return err
+			}
+			
+			for _, channel := range channels {
+				fmt.Println(channel)
+			}
+			
+			return nil
+		},
+	}
+	
+	cmd.Flags().String(flagNodeID, "", "Node ID")
+	cmd.Flags().String(flagAddress, "", "Account address")
+	
+	return cmd
+}
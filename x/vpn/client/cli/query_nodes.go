@@ -5,9 +5,11 @@ import (
 	
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	
+	hub "github.com/sentinel-official/hub/types"
 	"github.com/sentinel-official/hub/x/vpn/client/common"
 	"github.com/sentinel-official/hub/x/vpn/types"
 )
@@ -46,7 +48,13 @@ func QueryNodesCmd(cdc *codec.Codec) *cobra.Command {
 			if address != "" {
 				nodes, err = common.QueryNodesOfAddress(ctx, address)
 			} else {
-				nodes, err = common.QueryAllNodes(ctx)
+				var params types.QueryNodesParams
+				params, err = queryNodesParams()
+				if err != nil {
+					return err
+				}
+				
+				nodes, err = common.QueryNodes(ctx, params)
 			}
 			
 			if err != nil {
@@ -62,6 +70,42 @@ func QueryNodesCmd(cdc *codec.Codec) *cobra.Command {
 	}
 	
 	cmd.Flags().String(flagAddress, "", "Account address")
+	cmd.Flags().Int(flagPage, 1, "Page number")
+	cmd.Flags().Int(flagLimit, 100, "Number of nodes per page")
+	cmd.Flags().String(flagStatus, "", "Node status")
+	cmd.Flags().String(flagType, "", "Node type")
+	cmd.Flags().String(flagEncryption, "", "Encryption method")
+	cmd.Flags().String(flagVersion, "", "Node version")
+	cmd.Flags().Int64(flagUploadSpeed, 0, "Minimum internet upload speed in bytes/sec")
+	cmd.Flags().Int64(flagDownloadSpeed, 0, "Minimum internet download speed in bytes/sec")
+	cmd.Flags().String(flagMaxPricesPerGB, "", "Maximum prices per GB")
+	cmd.Flags().String(flagResolverID, "", "Resolver ID")
+	cmd.Flags().String(flagSortBy, "", "Sort nodes by price or speed")
 	
 	return cmd
 }
+
+func queryNodesParams() (params types.QueryNodesParams, err error) {
+	maxPricesPerGB, err := sdk.ParseCoins(viper.GetString(flagMaxPricesPerGB))
+	if err != nil {
+		return params, err
+	}
+	
+	var resolverID hub.ResolverID
+	if s := viper.GetString(flagResolverID); s != "" {
+		resolverID, err = hub.NewResolverIDFromString(s)
+		if err != nil {
+			return params, err
+		}
+	}
+	
+	minInternetSpeed := hub.Bandwidth{
+		Upload:   sdk.NewInt(viper.GetInt64(flagUploadSpeed)),
+		Download: sdk.NewInt(viper.GetInt64(flagDownloadSpeed)),
+	}
+	
+	return types.NewQueryNodesParams(viper.GetInt(flagPage), viper.GetInt(flagLimit),
+		viper.GetString(flagStatus), viper.GetString(flagType), viper.GetString(flagEncryption),
+		viper.GetString(flagVersion), minInternetSpeed, maxPricesPerGB, resolverID,
+		viper.GetString(flagSortBy)), nil
+}
@@ -24,6 +24,10 @@ func QueryResolversCmd(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 			
+			if region := viper.GetString(flagRegion); region != "" {
+				resolvers = resolvers.WithRegion(region)
+			}
+			
 			if len(resolvers) == 0 {
 				return nil
 			}
@@ -35,6 +39,28 @@ func QueryResolversCmd(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 	cmd.Flags().String(flagResolverID, "", "Resolver address")
+	cmd.Flags().String(flagRegion, "", "Region served by the resolvers")
+	
+	return cmd
+}
+
+func QueryCommissionsOfResolverCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolver-commissions [resolver-id]",
+		Short: "Query the commission history of a resolver",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+			
+			commissions, err := common.QueryCommissionsOfResolver(ctx, args[0])
+			if err != nil {
+				return err
+			}
+			
+			fmt.Println(commissions)
+			return nil
+		},
+	}
 	
 	return cmd
 }
@@ -33,6 +33,27 @@ func QuerySessionCmd(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+func QuerySettlementCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settlement",
+		Short: "Query settlement of a session",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+			
+			settlement, err := common.QuerySettlement(ctx, args[0])
+			if err != nil {
+				return err
+			}
+			
+			fmt.Println(settlement)
+			return nil
+		},
+	}
+	
+	return cmd
+}
+
 func QuerySessionsCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "sessions",
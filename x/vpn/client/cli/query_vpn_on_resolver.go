@@ -61,3 +61,26 @@ func QueryNodesOfResolverCmd(cdc *codec.Codec) *cobra.Command {
 	
 	return cmd
 }
+
+func QueryPendingNodesOfResolverCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-nodes-of-resolver [resolver-id]",
+		Short: "Query the nodes which requested a listing on the resolver",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+			
+			nodes, err := common.QueryPendingNodesOfResolver(ctx, args[0])
+			if err != nil {
+				return err
+			}
+			
+			for _, node := range nodes {
+				fmt.Println(node)
+			}
+			return nil
+		},
+	}
+	
+	return cmd
+}
@@ -16,7 +16,7 @@ import (
 func RegisterVPNOnResolverTxCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "register-vpn-on-resolver",
-		Short: "Register vpn node on resolver node",
+		Short: "Request a listing of the vpn node on the resolver",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
 			ctx := context.NewCLIContext().WithCodec(cdc)
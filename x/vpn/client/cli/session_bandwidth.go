@@ -24,23 +24,17 @@ func SignSessionBandwidthTxCmd(cdc *codec.Codec) *cobra.Command {
 		Short: "Sign session bandwidth",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.NewCLIContext().WithCodec(cdc)
-			_id := viper.GetString(flagSubscriptionID)
 			bandwidth := hub.Bandwidth{
 				Upload:   sdk.NewInt(viper.GetInt64(flagUpload)),
 				Download: sdk.NewInt(viper.GetInt64(flagDownload)),
 			}
 			
-			scs, err := common.QuerySessionsCountOfSubscription(ctx, _id)
+			session, err := common.QuerySession(ctx, viper.GetString(flagSessionID))
 			if err != nil {
 				return err
 			}
 			
-			id, err := hub.NewSubscriptionIDFromString(_id)
-			if err != nil {
-				return err
-			}
-			
-			data := hub.NewBandwidthSignatureData(id, scs, bandwidth).Bytes()
+			data := hub.NewBandwidthSignatureData(session.SubscriptionID, session.ID, bandwidth).Bytes()
 			
 			passphrase, err := keys.GetPassphrase(ctx.FromName)
 			if err != nil {
@@ -72,11 +66,11 @@ func SignSessionBandwidthTxCmd(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 	
-	cmd.Flags().String(flagSubscriptionID, "", "Subscription ID")
+	cmd.Flags().String(flagSessionID, "", "Session ID")
 	cmd.Flags().Int64(flagUpload, 0, "Upload in in bytes")
 	cmd.Flags().Int64(flagDownload, 0, "Download in bytes")
 	
-	_ = cmd.MarkFlagRequired(flagSubscriptionID)
+	_ = cmd.MarkFlagRequired(flagSessionID)
 	_ = cmd.MarkFlagRequired(flagUpload)
 	_ = cmd.MarkFlagRequired(flagDownload)
 	
@@ -91,7 +85,7 @@ func UpdateSessionInfoTxCmd(cdc *codec.Codec) *cobra.Command {
 			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
 			ctx := context.NewCLIContext().WithCodec(cdc)
 			
-			id, err := hub.NewSubscriptionIDFromString(viper.GetString(flagSubscriptionID))
+			id, err := hub.NewSessionIDFromString(viper.GetString(flagSessionID))
 			if err != nil {
 				return err
 			}
@@ -118,13 +112,13 @@ func UpdateSessionInfoTxCmd(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 	
-	cmd.Flags().String(flagSubscriptionID, "", "Subscription ID")
+	cmd.Flags().String(flagSessionID, "", "Session ID")
 	cmd.Flags().Int64(flagUpload, 0, "Upload in in bytes")
 	cmd.Flags().Int64(flagDownload, 0, "Download in bytes")
 	cmd.Flags().String(flagNodeOwnerSign, "", "Signature of the node owner")
 	cmd.Flags().String(flagClientSign, "", "Signature of the client")
 	
-	_ = cmd.MarkFlagRequired(flagSubscriptionID)
+	_ = cmd.MarkFlagRequired(flagSessionID)
 	_ = cmd.MarkFlagRequired(flagUpload)
 	_ = cmd.MarkFlagRequired(flagDownload)
 	_ = cmd.MarkFlagRequired(flagNodeOwnerSign)
@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func StartSessionTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "start",
+		Short: "Start a session of the subscription",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			ctx := context.NewCLIContext().WithCodec(cdc)
+			
+			id, err := hub.NewSubscriptionIDFromString(viper.GetString(flagSubscriptionID))
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgStartSession(ctx.FromAddress, id)
+			
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+	
+	cmd.Flags().String(flagSubscriptionID, "", "Subscription ID")
+	
+	_ = cmd.MarkFlagRequired(flagSubscriptionID)
+	
+	return cmd
+}
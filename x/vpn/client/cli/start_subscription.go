@@ -33,21 +33,24 @@ func StartSubscriptionTxCmd(cdc *codec.Codec) *cobra.Command {
 			
 			deposit := viper.GetString(flagDeposit)
 			
-			parsedDeposit, err := sdk.ParseCoin(deposit)
+			parsedDeposit, err := sdk.ParseCoins(deposit)
 			if err != nil {
 				return err
 			}
 			
 			fromAddress := ctx.GetFromAddress()
 			
-			msg := types.NewMsgStartSubscription(fromAddress, resolver, nodeID, parsedDeposit)
+			plan := viper.GetUint64(flagPlan)
+			
+			msg := types.NewMsgStartSubscription(fromAddress, resolver, nodeID, parsedDeposit, plan)
 			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
 		},
 	}
 	
 	cmd.Flags().String(flagResolverID, "", "Resolver")
 	cmd.Flags().String(flagNodeID, "", "Node ID")
-	cmd.Flags().String(flagDeposit, "", "Deposit")
+	cmd.Flags().String(flagDeposit, "", "Deposit, in one or more denominations")
+	cmd.Flags().Uint64(flagPlan, 0, "Position of the node plan, 0 for the per-GB pricing")
 	
 	_ = cmd.MarkFlagRequired(flagResolverID)
 	_ = cmd.MarkFlagRequired(flagNodeID)
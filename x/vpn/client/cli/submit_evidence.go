@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"io/ioutil"
+	
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+	
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func SubmitEvidenceTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-evidence [evidence-file]",
+		Short: "Submit the conflicting bandwidths signed by the node owner in the JSON file",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			ctx := context.NewCLIContext().WithCodec(cdc)
+			
+			bytes, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			
+			var evidence types.Evidence
+			if err := cdc.UnmarshalJSON(bytes, &evidence); err != nil {
+				return err
+			}
+			
+			fromAddress := ctx.GetFromAddress()
+			
+			msg := types.NewMsgSubmitEvidence(fromAddress, evidence)
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+	
+	return cmd
+}
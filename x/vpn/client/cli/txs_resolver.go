@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 	
 	hub "github.com/sentinel-official/hub/types"
 	"github.com/sentinel-official/hub/x/vpn/types"
@@ -23,6 +24,11 @@ func RegisterResolverTxCmd(cdc *codec.Codec) *cobra.Command {
 			ctx := context.NewCLIContext().WithCodec(cdc)
 			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
 			
+			deposit, err := sdk.ParseCoin(viper.GetString(flagDeposit))
+			if err != nil {
+				return err
+			}
+			
 			commission, err := sdk.NewDecFromStr(args[0])
 			if err != nil {
 				return err
@@ -32,7 +38,19 @@ func RegisterResolverTxCmd(cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("commission rate %s : between 0 and 1 ", commission.String())
 			}
 			
-			msg := types.NewMsgRegisterResolver(ctx.GetFromAddress(), commission)
+			maxRate, err := sdk.NewDecFromStr(viper.GetString(flagMaxCommissionRate))
+			if err != nil {
+				return err
+			}
+			
+			maxChangeRate, err := sdk.NewDecFromStr(viper.GetString(flagMaxCommissionChangeRate))
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgRegisterResolver(ctx.GetFromAddress(), viper.GetString(flagMoniker),
+				viper.GetString(flagEndpoint), viper.GetStringSlice(flagRegions), deposit,
+				commission, maxRate, maxChangeRate)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -40,14 +58,29 @@ func RegisterResolverTxCmd(cdc *codec.Codec) *cobra.Command {
 			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
 		},
 	}
+	
+	cmd.Flags().String(flagMoniker, "", "Moniker")
+	cmd.Flags().String(flagEndpoint, "", "Service endpoint URL")
+	cmd.Flags().StringSlice(flagRegions, nil, "Supported regions")
+	cmd.Flags().String(flagDeposit, "", "Self-stake, at least the minimum resolver deposit")
+	cmd.Flags().String(flagMaxCommissionRate, "", "Maximum commission rate, can not be changed later")
+	cmd.Flags().String(flagMaxCommissionChangeRate, "", "Maximum change of the commission rate at once, can not be changed later")
+	
+	_ = cmd.MarkFlagRequired(flagMoniker)
+	_ = cmd.MarkFlagRequired(flagEndpoint)
+	_ = cmd.MarkFlagRequired(flagRegions)
+	_ = cmd.MarkFlagRequired(flagDeposit)
+	_ = cmd.MarkFlagRequired(flagMaxCommissionRate)
+	_ = cmd.MarkFlagRequired(flagMaxCommissionChangeRate)
+	
 	return cmd
 }
 
 func UpdateResolverInfoTxCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "update [resolver-id] [commission-rate]",
+		Use:   "update [resolver-id]",
 		Short: "Update the info of Resolver node",
-		Args:  cobra.ExactArgs(2),
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.NewCLIContext().WithCodec(cdc)
 			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
@@ -56,16 +89,22 @@ func UpdateResolverInfoTxCmd(cdc *codec.Codec) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			commission, err := sdk.NewDecFromStr(args[1])
-			if err != nil {
-				return err
-			}
 			
-			if commission.LT(sdk.ZeroDec()) || commission.GT(sdk.OneDec()) {
-				return fmt.Errorf("commission rate %s : between 0 and 1 ", commission.String())
+			var commission *sdk.Dec
+			if s := viper.GetString(flagCommission); s != "" {
+				rate, err := sdk.NewDecFromStr(s)
+				if err != nil {
+					return err
+				}
+				if rate.LT(sdk.ZeroDec()) || rate.GT(sdk.OneDec()) {
+					return fmt.Errorf("commission rate %s : between 0 and 1 ", rate.String())
+				}
+				
+				commission = &rate
 			}
 			
-			msg := types.NewMsgUpdateResolverInfo(ctx.GetFromAddress(), resolverID, commission)
+			msg := types.NewMsgUpdateResolverInfo(ctx.GetFromAddress(), resolverID, viper.GetString(flagMoniker),
+				viper.GetString(flagEndpoint), viper.GetStringSlice(flagRegions), commission)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -73,6 +112,12 @@ func UpdateResolverInfoTxCmd(cdc *codec.Codec) *cobra.Command {
 			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
 		},
 	}
+	
+	cmd.Flags().String(flagMoniker, "", "Moniker")
+	cmd.Flags().String(flagEndpoint, "", "Service endpoint URL")
+	cmd.Flags().StringSlice(flagRegions, nil, "Supported regions")
+	cmd.Flags().String(flagCommission, "", "Commission rate, left unchanged if not given")
+	
 	return cmd
 }
 
@@ -98,3 +143,65 @@ func DeregisterResolverTxCmd(cdc *codec.Codec) *cobra.Command {
 	}
 	return cmd
 }
+
+func AcceptVPNOnResolverTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-node [resolver-id] [node-id]",
+		Short: "Accept a listing request of the node on the resolver",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			
+			resolverID, err := hub.NewResolverIDFromString(args[0])
+			if err != nil {
+				return err
+			}
+			
+			nodeID, err := hub.NewNodeIDFromString(args[1])
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgAcceptVPNOnResolver(ctx.GetFromAddress(), resolverID, nodeID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+	
+	return cmd
+}
+
+func RejectVPNOnResolverTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reject-node [resolver-id] [node-id]",
+		Short: "Reject a listing request of the node on the resolver",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			
+			resolverID, err := hub.NewResolverIDFromString(args[0])
+			if err != nil {
+				return err
+			}
+			
+			nodeID, err := hub.NewNodeIDFromString(args[1])
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgRejectVPNOnResolver(ctx.GetFromAddress(), resolverID, nodeID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+	
+	return cmd
+}
@@ -43,8 +43,8 @@ func UpdateNodeInfoTxCmd(cdc *codec.Codec) *cobra.Command {
 			
 			fromAddress := ctx.GetFromAddress()
 			
-			msg := types.NewMsgUpdateNodeInfo(fromAddress, nodeID,
-				_type, version, moniker, parsedPricesPerGB, internetSpeed, encryption)
+			msg := types.NewMsgUpdateNodeInfo(fromAddress, nodeID, _type, version, moniker,
+				parsedPricesPerGB, internetSpeed, encryption, viper.GetUint64(flagMaxConcurrentSessions))
 			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
 		},
 	}
@@ -57,6 +57,7 @@ func UpdateNodeInfoTxCmd(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().Int64(flagUploadSpeed, 0, "Internet upload speed in bytes/sec")
 	cmd.Flags().Int64(flagDownloadSpeed, 0, "Internet download speed in bytes/sec")
 	cmd.Flags().String(flagEncryption, "", "VPN encryption method")
+	cmd.Flags().Uint64(flagMaxConcurrentSessions, 0, "Maximum concurrent sessions of a per-GB subscription")
 	
 	_ = cmd.MarkFlagRequired(flagNodeID)
 	
@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"io/ioutil"
+	
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func UpdateNodePlansTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-plans [plans-file]",
+		Short: "Replace the plans of the node with the plans in the JSON file",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			ctx := context.NewCLIContext().WithCodec(cdc)
+			
+			nodeID, err := hub.NewNodeIDFromString(viper.GetString(flagNodeID))
+			if err != nil {
+				return err
+			}
+			
+			bytes, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			
+			var plans []types.Plan
+			if err := cdc.UnmarshalJSON(bytes, &plans); err != nil {
+				return err
+			}
+			
+			fromAddress := ctx.GetFromAddress()
+			
+			msg := types.NewMsgUpdateNodePlans(fromAddress, nodeID, plans)
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+	
+	cmd.Flags().String(flagNodeID, "", "Node ID")
+	
+	_ = cmd.MarkFlagRequired(flagNodeID)
+	
+	return cmd
+}
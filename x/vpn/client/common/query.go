@@ -69,6 +69,26 @@ func QueryNodesOfAddress(ctx context.CLIContext, s string) ([]types.Node, error)
 	return nodes, nil
 }
 
+func QueryNodes(ctx context.CLIContext, params types.QueryNodesParams) ([]types.Node, error) {
+	bytes, err := ctx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+	
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNodes)
+	res, _, err := ctx.QueryWithData(path, bytes)
+	if err != nil {
+		return nil, err
+	}
+	
+	var nodes []types.Node
+	if err := ctx.Codec.UnmarshalJSON(res, &nodes); err != nil {
+		return nil, err
+	}
+	
+	return nodes, nil
+}
+
 func QueryAllNodes(ctx context.CLIContext) ([]types.Node, error) {
 	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllNodes)
 	res, _, err := ctx.QueryWithData(path, nil)
@@ -177,6 +197,36 @@ func QueryNodesOfResolver(ctx context.CLIContext, s string) ([]hub.NodeID, error
 	return freeNodes, nil
 }
 
+func QueryPendingNodesOfResolver(ctx context.CLIContext, s string) ([]hub.NodeID, error) {
+	id, err := hub.NewResolverIDFromString(s)
+	if err != nil {
+		return nil, err
+	}
+	
+	params := types.NewQueryNodesOfResolverPrams(id)
+	
+	bytes, err := ctx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+	
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPendingNodesOfResolver)
+	res, _, err := ctx.QueryWithData(path, bytes)
+	if err != nil {
+		return nil, err
+	}
+	if string(res) == "[]" || string(res) == "null" {
+		return nil, fmt.Errorf("no pending nodes found")
+	}
+	
+	var nodes []hub.NodeID
+	if err := ctx.Codec.UnmarshalJSON(res, &nodes); err != nil {
+		return nil, err
+	}
+	
+	return nodes, nil
+}
+
 func QueryResolversOfNode(ctx context.CLIContext, id string) ([]sdk.AccAddress, error) {
 	nodeID, err := hub.NewNodeIDFromString(id)
 	if err != nil {
@@ -372,6 +422,36 @@ func QuerySession(ctx context.CLIContext, s string) (*types.Session, error) {
 	return &session, nil
 }
 
+func QuerySettlement(ctx context.CLIContext, s string) (*types.Settlement, error) {
+	id, err := hub.NewSessionIDFromString(s)
+	if err != nil {
+		return nil, err
+	}
+	
+	params := types.NewQuerySettlementParams(id)
+	
+	bytes, err := ctx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+	
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySettlement)
+	res, _, err := ctx.QueryWithData(path, bytes)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, fmt.Errorf("no settlement found")
+	}
+	
+	var settlement types.Settlement
+	if err := ctx.Codec.UnmarshalJSON(res, &settlement); err != nil {
+		return nil, err
+	}
+	
+	return &settlement, nil
+}
+
 func QuerySessionOfSubscription(ctx context.CLIContext, s string, index uint64) (*types.Session, error) {
 	id, err := hub.NewSubscriptionIDFromString(s)
 	if err != nil {
@@ -447,3 +527,93 @@ func QueryAllSessions(ctx context.CLIContext) ([]types.Session, error) {
 	
 	return sessions, nil
 }
+
+func QueryChannel(ctx context.CLIContext, s string) (*types.Channel, error) {
+	id, err := hub.NewChannelIDFromString(s)
+	if err != nil {
+		return nil, err
+	}
+	
+	params := types.NewQueryChannelParams(id)
+	
+	bytes, err := ctx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+	
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryChannel)
+	res, _, err := ctx.QueryWithData(path, bytes)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, fmt.Errorf("no channel found")
+	}
+	
+	var channel types.Channel
+	if err := ctx.Codec.UnmarshalJSON(res, &channel); err != nil {
+		return nil, err
+	}
+	
+	return &channel, nil
+}
+
+func QueryChannelsOfNode(ctx context.CLIContext, s string) ([]types.Channel, error) {
+	id, err := hub.NewNodeIDFromString(s)
+	if err != nil {
+		return nil, err
+	}
+	
+	params := types.NewQueryChannelsOfNodeParams(id)
+	
+	bytes, err := ctx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+	
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryChannelsOfNode)
+	res, _, err := ctx.QueryWithData(path, bytes)
+	if err != nil {
+		return nil, err
+	}
+	if string(res) == "[]" || string(res) == "null" {
+		return nil, fmt.Errorf("no channels found")
+	}
+	
+	var channels []types.Channel
+	if err := ctx.Codec.UnmarshalJSON(res, &channels); err != nil {
+		return nil, err
+	}
+	
+	return channels, nil
+}
+
+func QueryChannelsOfAddress(ctx context.CLIContext, s string) ([]types.Channel, error) {
+	address, err := sdk.AccAddressFromBech32(s)
+	if err != nil {
+		return nil, err
+	}
+	
+	params := types.NewQueryChannelsOfAddressParams(address)
+	
+	bytes, err := ctx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+	
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryChannelsOfAddress)
+	res, _, err := ctx.QueryWithData(path, bytes)
+	if err != nil {
+		return nil, err
+	}
+	if string(res) == "[]" || string(res) == "null" {
+		return nil, fmt.Errorf("no channels found")
+	}
+	
+	var channels []types.Channel
+	if err := ctx.Codec.UnmarshalJSON(res, &channels); err != nil {
+		return nil, err
+	}
+	
+	return channels, nil
+}
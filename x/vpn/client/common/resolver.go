@@ -45,3 +45,33 @@ func QueryResolvers(ctx context.CLIContext, resolverID string) (types.Resolvers,
 	
 	return resolvers, nil
 }
+
+func QueryCommissionsOfResolver(ctx context.CLIContext, s string) (types.ResolverCommissions, error) {
+	id, err := hub.NewResolverIDFromString(s)
+	if err != nil {
+		return nil, err
+	}
+	
+	params := types.NewQueryCommissionsOfResolverParams(id)
+	
+	bytes, err := ctx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+	
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCommissionsOfResolver)
+	res, _, err := ctx.QueryWithData(path, bytes)
+	if err != nil {
+		return nil, err
+	}
+	if string(res) == "[]" || string(res) == "null" {
+		return nil, fmt.Errorf("no commissions found")
+	}
+	
+	var commissions types.ResolverCommissions
+	if err := ctx.Codec.UnmarshalJSON(res, &commissions); err != nil {
+		return nil, err
+	}
+	
+	return commissions, nil
+}
@@ -0,0 +1,15 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	
+	"github.com/sentinel-official/hub/x/vpn/client/cli"
+	"github.com/sentinel-official/hub/x/vpn/client/rest"
+)
+
+var (
+	ChangeParamsProposalHandler   = govclient.NewProposalHandler(cli.ChangeParamsProposalTxCmd, rest.ChangeParamsProposalRESTHandler)
+	BlacklistProposalHandler      = govclient.NewProposalHandler(cli.BlacklistProposalTxCmd, rest.BlacklistProposalRESTHandler)
+	MaxPricesPerGBProposalHandler = govclient.NewProposalHandler(cli.MaxPricesPerGBProposalTxCmd, rest.MaxPricesPerGBProposalRESTHandler)
+	ExchangeRatesProposalHandler  = govclient.NewProposalHandler(cli.ExchangeRatesProposalTxCmd, rest.ExchangeRatesProposalRESTHandler)
+)
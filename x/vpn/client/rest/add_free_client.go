@@ -13,9 +13,12 @@ import (
 )
 
 type msgAddFreeClient struct {
-	BaseReq rest.BaseReq `json:"base_req"`
-	NodeID  string       `json:"node_id"`
-	Address string       `json:"address"`
+	BaseReq          rest.BaseReq  `json:"base_req"`
+	NodeID           string        `json:"node_id"`
+	Address          string        `json:"address"`
+	Bandwidth        hub.Bandwidth `json:"bandwidth"`
+	ExpiresAt        int64         `json:"expires_at"`
+	MaxSubscriptions uint64        `json:"max_subscriptions"`
 }
 
 func addFreeClientHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
@@ -49,7 +52,12 @@ func addFreeClientHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 			return
 		}
 		
-		msg := types.NewMsgAddFreeClient(fromAddress, nodeID, client)
+		if req.Bandwidth.AnyNil() {
+			req.Bandwidth = hub.NewBandwidthFromInt64(0, 0)
+		}
+		
+		msg := types.NewMsgAddFreeClient(fromAddress, nodeID, client,
+			req.Bandwidth, req.ExpiresAt, req.MaxSubscriptions)
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
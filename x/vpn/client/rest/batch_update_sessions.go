@@ -0,0 +1,46 @@
+package rest
+
+import (
+	"net/http"
+	
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+type msgBatchUpdateSessions struct {
+	BaseReq rest.BaseReq          `json:"base_req"`
+	Updates []types.SessionUpdate `json:"updates"`
+}
+
+func batchUpdateSessionsHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req msgBatchUpdateSessions
+		
+		if !rest.ReadRESTReq(w, r, ctx.Codec, &req) {
+			return
+		}
+		
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		
+		msg := types.NewMsgBatchUpdateSessions(fromAddress, req.Updates)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		
+		utils.WriteGenerateStdTxResponse(w, ctx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
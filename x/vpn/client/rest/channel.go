@@ -0,0 +1,158 @@
+package rest
+
+import (
+	"net/http"
+	"time"
+	
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/gorilla/mux"
+	
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+type msgOpenChannel struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Deposit string       `json:"deposit"`
+	Timeout string       `json:"timeout"`
+}
+
+func openChannelHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req msgOpenChannel
+		
+		if !rest.ReadRESTReq(w, r, ctx.Codec, &req) {
+			return
+		}
+		
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		
+		vars := mux.Vars(r)
+		id, err := hub.NewNodeIDFromString(vars["id"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		
+		deposit, err := sdk.ParseCoin(req.Deposit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		
+		timeout, err := time.ParseDuration(req.Timeout)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		
+		msg := types.NewMsgOpenChannel(fromAddress, id, deposit, timeout)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		
+		utils.WriteGenerateStdTxResponse(w, ctx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+type msgRedeemVoucher struct {
+	BaseReq         rest.BaseReq      `json:"base_req"`
+	Amount          string            `json:"amount"`
+	ClientSignature auth.StdSignature `json:"client_signature"`
+}
+
+func redeemVoucherHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req msgRedeemVoucher
+		
+		if !rest.ReadRESTReq(w, r, ctx.Codec, &req) {
+			return
+		}
+		
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		
+		vars := mux.Vars(r)
+		id, err := hub.NewChannelIDFromString(vars["id"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		
+		amount, err := sdk.ParseCoin(req.Amount)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		
+		msg := types.NewMsgRedeemVoucher(fromAddress, id, amount, req.ClientSignature)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		
+		utils.WriteGenerateStdTxResponse(w, ctx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+type msgCloseChannel struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+}
+
+func closeChannelHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req msgCloseChannel
+		
+		if !rest.ReadRESTReq(w, r, ctx.Codec, &req) {
+			return
+		}
+		
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		
+		vars := mux.Vars(r)
+		id, err := hub.NewChannelIDFromString(vars["id"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		
+		msg := types.NewMsgCloseChannel(fromAddress, id)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		
+		utils.WriteGenerateStdTxResponse(w, ctx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
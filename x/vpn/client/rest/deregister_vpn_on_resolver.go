@@ -15,7 +15,6 @@ import (
 
 type msgRemoveVPNOnResolver struct {
 	BaseReq rest.BaseReq `json:"base_req"`
-	NodeID  string       `json:"node_id"`
 }
 
 func deregisterVPNOnResolverHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
@@ -37,14 +36,14 @@ func deregisterVPNOnResolverHandlerFunc(ctx context.CLIContext) http.HandlerFunc
 			return
 		}
 		
-		nodeID, err := hub.NewNodeIDFromString(req.NodeID)
+		vars := mux.Vars(r)
+		nodeID, err := hub.NewNodeIDFromString(vars["id"])
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 		
-		vars := mux.Vars(r)
-		resolver, err := hub.NewResolverIDFromString(vars["id"])
+		resolver, err := hub.NewResolverIDFromString(vars["resolver_id"])
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -37,7 +37,7 @@ func endSessionHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		id, err := hub.NewSubscriptionIDFromString(vars["id"])
+		id, err := hub.NewSessionIDFromString(vars["id"])
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
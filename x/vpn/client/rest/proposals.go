@@ -0,0 +1,132 @@
+package rest
+
+import (
+	"net/http"
+	
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+type changeParamsProposalReq struct {
+	BaseReq     rest.BaseReq `json:"base_req"`
+	Title       string       `json:"title"`
+	Description string       `json:"description"`
+	Params      types.Params `json:"params"`
+	Deposit     sdk.Coins    `json:"deposit"`
+}
+
+type blacklistProposalReq struct {
+	BaseReq     rest.BaseReq     `json:"base_req"`
+	Title       string           `json:"title"`
+	Description string           `json:"description"`
+	NodeIDs     []hub.NodeID     `json:"node_ids"`
+	ResolverIDs []hub.ResolverID `json:"resolver_ids"`
+	Blacklisted bool             `json:"blacklisted"`
+	Deposit     sdk.Coins        `json:"deposit"`
+}
+
+type maxPricesPerGBProposalReq struct {
+	BaseReq        rest.BaseReq `json:"base_req"`
+	Title          string       `json:"title"`
+	Description    string       `json:"description"`
+	MaxPricesPerGB sdk.Coins    `json:"max_prices_per_gb"`
+	Deposit        sdk.Coins    `json:"deposit"`
+}
+
+type exchangeRatesProposalReq struct {
+	BaseReq       rest.BaseReq        `json:"base_req"`
+	Title         string              `json:"title"`
+	Description   string              `json:"description"`
+	ExchangeRates types.ExchangeRates `json:"exchange_rates"`
+	Deposit       sdk.Coins           `json:"deposit"`
+}
+
+func ChangeParamsProposalRESTHandler(ctx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "vpn_params",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req changeParamsProposalReq
+			if !rest.ReadRESTReq(w, r, ctx.Codec, &req) {
+				return
+			}
+			
+			content := types.NewChangeParamsProposal(req.Title, req.Description, req.Params)
+			writeProposal(w, ctx, req.BaseReq, content, req.Deposit)
+		},
+	}
+}
+
+func BlacklistProposalRESTHandler(ctx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "vpn_blacklist",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req blacklistProposalReq
+			if !rest.ReadRESTReq(w, r, ctx.Codec, &req) {
+				return
+			}
+			
+			content := types.NewBlacklistProposal(req.Title, req.Description,
+				req.NodeIDs, req.ResolverIDs, req.Blacklisted)
+			writeProposal(w, ctx, req.BaseReq, content, req.Deposit)
+		},
+	}
+}
+
+func MaxPricesPerGBProposalRESTHandler(ctx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "vpn_max_prices_per_gb",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req maxPricesPerGBProposalReq
+			if !rest.ReadRESTReq(w, r, ctx.Codec, &req) {
+				return
+			}
+			
+			content := types.NewMaxPricesPerGBProposal(req.Title, req.Description, req.MaxPricesPerGB)
+			writeProposal(w, ctx, req.BaseReq, content, req.Deposit)
+		},
+	}
+}
+
+func ExchangeRatesProposalRESTHandler(ctx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "vpn_exchange_rates",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req exchangeRatesProposalReq
+			if !rest.ReadRESTReq(w, r, ctx.Codec, &req) {
+				return
+			}
+			
+			content := types.NewExchangeRatesProposal(req.Title, req.Description, req.ExchangeRates.Sort())
+			writeProposal(w, ctx, req.BaseReq, content, req.Deposit)
+		},
+	}
+}
+
+func writeProposal(w http.ResponseWriter, ctx context.CLIContext,
+	baseReq rest.BaseReq, content gov.Content, deposit sdk.Coins) {
+	baseReq = baseReq.Sanitize()
+	if !baseReq.ValidateBasic(w) {
+		return
+	}
+	
+	fromAddress, err := sdk.AccAddressFromBech32(baseReq.From)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	
+	msg := gov.NewMsgSubmitProposal(content, deposit, fromAddress)
+	if err := msg.ValidateBasic(); err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	
+	utils.WriteGenerateStdTxResponse(w, ctx, baseReq, []sdk.Msg{msg})
+}
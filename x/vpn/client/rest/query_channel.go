@@ -0,0 +1,53 @@
+package rest
+
+import (
+	"net/http"
+	
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+	
+	"github.com/sentinel-official/hub/x/vpn/client/common"
+)
+
+func getChannelHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		
+		channel, err := common.QueryChannel(ctx, vars["id"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		
+		rest.PostProcessResponse(w, ctx, channel)
+	}
+}
+
+func getChannelsOfNodeHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		
+		channels, err := common.QueryChannelsOfNode(ctx, vars["id"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		
+		rest.PostProcessResponse(w, ctx, channels)
+	}
+}
+
+func getChannelsOfAddressHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		
+		channels, err := common.QueryChannelsOfAddress(ctx, vars["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		
+		rest.PostProcessResponse(w, ctx, channels)
+	}
+}
@@ -4,10 +4,13 @@ import (
 	"net/http"
 	
 	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/gorilla/mux"
 	
+	hub "github.com/sentinel-official/hub/types"
 	"github.com/sentinel-official/hub/x/vpn/client/common"
+	"github.com/sentinel-official/hub/x/vpn/types"
 )
 
 func getNodeHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
@@ -38,9 +41,54 @@ func getNodesOfAddressHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 	}
 }
 
-func getAllNodesHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+func getNodesHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		nodes, err := common.QueryAllNodes(ctx)
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		
+		query := r.URL.Query()
+		
+		minInternetSpeed := hub.NewBandwidthFromInt64(0, 0)
+		if s := query.Get("min_upload_speed"); s != "" {
+			upload, ok := sdk.NewIntFromString(s)
+			if !ok {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid min_upload_speed")
+				return
+			}
+			minInternetSpeed.Upload = upload
+		}
+		if s := query.Get("min_download_speed"); s != "" {
+			download, ok := sdk.NewIntFromString(s)
+			if !ok {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid min_download_speed")
+				return
+			}
+			minInternetSpeed.Download = download
+		}
+		
+		maxPricesPerGB, err := sdk.ParseCoins(query.Get("max_prices_per_gb"))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		
+		var resolverID hub.ResolverID
+		if s := query.Get("resolver_id"); s != "" {
+			resolverID, err = hub.NewResolverIDFromString(s)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+		
+		params := types.NewQueryNodesParams(page, limit, query.Get("status"), query.Get("type"),
+			query.Get("encryption"), query.Get("version"), minInternetSpeed, maxPricesPerGB, resolverID,
+			query.Get("sort_by"))
+		
+		nodes, err := common.QueryNodes(ctx, params)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -5,6 +5,7 @@ import (
 	
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
 	
 	"github.com/sentinel-official/hub/x/vpn/client/common"
 )
@@ -18,6 +19,25 @@ func getResolversHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
+		
+		if region := r.URL.Query().Get("region"); region != "" {
+			resolvers = resolvers.WithRegion(region)
+		}
+		
 		rest.PostProcessResponse(w, ctx, resolvers)
 	}
 }
+
+func getCommissionsOfResolverHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		
+		commissions, err := common.QueryCommissionsOfResolver(ctx, vars["id"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		
+		rest.PostProcessResponse(w, ctx, commissions)
+	}
+}
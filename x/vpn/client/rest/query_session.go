@@ -24,6 +24,20 @@ func getSessionHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+func getSettlementHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		
+		settlement, err := common.QuerySettlement(ctx, vars["id"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		
+		rest.PostProcessResponse(w, ctx, settlement)
+	}
+}
+
 func getSessionsOfSubscriptionHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
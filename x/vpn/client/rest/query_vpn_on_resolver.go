@@ -37,3 +37,17 @@ func getNodesOfResolverHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, ctx, nodes)
 	}
 }
+
+func getPendingNodesOfResolverHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		
+		nodes, err := common.QueryPendingNodesOfResolver(ctx, vars["id"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		
+		rest.PostProcessResponse(w, ctx, nodes)
+	}
+}
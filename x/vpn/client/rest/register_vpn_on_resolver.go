@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/gorilla/mux"
 	
 	hub "github.com/sentinel-official/hub/types"
 	"github.com/sentinel-official/hub/x/vpn/types"
@@ -14,7 +15,6 @@ import (
 
 type msgAddVPNOnResolver struct {
 	BaseReq    rest.BaseReq `json:"base_req"`
-	NodeID     string       `json:"node_id"`
 	ResolverID string       `json:"resolver_id"`
 }
 
@@ -37,7 +37,8 @@ func registerVPNOnResolverHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 			return
 		}
 		
-		nodeID, err := hub.NewNodeIDFromString(req.NodeID)
+		vars := mux.Vars(r)
+		nodeID, err := hub.NewNodeIDFromString(vars["id"])
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
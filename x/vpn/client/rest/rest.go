@@ -17,24 +17,43 @@ func registerTxRoutes(ctx context.CLIContext, r *mux.Router) {
 		Methods("DELETE")
 	r.HandleFunc("/nodes/{id}/info", updateNodeInfoHandlerFunc(ctx)).
 		Methods("PUT")
+	r.HandleFunc("/nodes/{id}/plans", updateNodePlansHandlerFunc(ctx)).
+		Methods("PUT")
+	r.HandleFunc("/nodes/{id}/heartbeat", nodeHeartbeatHandlerFunc(ctx)).
+		Methods("POST")
+	r.HandleFunc("/nodes/{id}/deposit", topUpNodeDepositHandlerFunc(ctx)).
+		Methods("POST")
 	r.HandleFunc("/nodes/{id}/add-free-client", addFreeClientHandlerFunc(ctx)).
 		Methods("POST")
 	r.HandleFunc("/nodes/{id}/remove-free-client/{address}", removeFreeClientHandlerFunc(ctx)).
 		Methods("DELETE")
-	r.HandleFunc("/nodes/{id}/register-vpn-on-resolver", registerVPNOnResolverHandlerFunc(ctx)).
-		Methods("POST")
-	r.HandleFunc("/nodes/{id}/deregister-vpn-on-resolver/{address}", deregisterVPNOnResolverHandlerFunc(ctx)).
+	r.HandleFunc("/nodes/{id}/resolvers", registerVPNOnResolverHandlerFunc(ctx)).
 		Methods("POST")
+	r.HandleFunc("/nodes/{id}/resolvers/{resolver_id}", deregisterVPNOnResolverHandlerFunc(ctx)).
+		Methods("DELETE")
 	r.HandleFunc("/nodes/{id}/subscriptions", startSubscriptionHandlerFunc(ctx)).
 		Methods("POST")
+	r.HandleFunc("/nodes/{id}/channels", openChannelHandlerFunc(ctx)).
+		Methods("POST")
 
 	r.HandleFunc("/subscriptions/{id}", endSubscriptionHandlerFunc(ctx)).
 		Methods("DELETE")
-	r.HandleFunc("/subscriptions/{id}/sessions/bandwidth/sign", signSessionBandwidthHandlerFunc(ctx)).
+	r.HandleFunc("/subscriptions/{id}/sessions", startSessionHandlerFunc(ctx)).
+		Methods("POST")
+	r.HandleFunc("/sessions", batchUpdateSessionsHandlerFunc(ctx)).
+		Methods("PUT")
+	r.HandleFunc("/sessions/{id}/bandwidth/sign", signSessionBandwidthHandlerFunc(ctx)).
 		Methods("POST")
-	r.HandleFunc("/subscriptions/{id}/sessions", updateSessionInfoHandlerFunc(ctx)).
+	r.HandleFunc("/sessions/{id}", updateSessionInfoHandlerFunc(ctx)).
 		Methods("PUT")
-	r.HandleFunc("/subscriptions/{id}/sessions", endSessionHandlerFunc(ctx)).
+	r.HandleFunc("/sessions/{id}", endSessionHandlerFunc(ctx)).
+		Methods("DELETE")
+	r.HandleFunc("/subscriptions/{id}/evidence", submitEvidenceHandlerFunc(ctx)).
+		Methods("POST")
+	
+	r.HandleFunc("/channels/{id}/vouchers", redeemVoucherHandlerFunc(ctx)).
+		Methods("POST")
+	r.HandleFunc("/channels/{id}", closeChannelHandlerFunc(ctx)).
 		Methods("DELETE")
 
 	r.HandleFunc("/resolver", registerResolverHandleFunc(ctx)).
@@ -43,10 +62,14 @@ func registerTxRoutes(ctx context.CLIContext, r *mux.Router) {
 		Methods("PUT")
 	r.HandleFunc("/resolver/de-register", deregisterResolverHandleFunc(ctx)).
 		Methods("DELETE")
+	r.HandleFunc("/resolvers/{id}/pending-nodes/{node_id}", acceptVPNOnResolverHandlerFunc(ctx)).
+		Methods("PUT")
+	r.HandleFunc("/resolvers/{id}/pending-nodes/{node_id}", rejectVPNOnResolverHandlerFunc(ctx)).
+		Methods("DELETE")
 }
 
 func registerQueryRoutes(ctx context.CLIContext, r *mux.Router) {
-	r.HandleFunc("/nodes", getAllNodesHandlerFunc(ctx)).
+	r.HandleFunc("/nodes", getNodesHandlerFunc(ctx)).
 		Methods("GET")
 	r.HandleFunc("/nodes/{id}", getNodeHandlerFunc(ctx)).
 		Methods("GET")
@@ -56,6 +79,8 @@ func registerQueryRoutes(ctx context.CLIContext, r *mux.Router) {
 		Methods("GET")
 	r.HandleFunc("/nodes/{id}/subscriptions", getSubscriptionsOfNodeHandlerFunc(ctx)).
 		Methods("GET")
+	r.HandleFunc("/nodes/{id}/channels", getChannelsOfNodeHandlerFunc(ctx)).
+		Methods("GET")
 
 	r.HandleFunc("/subscriptions", getAllSubscriptionsHandlerFunc(ctx)).
 		Methods("GET")
@@ -68,9 +93,16 @@ func registerQueryRoutes(ctx context.CLIContext, r *mux.Router) {
 		Methods("GET")
 	r.HandleFunc("/sessions/{id}", getSessionHandlerFunc(ctx)).
 		Methods("GET")
+	r.HandleFunc("/sessions/{id}/settlement", getSettlementHandlerFunc(ctx)).
+		Methods("GET")
 
+	r.HandleFunc("/channels/{id}", getChannelHandlerFunc(ctx)).
+		Methods("GET")
+	
 	r.HandleFunc("/accounts/{address}/subscriptions", getSubscriptionsOfAddressHandlerFunc(ctx)).
 		Methods("GET")
+	r.HandleFunc("/accounts/{address}/channels", getChannelsOfAddressHandlerFunc(ctx)).
+		Methods("GET")
 	r.HandleFunc("/accounts/{address}/nodes", getNodesOfAddressHandlerFunc(ctx)).
 		Methods("GET")
 	r.HandleFunc("/accounts/{address}/free-nodes", getFreeNodesOfClientHandlerFunc(ctx)).
@@ -80,6 +112,10 @@ func registerQueryRoutes(ctx context.CLIContext, r *mux.Router) {
 
 	r.HandleFunc("/resolvers", getResolversHandlerFunc(ctx)).
 		Methods("GET")
+	r.HandleFunc("/resolvers/{id}/commissions", getCommissionsOfResolverHandlerFunc(ctx)).
+		Methods("GET")
+	r.HandleFunc("/resolvers/{id}/pending-nodes", getPendingNodesOfResolverHandlerFunc(ctx)).
+		Methods("GET")
 	r.HandleFunc("/vpn/params", getParamsHandlerFunc(ctx)).
 		Methods("GET")
 }
@@ -31,18 +31,12 @@ func signSessionBandwidthHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 			return
 		}
 		
-		scs, err := common.QuerySessionsCountOfSubscription(ctx, vars["id"])
+		session, err := common.QuerySession(ctx, vars["id"])
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		
-		id, err := hub.NewSubscriptionIDFromString(vars["id"])
-		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
-			return
-		}
-		data := hub.NewBandwidthSignatureData(id, scs, req.Bandwidth).Bytes()
+		data := hub.NewBandwidthSignatureData(session.SubscriptionID, session.ID, req.Bandwidth).Bytes()
 		
 		kb, err := keys.NewKeyBaseFromHomeFlag()
 		if err != nil {
@@ -98,7 +92,7 @@ func updateSessionInfoHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 			return
 		}
 		
-		id, err := hub.NewSubscriptionIDFromString(vars["id"])
+		id, err := hub.NewSessionIDFromString(vars["id"])
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
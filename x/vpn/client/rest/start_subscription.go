@@ -17,6 +17,7 @@ type msgStartSubscription struct {
 	BaseReq    rest.BaseReq `json:"base_req"`
 	ResolverID string       `json:"resolver_id"`
 	Deposit    string       `json:"deposit"`
+	Plan       uint64       `json:"plan"`
 }
 
 func startSubscriptionHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
@@ -44,7 +45,7 @@ func startSubscriptionHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 			return
 		}
 		
-		deposit, err := sdk.ParseCoin(req.Deposit)
+		deposit, err := sdk.ParseCoins(req.Deposit)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -56,7 +57,7 @@ func startSubscriptionHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		msg := types.NewMsgStartSubscription(fromAddress, resolver, id, deposit)
+		msg := types.NewMsgStartSubscription(fromAddress, resolver, id, deposit, req.Plan)
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
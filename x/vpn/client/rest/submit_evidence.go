@@ -0,0 +1,62 @@
+package rest
+
+import (
+	"net/http"
+	
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/gorilla/mux"
+	
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+type msgSubmitEvidence struct {
+	BaseReq    rest.BaseReq      `json:"base_req"`
+	SessionID  hub.SessionID     `json:"session_id"`
+	Bandwidth1 hub.Bandwidth     `json:"bandwidth_1"`
+	Signature1 auth.StdSignature `json:"signature_1"`
+	Bandwidth2 hub.Bandwidth     `json:"bandwidth_2"`
+	Signature2 auth.StdSignature `json:"signature_2"`
+}
+
+func submitEvidenceHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req msgSubmitEvidence
+		
+		if !rest.ReadRESTReq(w, r, ctx.Codec, &req) {
+			return
+		}
+		
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		
+		vars := mux.Vars(r)
+		id, err := hub.NewSubscriptionIDFromString(vars["id"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		
+		evidence := types.NewEvidence(id, req.SessionID, req.Bandwidth1, req.Signature1, req.Bandwidth2, req.Signature2)
+		
+		msg := types.NewMsgSubmitEvidence(fromAddress, evidence)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		
+		utils.WriteGenerateStdTxResponse(w, ctx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -8,14 +8,21 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/gorilla/mux"
 	
 	hub "github.com/sentinel-official/hub/types"
 	"github.com/sentinel-official/hub/x/vpn/types"
 )
 
 type msgRegisterResolver struct {
-	BaseReq    rest.BaseReq `json:"base_req"`
-	Commission string       `json:"commission"`
+	BaseReq                 rest.BaseReq `json:"base_req"`
+	Moniker                 string       `json:"moniker"`
+	Endpoint                string       `json:"endpoint"`
+	Regions                 []string     `json:"regions"`
+	Deposit                 string       `json:"deposit"`
+	Commission              string       `json:"commission"`
+	MaxCommissionRate       string       `json:"max_commission_rate"`
+	MaxCommissionChangeRate string       `json:"max_commission_change_rate"`
 }
 
 func registerResolverHandleFunc(ctx context.CLIContext) http.HandlerFunc {
@@ -48,7 +55,26 @@ func registerResolverHandleFunc(ctx context.CLIContext) http.HandlerFunc {
 			return
 		}
 		
-		msg := types.NewMsgRegisterResolver(addr, commission)
+		maxRate, err := sdk.NewDecFromStr(req.MaxCommissionRate)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		
+		maxChangeRate, err := sdk.NewDecFromStr(req.MaxCommissionChangeRate)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		
+		deposit, err := sdk.ParseCoin(req.Deposit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		
+		msg := types.NewMsgRegisterResolver(addr, req.Moniker, req.Endpoint, req.Regions, deposit,
+			commission, maxRate, maxChangeRate)
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -60,6 +86,9 @@ func registerResolverHandleFunc(ctx context.CLIContext) http.HandlerFunc {
 
 type msgUpdateResolver struct {
 	BaseReq    rest.BaseReq `json:"base_req"`
+	Moniker    string       `json:"moniker"`
+	Endpoint   string       `json:"endpoint"`
+	Regions    []string     `json:"regions"`
 	Commission string       `json:"commission"`
 	ResolverID string       `json:"resolver_id"`
 }
@@ -88,18 +117,23 @@ func updateResolverHandleFunc(ctx context.CLIContext) http.HandlerFunc {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		commission, err := sdk.NewDecFromStr(req.Commission)
-		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
-			return
-		}
 		
-		if commission.LT(sdk.ZeroDec()) || commission.GT(sdk.OneDec()) {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("commission %s: between 0 and 1", commission.String()))
-			return
+		var commission *sdk.Dec
+		if req.Commission != "" {
+			rate, err := sdk.NewDecFromStr(req.Commission)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			if rate.LT(sdk.ZeroDec()) || rate.GT(sdk.OneDec()) {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("commission %s: between 0 and 1", rate.String()))
+				return
+			}
+			
+			commission = &rate
 		}
 		
-		msg := types.NewMsgUpdateResolverInfo(addr, resolverID, commission)
+		msg := types.NewMsgUpdateResolverInfo(addr, resolverID, req.Moniker, req.Endpoint, req.Regions, commission)
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -148,3 +182,62 @@ func deregisterResolverHandleFunc(ctx context.CLIContext) http.HandlerFunc {
 		utils.WriteGenerateStdTxResponse(w, ctx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+type msgReviewVPNOnResolver struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+}
+
+func acceptVPNOnResolverHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return reviewVPNOnResolverHandlerFunc(ctx, func(from sdk.AccAddress, resolverID hub.ResolverID, nodeID hub.NodeID) sdk.Msg {
+		return types.NewMsgAcceptVPNOnResolver(from, resolverID, nodeID)
+	})
+}
+
+func rejectVPNOnResolverHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return reviewVPNOnResolverHandlerFunc(ctx, func(from sdk.AccAddress, resolverID hub.ResolverID, nodeID hub.NodeID) sdk.Msg {
+		return types.NewMsgRejectVPNOnResolver(from, resolverID, nodeID)
+	})
+}
+
+func reviewVPNOnResolverHandlerFunc(ctx context.CLIContext,
+	newMsg func(sdk.AccAddress, hub.ResolverID, hub.NodeID) sdk.Msg) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req msgReviewVPNOnResolver
+		
+		if !rest.ReadRESTReq(w, r, ctx.Codec, &req) {
+			return
+		}
+		
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+		
+		addr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		
+		vars := mux.Vars(r)
+		resolverID, err := hub.NewResolverIDFromString(vars["id"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		
+		nodeID, err := hub.NewNodeIDFromString(vars["node_id"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		
+		msg := newMsg(addr, resolverID, nodeID)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		
+		utils.WriteGenerateStdTxResponse(w, ctx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
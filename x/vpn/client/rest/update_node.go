@@ -21,6 +21,8 @@ type msgUpdateNode struct {
 	Encryption    string        `json:"encryption"`
 	Type          string        `json:"type"`
 	Version       string        `json:"version"`
+	
+	MaxConcurrentSessions uint64 `json:"max_concurrent_sessions"`
 }
 
 func updateNodeInfoHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
@@ -55,7 +57,7 @@ func updateNodeInfoHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 			return
 		}
 		msg := types.NewMsgUpdateNodeInfo(fromAddress, id, req.Type, req.Version,
-			req.Moniker, pricesPerGB, req.InternetSpeed, req.Encryption)
+			req.Moniker, pricesPerGB, req.InternetSpeed, req.Encryption, req.MaxConcurrentSessions)
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -5,20 +5,39 @@ import (
 	
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	
+	hub "github.com/sentinel-official/hub/types"
 	"github.com/sentinel-official/hub/x/vpn/types"
 )
 
+// InitGenesis restores the records of the state and rebuilds the indexes of them. The counts are set to the
+// next free ID rather than the number of the records, so that the IDs of new records never collide with the
+// imported ones.
 func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 	k.SetParams(ctx, data.Params)
+	k.SetConsensusVersion(ctx, types.ConsensusVersion)
 	
 	for _, node := range data.Nodes {
 		k.SetNode(ctx, node)
 		
 		nca := k.GetNodesCountOfAddress(ctx, node.Owner)
 		k.SetNodeIDByAddress(ctx, node.Owner, nca, node.ID)
-		
-		k.SetNodesCount(ctx, k.GetNodesCount(ctx)+1)
 		k.SetNodesCountOfAddress(ctx, node.Owner, nca+1)
+		
+		if node.ID.Uint64() >= k.GetNodesCount(ctx) {
+			k.SetNodesCount(ctx, node.ID.Uint64()+1)
+		}
+		if node.Status == types.StatusUnbonding {
+			k.AddNodeIDToUnbondingQueue(ctx, node.UnbondingCompletionTime, node.ID)
+		}
+	}
+	
+	for _, active := range data.ActiveNodeIDs {
+		ids := make(hub.IDs, 0, len(active.IDs))
+		for _, id := range active.IDs {
+			ids = ids.Append(id)
+		}
+		
+		k.SetActiveNodeIDs(ctx, active.Height, ids)
 	}
 	
 	for _, subscription := range data.Subscriptions {
@@ -26,13 +45,18 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		
 		scn := k.GetSubscriptionsCountOfNode(ctx, subscription.NodeID)
 		k.SetSubscriptionIDByNodeID(ctx, subscription.NodeID, scn, subscription.ID)
+		k.SetSubscriptionsCountOfNode(ctx, subscription.NodeID, scn+1)
 		
 		sca := k.GetSubscriptionsCountOfAddress(ctx, subscription.Client)
 		k.SetSubscriptionIDByAddress(ctx, subscription.Client, sca, subscription.ID)
-		
-		k.SetSubscriptionsCount(ctx, k.GetSubscriptionsCount(ctx)+1)
-		k.SetSubscriptionsCountOfNode(ctx, subscription.NodeID, scn+1)
 		k.SetSubscriptionsCountOfAddress(ctx, subscription.Client, sca+1)
+		
+		if subscription.ID.Uint64() >= k.GetSubscriptionsCount(ctx) {
+			k.SetSubscriptionsCount(ctx, subscription.ID.Uint64()+1)
+		}
+		if subscription.Status == types.StatusActive && !subscription.ExpiresAt.IsZero() {
+			k.AddSubscriptionIDToExpiryQueue(ctx, subscription.ExpiresAt, subscription.ID)
+		}
 	}
 	
 	for _, session := range data.Sessions {
@@ -40,26 +64,68 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		
 		scs := k.GetSessionsCountOfSubscription(ctx, session.SubscriptionID)
 		k.SetSessionIDBySubscriptionID(ctx, session.SubscriptionID, scs, session.ID)
-		
-		k.SetSessionsCount(ctx, k.GetSessionsCount(ctx)+1)
 		k.SetSessionsCountOfSubscription(ctx, session.SubscriptionID, scs+1)
+		
+		if session.ID.Uint64() >= k.GetSessionsCount(ctx) {
+			k.SetSessionsCount(ctx, session.ID.Uint64()+1)
+		}
+	}
+	
+	for _, active := range data.ActiveSessionIDs {
+		ids := make(hub.IDs, 0, len(active.IDs))
+		for _, id := range active.IDs {
+			ids = ids.Append(id)
+		}
+		
+		k.SetActiveSessionIDs(ctx, active.Height, ids)
+	}
+	
+	for _, evidence := range data.Evidences {
+		k.SetEvidence(ctx, evidence)
+	}
+	
+	for _, settlement := range data.Settlements {
+		k.SetSettlement(ctx, settlement)
+	}
+	
+	for _, channel := range data.Channels {
+		k.AddChannel(ctx, channel)
+		
+		if channel.ID.Uint64() >= k.GetChannelsCount(ctx) {
+			k.SetChannelsCount(ctx, channel.ID.Uint64()+1)
+		}
 	}
 	
 	for _, resolver := range data.Resolvers {
 		k.SetResolver(ctx, resolver)
 		
-		rc := k.GetResolverCount(ctx)
 		rca := k.GetResolversCountOfAddress(ctx, resolver.Owner)
-		
 		k.SetResolverIDByAddress(ctx, resolver.Owner, rca, resolver.ID)
-		k.SetResolverCount(ctx, rc+1)
 		k.SetResolverCountOfAddress(ctx, resolver.Owner, rca+1)
+		
+		if resolver.ID.Uint64() >= k.GetResolverCount(ctx) {
+			k.SetResolverCount(ctx, resolver.ID.Uint64()+1)
+		}
+		if resolver.Status == types.StatusUnbonding {
+			k.AddResolverIDToUnbondingQueue(ctx, resolver.UnbondingCompletionTime, resolver.ID)
+		}
+	}
+	
+	for _, commission := range data.ResolverCommissions {
+		k.SetResolverCommission(ctx, commission)
+	}
+	
+	for _, link := range data.ResolverNodes {
+		k.SetNodeOfResolver(ctx, link.ResolverID, link.NodeID)
+		k.SetResolverOfNode(ctx, link.NodeID, link.ResolverID)
+	}
+	
+	for _, link := range data.PendingResolverNodes {
+		k.SetPendingNodeOfResolver(ctx, link.ResolverID, link.NodeID)
 	}
 	
 	for _, freeClient := range data.FreeClients {
 		k.SetFreeClient(ctx, freeClient)
-		k.SetFreeClientOfNode(ctx, freeClient.NodeID, freeClient.Client)
-		k.SetFreeNodeOfClient(ctx, freeClient.Client, freeClient.NodeID)
 	}
 }
 
@@ -68,28 +134,221 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 	nodes := k.GetAllNodes(ctx)
 	subscriptions := k.GetAllSubscriptions(ctx)
 	sessions := k.GetAllSessions(ctx)
+	channels := k.GetAllChannels(ctx)
 	resolvers := k.GetAllResolvers(ctx)
+	resolverCommissions := k.GetAllResolverCommissions(ctx)
 	freeClients := k.GetFreeClients(ctx)
+	evidences := k.GetAllEvidences(ctx)
+	settlements := k.GetAllSettlements(ctx)
+	
+	var resolverNodes, pendingResolverNodes []types.ResolverNode
+	for _, resolver := range resolvers {
+		for _, id := range k.GetNodesOfResolver(ctx, resolver.ID) {
+			resolverNodes = append(resolverNodes, types.ResolverNode{ResolverID: resolver.ID, NodeID: id})
+		}
+		for _, id := range k.GetPendingNodesOfResolver(ctx, resolver.ID) {
+			pendingResolverNodes = append(pendingResolverNodes, types.ResolverNode{ResolverID: resolver.ID, NodeID: id})
+		}
+	}
+	
+	var activeNodeIDs []types.ActiveNodeIDs
+	k.IterateActiveNodeIDs(ctx, func(height int64, ids hub.IDs) bool {
+		active := types.ActiveNodeIDs{Height: height, IDs: make([]hub.NodeID, 0, len(ids))}
+		for _, id := range ids {
+			active.IDs = append(active.IDs, id.(hub.NodeID))
+		}
+		
+		activeNodeIDs = append(activeNodeIDs, active)
+		return false
+	})
 	
-	return types.NewGenesisState(nodes, subscriptions, sessions, resolvers, freeClients, params)
+	var activeSessionIDs []types.ActiveSessionIDs
+	k.IterateActiveSessionIDs(ctx, func(height int64, ids hub.IDs) bool {
+		active := types.ActiveSessionIDs{Height: height, IDs: make([]hub.SessionID, 0, len(ids))}
+		for _, id := range ids {
+			active.IDs = append(active.IDs, id.(hub.SessionID))
+		}
+		
+		activeSessionIDs = append(activeSessionIDs, active)
+		return false
+	})
+	
+	return types.NewGenesisState(nodes, subscriptions, sessions, channels, resolvers, resolverCommissions,
+		resolverNodes, pendingResolverNodes, freeClients, evidences, settlements, activeNodeIDs, activeSessionIDs,
+		params)
 }
 
+// PrepForZeroHeightGenesis prepares the state for the genesis of a new chain which starts at the height zero.
+// The active sessions are settled, and the ones which fail to settle are carried over. The heights of the nodes,
+// the sessions and the resolvers are reset, and the active lists are rebuilt at the height zero, so that the
+// EndBlock of the new chain processes them after the inactive intervals. The free client grants keep their
+// remaining blocks. The subscriptions keep their locked deposits and expire by time on the new chain.
+func PrepForZeroHeightGenesis(ctx sdk.Context, k Keeper) {
+	var (
+		sessionHeights []int64
+		sessionIDs     []hub.SessionID
+	)
+	
+	k.IterateActiveSessionIDs(ctx, func(height int64, ids hub.IDs) bool {
+		sessionHeights = append(sessionHeights, height)
+		for _, id := range ids {
+			sessionIDs = append(sessionIDs, id.(hub.SessionID))
+		}
+		
+		return false
+	})
+	
+	for _, id := range sessionIDs {
+		session, found := k.GetSession(ctx, id)
+		if !found || session.Status != types.StatusActive {
+			continue
+		}
+		
+		cacheCtx, write := ctx.CacheContext()
+		if _, err := k.SettleSession(cacheCtx, session); err != nil {
+			ctx.Logger().Error("failed to settle the session, carrying it over", "id", session.ID, "err", err.Error())
+			continue
+		}
+		
+		write()
+	}
+	
+	for _, height := range sessionHeights {
+		k.DeleteActiveSessionIDs(ctx, height)
+	}
+	
+	for _, session := range k.GetAllSessions(ctx) {
+		session.StatusModifiedAt = 0
+		k.SetSession(ctx, session)
+		
+		if session.Status == types.StatusActive {
+			k.AddSessionIDToActiveList(ctx, 0, session.ID)
+		}
+	}
+	
+	var nodeHeights []int64
+	k.IterateActiveNodeIDs(ctx, func(height int64, _ hub.IDs) bool {
+		nodeHeights = append(nodeHeights, height)
+		return false
+	})
+	
+	for _, height := range nodeHeights {
+		k.DeleteActiveNodeIDs(ctx, height)
+	}
+	
+	for _, node := range k.GetAllNodes(ctx) {
+		node.StatusModifiedAt = 0
+		k.SetNode(ctx, node)
+		
+		if node.Status == types.StatusRegistered {
+			k.AddNodeIDToActiveList(ctx, 0, node.ID)
+		}
+	}
+	
+	for _, resolver := range k.GetAllResolvers(ctx) {
+		resolver.CommissionUpdatedAt = 0
+		resolver.StatusModifiedAt = 0
+		k.SetResolver(ctx, resolver)
+	}
+	
+	for _, freeClient := range k.GetFreeClients(ctx) {
+		if freeClient.ExpiresAt == 0 {
+			continue
+		}
+		
+		if freeClient.IsExpired(ctx.BlockHeight()) {
+			k.RemoveFreeClient(ctx, freeClient.NodeID, freeClient.Client)
+			continue
+		}
+		
+		freeClient.ExpiresAt -= ctx.BlockHeight()
+		k.SetFreeClient(ctx, freeClient)
+	}
+}
+
+// ValidateGenesis checks the records of the state and that the records they refer to exist
+// nolint:funlen
 func ValidateGenesis(data types.GenesisState) error {
 	if err := data.Params.Validate(); err != nil {
 		return err
 	}
 	
-	sessionsMap := make(map[uint64]bool, len(data.Sessions))
-	for _, session := range data.Sessions {
-		if err := session.IsValid(); err != nil {
-			return fmt.Errorf("%s for the %s", err.Error(), session)
+	nodeIDsMap := make(map[uint64]bool, len(data.Nodes))
+	for _, node := range data.Nodes {
+		if err := node.IsValid(); err != nil {
+			return fmt.Errorf("%s for the %s", err.Error(), node)
 		}
 		
-		if sessionsMap[session.ID.Uint64()] {
-			return fmt.Errorf("duplicate id for the %s", session)
+		if node.Deposit.Denom != data.Params.Deposit.Denom {
+			return fmt.Errorf("invalid deposit for the %s", node)
 		}
 		
-		sessionsMap[session.ID.Uint64()] = true
+		if nodeIDsMap[node.ID.Uint64()] {
+			return fmt.Errorf("duplicate id for the %s", node)
+		}
+		
+		nodeIDsMap[node.ID.Uint64()] = true
+	}
+	
+	resolversMap := make(map[uint64]bool, len(data.Resolvers))
+	for _, resolver := range data.Resolvers {
+		if err := resolver.IsValid(); err != nil {
+			return fmt.Errorf("%s for the %s", err.Error(), resolver)
+		}
+		
+		if resolversMap[resolver.ID.Uint64()] {
+			return fmt.Errorf("duplicate id for the %s", resolver)
+		}
+		
+		resolversMap[resolver.ID.Uint64()] = true
+	}
+	
+	for _, commission := range data.ResolverCommissions {
+		if !resolversMap[commission.ResolverID.Uint64()] {
+			return fmt.Errorf("resolver does not exist for the %s", commission)
+		}
+		
+		if commission.Commission.IsNil() || commission.Commission.IsNegative() ||
+			commission.Commission.GT(sdk.OneDec()) {
+			return fmt.Errorf("invalid commission for the %s", commission)
+		}
+	}
+	
+	for _, links := range [][]types.ResolverNode{data.ResolverNodes, data.PendingResolverNodes} {
+		linksMap := make(map[string]bool, len(links))
+		for _, link := range links {
+			if link.ResolverID == nil || !resolversMap[link.ResolverID.Uint64()] {
+				return fmt.Errorf("resolver does not exist for the link %s of the node %s", link.ResolverID, link.NodeID)
+			}
+			if link.NodeID == nil || !nodeIDsMap[link.NodeID.Uint64()] {
+				return fmt.Errorf("node does not exist for the link %s of the node %s", link.ResolverID, link.NodeID)
+			}
+			
+			key := link.ResolverID.String() + link.NodeID.String()
+			if linksMap[key] {
+				return fmt.Errorf("duplicate link %s of the node %s", link.ResolverID, link.NodeID)
+			}
+			
+			linksMap[key] = true
+		}
+	}
+	
+	freeClientsMap := make(map[string]bool, len(data.FreeClients))
+	for _, freeClient := range data.FreeClients {
+		if err := freeClient.IsValid(); err != nil {
+			return fmt.Errorf("%s for the %s", err.Error(), freeClient)
+		}
+		
+		if !nodeIDsMap[freeClient.NodeID.Uint64()] {
+			return fmt.Errorf("node does not exist for the %s", freeClient)
+		}
+		
+		key := freeClient.NodeID.String() + freeClient.Client.String()
+		if freeClientsMap[key] {
+			return fmt.Errorf("duplicate node_id and client for the %s", freeClient)
+		}
+		
+		freeClientsMap[key] = true
 	}
 	
 	subscriptionsMap := make(map[uint64]bool, len(data.Subscriptions))
@@ -98,6 +357,13 @@ func ValidateGenesis(data types.GenesisState) error {
 			return fmt.Errorf("%s for the %s", err.Error(), subscription)
 		}
 		
+		if !nodeIDsMap[subscription.NodeID.Uint64()] {
+			return fmt.Errorf("node does not exist for the %s", subscription)
+		}
+		if !resolversMap[subscription.ResolverID.Uint64()] {
+			return fmt.Errorf("resolver does not exist for the %s", subscription)
+		}
+		
 		if subscriptionsMap[subscription.ID.Uint64()] {
 			return fmt.Errorf("duplicate id for the %s", subscription)
 		}
@@ -105,21 +371,105 @@ func ValidateGenesis(data types.GenesisState) error {
 		subscriptionsMap[subscription.ID.Uint64()] = true
 	}
 	
-	nodeIDsMap := make(map[uint64]bool, len(data.Nodes))
-	for _, node := range data.Nodes {
-		if err := node.IsValid(); err != nil {
-			return fmt.Errorf("%s for the %s", err.Error(), node)
+	sessionsMap := make(map[uint64]bool, len(data.Sessions))
+	for _, session := range data.Sessions {
+		if err := session.IsValid(); err != nil {
+			return fmt.Errorf("%s for the %s", err.Error(), session)
 		}
 		
-		if node.Deposit.Denom != data.Params.Deposit.Denom {
-			return fmt.Errorf("invalid deposit for the %s", node)
+		if !subscriptionsMap[session.SubscriptionID.Uint64()] {
+			return fmt.Errorf("subscription does not exist for the %s", session)
 		}
 		
-		if nodeIDsMap[node.ID.Uint64()] {
-			return fmt.Errorf("duplicate id for the %s", node)
+		if sessionsMap[session.ID.Uint64()] {
+			return fmt.Errorf("duplicate id for the %s", session)
 		}
 		
-		nodeIDsMap[node.ID.Uint64()] = true
+		sessionsMap[session.ID.Uint64()] = true
+	}
+	
+	channelsMap := make(map[uint64]bool, len(data.Channels))
+	for _, channel := range data.Channels {
+		if err := channel.IsValid(); err != nil {
+			return fmt.Errorf("%s for the %s", err.Error(), channel)
+		}
+		
+		if !nodeIDsMap[channel.NodeID.Uint64()] {
+			return fmt.Errorf("node does not exist for the %s", channel)
+		}
+		
+		if channelsMap[channel.ID.Uint64()] {
+			return fmt.Errorf("duplicate id for the %s", channel)
+		}
+		
+		channelsMap[channel.ID.Uint64()] = true
+	}
+	
+	evidencesMap := make(map[uint64]bool, len(data.Evidences))
+	for _, evidence := range data.Evidences {
+		if err := evidence.IsValid(); err != nil {
+			return fmt.Errorf("%s for the %s", err.Error(), evidence)
+		}
+		
+		if !subscriptionsMap[evidence.SubscriptionID.Uint64()] {
+			return fmt.Errorf("subscription does not exist for the %s", evidence)
+		}
+		if !sessionsMap[evidence.SessionID.Uint64()] {
+			return fmt.Errorf("session does not exist for the %s", evidence)
+		}
+		
+		if evidencesMap[evidence.SessionID.Uint64()] {
+			return fmt.Errorf("duplicate session_id for the %s", evidence)
+		}
+		
+		evidencesMap[evidence.SessionID.Uint64()] = true
+	}
+	
+	settlementsMap := make(map[uint64]bool, len(data.Settlements))
+	for _, settlement := range data.Settlements {
+		if settlement.SessionID == nil || !sessionsMap[settlement.SessionID.Uint64()] {
+			return fmt.Errorf("session does not exist for the %s", settlement)
+		}
+		if settlement.SubscriptionID == nil || !subscriptionsMap[settlement.SubscriptionID.Uint64()] {
+			return fmt.Errorf("subscription does not exist for the %s", settlement)
+		}
+		
+		if settlementsMap[settlement.SessionID.Uint64()] {
+			return fmt.Errorf("duplicate session_id for the %s", settlement)
+		}
+		
+		settlementsMap[settlement.SessionID.Uint64()] = true
+	}
+	
+	activeNodeIDsMap := make(map[int64]bool, len(data.ActiveNodeIDs))
+	for _, active := range data.ActiveNodeIDs {
+		if activeNodeIDsMap[active.Height] {
+			return fmt.Errorf("duplicate height %d of the active node ids", active.Height)
+		}
+		
+		for _, id := range active.IDs {
+			if id == nil || !nodeIDsMap[id.Uint64()] {
+				return fmt.Errorf("node %s does not exist for the active node ids at the height %d", id, active.Height)
+			}
+		}
+		
+		activeNodeIDsMap[active.Height] = true
+	}
+	
+	activeSessionIDsMap := make(map[int64]bool, len(data.ActiveSessionIDs))
+	for _, active := range data.ActiveSessionIDs {
+		if activeSessionIDsMap[active.Height] {
+			return fmt.Errorf("duplicate height %d of the active session ids", active.Height)
+		}
+		
+		for _, id := range active.IDs {
+			if id == nil || !sessionsMap[id.Uint64()] {
+				return fmt.Errorf("session %s does not exist for the active session ids at the height %d",
+					id, active.Height)
+			}
+		}
+		
+		activeSessionIDsMap[active.Height] = true
 	}
 	
 	return nil
@@ -0,0 +1,124 @@
+package vpn
+
+import (
+	"testing"
+	
+	"github.com/stretchr/testify/require"
+	
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/keeper"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func TestGenesis_FreeClients(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	
+	freeClients := []types.FreeClient{
+		types.NewFreeClient(hub.NewNodeID(0), types.TestAddress2, types.TestBandwidthPos1, 10, 1),
+		types.NewFreeClient(hub.NewNodeID(0), types.TestAddress3, types.TestBandwidthZero, 0, 0),
+	}
+	
+	state := types.DefaultGenesisState()
+	state.Nodes = []types.Node{types.TestNode}
+	state.FreeClients = freeClients
+	require.Nil(t, ValidateGenesis(state))
+	
+	InitGenesis(ctx, k, state)
+	require.ElementsMatch(t, freeClients, ExportGenesis(ctx, k).FreeClients)
+	require.Equal(t, []hub.NodeID{hub.NewNodeID(0)}, k.GetFreeNodesOfClient(ctx, types.TestAddress3))
+	
+	state.FreeClients = append(freeClients, freeClients[0])
+	require.NotNil(t, ValidateGenesis(state))
+	
+	state.FreeClients = []types.FreeClient{
+		types.NewFreeClient(hub.NewNodeID(1), types.TestAddress2, types.TestBandwidthZero, 0, 0),
+	}
+	require.NotNil(t, ValidateGenesis(state))
+}
+
+func TestGenesis_References(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	
+	state := types.DefaultGenesisState()
+	state.Nodes = []types.Node{types.TestNode}
+	state.Resolvers = []types.Resolver{types.TestResolver}
+	state.Subscriptions = []types.Subscription{types.TestSubscription}
+	state.Sessions = []types.Session{types.TestSession}
+	state.Channels = []types.Channel{types.TestChannel}
+	state.ResolverNodes = []types.ResolverNode{{ResolverID: hub.NewResolverID(0), NodeID: hub.NewNodeID(0)}}
+	state.ActiveNodeIDs = []types.ActiveNodeIDs{{Height: 1, IDs: []hub.NodeID{hub.NewNodeID(0)}}}
+	state.ActiveSessionIDs = []types.ActiveSessionIDs{{Height: 2, IDs: []hub.SessionID{hub.NewSessionID(0)}}}
+	require.Nil(t, ValidateGenesis(state))
+	
+	InitGenesis(ctx, k, state)
+	require.Equal(t, state, ExportGenesis(ctx, k))
+	require.Equal(t, uint64(1), k.GetNodesCount(ctx))
+	require.Equal(t, []hub.ResolverID{hub.NewResolverID(0)}, k.GetResolversOfNode(ctx, hub.NewNodeID(0)))
+	
+	subscription := types.TestSubscription
+	subscription.NodeID = hub.NewNodeID(1)
+	state.Subscriptions = []types.Subscription{subscription}
+	require.NotNil(t, ValidateGenesis(state))
+	
+	subscription = types.TestSubscription
+	subscription.ResolverID = hub.NewResolverID(1)
+	state.Subscriptions = []types.Subscription{subscription}
+	require.NotNil(t, ValidateGenesis(state))
+	
+	state.Subscriptions = []types.Subscription{types.TestSubscription}
+	session := types.TestSession
+	session.SubscriptionID = hub.NewSubscriptionID(1)
+	state.Sessions = []types.Session{session}
+	require.NotNil(t, ValidateGenesis(state))
+	
+	state.Sessions = []types.Session{types.TestSession}
+	state.ResolverNodes = []types.ResolverNode{{ResolverID: hub.NewResolverID(1), NodeID: hub.NewNodeID(0)}}
+	require.NotNil(t, ValidateGenesis(state))
+	
+	state.ResolverNodes = nil
+	state.ActiveSessionIDs = []types.ActiveSessionIDs{{Height: 2, IDs: []hub.SessionID{hub.NewSessionID(1)}}}
+	require.NotNil(t, ValidateGenesis(state))
+}
+
+func TestGenesis_Counts(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	
+	node := types.TestNode
+	node.ID = hub.NewNodeID(4)
+	
+	state := types.DefaultGenesisState()
+	state.Nodes = []types.Node{types.TestNode, node}
+	require.Nil(t, ValidateGenesis(state))
+	
+	InitGenesis(ctx, k, state)
+	require.Equal(t, uint64(5), k.GetNodesCount(ctx))
+	require.Equal(t, uint64(2), k.GetNodesCountOfAddress(ctx, types.TestAddress1))
+}
+
+func TestPrepForZeroHeightGenesis(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	ctx = ctx.WithBlockHeight(10)
+	
+	node := types.TestNode
+	node.Status = types.StatusRegistered
+	node.StatusModifiedAt = 5
+	k.SetNode(ctx, node)
+	k.AddNodeIDToActiveList(ctx, node.StatusModifiedAt, node.ID)
+	
+	k.SetFreeClient(ctx, types.NewFreeClient(node.ID, types.TestAddress2, types.TestBandwidthZero, 25, 0))
+	k.SetFreeClient(ctx, types.NewFreeClient(node.ID, types.TestAddress3, types.TestBandwidthZero, 10, 0))
+	
+	PrepForZeroHeightGenesis(ctx, k)
+	
+	node, _ = k.GetNode(ctx, node.ID)
+	require.Equal(t, int64(0), node.StatusModifiedAt)
+	require.Equal(t, hub.IDs{node.ID}, k.GetActiveNodeIDs(ctx, 0))
+	require.Equal(t, hub.IDs(nil), k.GetActiveNodeIDs(ctx, 5))
+	
+	freeClient, found := k.GetFreeClient(ctx, node.ID, types.TestAddress2)
+	require.True(t, found)
+	require.Equal(t, int64(15), freeClient.ExpiresAt)
+	
+	_, found = k.GetFreeClient(ctx, node.ID, types.TestAddress3)
+	require.False(t, found)
+}
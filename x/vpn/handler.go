@@ -3,10 +3,13 @@ package vpn
 import (
 	"bytes"
 	"reflect"
+	"strconv"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/deposit"
 	"github.com/sentinel-official/hub/x/vpn/keeper"
 	"github.com/sentinel-official/hub/x/vpn/types"
 )
@@ -20,6 +23,8 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleRegisterNode(ctx, k, msg)
 		case types.MsgUpdateNodeInfo:
 			return handleUpdateNodeInfo(ctx, k, msg)
+		case types.MsgUpdateNodePlans:
+			return handleUpdateNodePlans(ctx, k, msg)
 		case types.MsgAddFreeClient:
 			return handleAddFreeClient(ctx, k, msg)
 		case types.MsgRemoveFreeClient:
@@ -30,20 +35,40 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleDeregisterVPNOnResolver(ctx, k, msg)
 		case types.MsgDeregisterNode:
 			return handleDeregisterNode(ctx, k, msg)
+		case types.MsgNodeHeartbeat:
+			return handleNodeHeartbeat(ctx, k, msg)
+		case types.MsgTopUpNodeDeposit:
+			return handleTopUpNodeDeposit(ctx, k, msg)
 		case types.MsgStartSubscription:
 			return handleStartSubscription(ctx, k, msg)
 		case types.MsgEndSubscription:
 			return handleEndSubscription(ctx, k, msg)
+		case types.MsgStartSession:
+			return handleStartSession(ctx, k, msg)
 		case types.MsgUpdateSessionInfo:
 			return handleUpdateSessionInfo(ctx, k, msg)
+		case types.MsgBatchUpdateSessions:
+			return handleBatchUpdateSessions(ctx, k, msg)
 		case types.MsgEndSession:
 			return handleEndSession(ctx, k, msg)
+		case types.MsgSubmitEvidence:
+			return handleSubmitEvidence(ctx, k, msg)
+		case types.MsgOpenChannel:
+			return handleOpenChannel(ctx, k, msg)
+		case types.MsgRedeemVoucher:
+			return handleRedeemVoucher(ctx, k, msg)
+		case types.MsgCloseChannel:
+			return handleCloseChannel(ctx, k, msg)
 		case types.MsgRegisterResolver:
 			return handleRegisterResolver(ctx, k, msg)
 		case types.MsgUpdateResolverInfo:
 			return handleUpdateResolverInfo(ctx, k, msg)
 		case types.MsgDeregisterResolver:
 			return handleDeregisterResolver(ctx, k, msg)
+		case types.MsgAcceptVPNOnResolver:
+			return handleAcceptVPNOnResolver(ctx, k, msg)
+		case types.MsgRejectVPNOnResolver:
+			return handleRejectVPNOnResolver(ctx, k, msg)
 
 		default:
 			return types.ErrorUnknownMsgType(reflect.TypeOf(msg).Name()).Result()
@@ -52,68 +77,262 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 }
 
 func EndBlock(ctx sdk.Context, k keeper.Keeper) {
-	height := ctx.BlockHeight()
-	_height := height - k.SessionInactiveInterval(ctx)
-
+	_height := ctx.BlockHeight() - k.SessionInactiveInterval(ctx)
+	
 	ids := k.GetActiveSessionIDs(ctx, _height)
 	for _, id := range ids {
-		session, _ := k.GetSession(ctx, id.(hub.SessionID))
-		subscription, _ := k.GetSubscription(ctx, session.SubscriptionID)
-
-		bandwidth := session.Bandwidth.CeilTo(hub.GB.Quo(subscription.PricePerGB.Amount))
-
-		freeClients := k.GetFreeClientsOfNode(ctx, subscription.NodeID)
-
-		pay := sdk.NewInt(0)
-		if !types.IsFreeClient(freeClients, subscription.Client) {
-			amount := bandwidth.Sum().Mul(subscription.PricePerGB.Amount).Quo(hub.GB)
-			payCoin := sdk.NewCoin(subscription.PricePerGB.Denom, amount)
-
-			pay = payCoin.Amount
-			if !pay.IsZero() {
-				node, _ := k.GetNode(ctx, subscription.NodeID)
-
-				_resolver, found := k.GetResolver(ctx, subscription.ResolverID)
-				if !found {
-					panic("no resolver found")
-				}
-
-				commission := _resolver.GetCommission(payCoin)
-
-				if commission.IsPositive() {
-					if err := k.SendDeposit(ctx, subscription.Client, _resolver.Owner, commission); err != nil {
-						panic(err)
-					}
+		session, found := k.GetSession(ctx, id.(hub.SessionID))
+		if !found {
+			continue
+		}
+		
+		cacheCtx, write := ctx.CacheContext()
+		if _, err := k.SettleSession(cacheCtx, session); err != nil {
+			ctx.Logger().Error("failed to settle the session", "id", session.ID, "err", err.Error())
+			
+			// the session is closed unbilled, instead of being retried every block
+			if session.Status == types.StatusActive {
+				k.AbortSession(ctx, session)
+			}
+			
+			continue
+		}
+		
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+	
+	k.DeleteActiveSessionIDs(ctx, _height)
+	
+	_height = ctx.BlockHeight() - k.NodeInactiveInterval(ctx)
+	
+	ids = k.GetActiveNodeIDs(ctx, _height)
+	for _, id := range ids {
+		node, found := k.GetNode(ctx, id.(hub.NodeID))
+		if !found || node.Status != types.StatusRegistered || node.StatusModifiedAt > _height {
+			continue
+		}
+		
+		node.Status = types.StatusInactive
+		node.StatusModifiedAt = ctx.BlockHeight()
+		k.SetNode(ctx, node)
+		
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeInactivateNode,
+				sdk.NewAttribute(types.AttributeKeyNodeID, node.ID.String()),
+				sdk.NewAttribute(types.AttributeKeyStatus, node.Status),
+			),
+		)
+	}
+	
+	k.DeleteActiveNodeIDs(ctx, _height)
+	
+	var expired []hub.SubscriptionID
+	k.IterateExpiredSubscriptionIDs(ctx, ctx.BlockTime(), func(_ int64, id hub.SubscriptionID) bool {
+		expired = append(expired, id)
+		return false
+	})
+	
+	for _, id := range expired {
+		subscription, found := k.GetSubscription(ctx, id)
+		if !found {
+			continue
+		}
+		
+		expireOrAbortSubscription(ctx, k, subscription)
+	}
+	
+	var unbondedNodes []hub.NodeID
+	k.IterateUnbondedNodeIDs(ctx, ctx.BlockTime(), func(_ int64, id hub.NodeID) bool {
+		unbondedNodes = append(unbondedNodes, id)
+		return false
+	})
+	
+	for _, id := range unbondedNodes {
+		node, found := k.GetNode(ctx, id)
+		if !found {
+			continue
+		}
+		
+		cacheCtx, write := ctx.CacheContext()
+		if err := completeNodeUnbonding(cacheCtx, k, node); err != nil {
+			ctx.Logger().Error("failed to complete the node unbonding", "id", node.ID, "err", err.Error())
+			abortNodeUnbonding(ctx, k, node)
+			continue
+		}
+		
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+	
+	var unbondedResolvers []hub.ResolverID
+	k.IterateUnbondedResolverIDs(ctx, ctx.BlockTime(), func(_ int64, id hub.ResolverID) bool {
+		unbondedResolvers = append(unbondedResolvers, id)
+		return false
+	})
+	
+	for _, id := range unbondedResolvers {
+		resolver, found := k.GetResolver(ctx, id)
+		if !found {
+			continue
+		}
+		
+		cacheCtx, write := ctx.CacheContext()
+		if err := completeResolverUnbonding(cacheCtx, k, resolver); err != nil {
+			ctx.Logger().Error("failed to complete the resolver unbonding", "id", resolver.ID, "err", err.Error())
+			abortResolverUnbonding(ctx, k, resolver)
+			continue
+		}
+		
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
 
-					if err := k.SendDeposit(ctx, subscription.Client, node.Owner, payCoin.Sub(commission)); err != nil {
-						panic(err)
-					}
-				}
+// completeResolverUnbonding releases the self-stake of the resolver
+func completeResolverUnbonding(ctx sdk.Context, k keeper.Keeper, resolver types.Resolver) sdk.Error {
+	if resolver.Deposit.IsPositive() {
+		if err := k.UnlockDeposit(ctx, resolver.Owner, resolver.ID, resolver.Deposit); err != nil {
+			return err
+		}
+	}
+	
+	k.RemoveResolverIDFromUnbondingQueue(ctx, resolver.UnbondingCompletionTime, resolver.ID)
+	resolver.Status = types.StatusDeRegistered
+	resolver.StatusModifiedAt = ctx.BlockHeight()
+	k.SetResolver(ctx, resolver)
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCompleteResolverUnbonding,
+			sdk.NewAttribute(types.AttributeKeyResolverID, resolver.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyDeposit, resolver.Deposit.String()),
+			sdk.NewAttribute(types.AttributeKeyStatus, resolver.Status),
+		),
+	)
+	
+	return nil
+}
 
-				if commission.IsZero() {
-					if err := k.SendDeposit(ctx, subscription.Client, node.Owner, payCoin); err != nil {
-						panic(err)
-					}
-				}
-			}
+// completeNodeUnbonding settles the subscriptions still active on the node and releases the remaining deposit
+// of the node. The deposit may be slashed until the unbonding completes.
+func completeNodeUnbonding(ctx sdk.Context, k keeper.Keeper, node types.Node) sdk.Error {
+	for _, subscription := range k.GetSubscriptionsOfNode(ctx, node.ID) {
+		if subscription.Status != types.StatusActive {
+			continue
 		}
+		
+		expireOrAbortSubscription(ctx, k, subscription)
+	}
+	
+	if node.Deposit.IsPositive() {
+		if err := k.UnlockDeposit(ctx, node.Owner, node.ID, node.Deposit); err != nil {
+			return err
+		}
+	}
+	
+	k.RemoveNodeIDFromUnbondingQueue(ctx, node.UnbondingCompletionTime, node.ID)
+	node.Status = types.StatusDeRegistered
+	node.StatusModifiedAt = ctx.BlockHeight()
+	k.SetNode(ctx, node)
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCompleteNodeUnbonding,
+			sdk.NewAttribute(types.AttributeKeyNodeID, node.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyDeposit, node.Deposit.String()),
+			sdk.NewAttribute(types.AttributeKeyStatus, node.Status),
+		),
+	)
+	
+	return nil
+}
 
-		session.Status = types.StatusInactive
-		session.StatusModifiedAt = height
-		k.SetSession(ctx, session)
+// abortResolverUnbonding completes the unbonding of a resolver whose self-stake failed to be released, by
+// releasing whatever is still locked by the resolver, so that it does not stay in the unbonding queue
+func abortResolverUnbonding(ctx sdk.Context, k keeper.Keeper, resolver types.Resolver) {
+	released := k.ReleaseDeposit(ctx, resolver.Owner, resolver.ID)
+	
+	k.RemoveResolverIDFromUnbondingQueue(ctx, resolver.UnbondingCompletionTime, resolver.ID)
+	resolver.Status = types.StatusDeRegistered
+	resolver.StatusModifiedAt = ctx.BlockHeight()
+	k.SetResolver(ctx, resolver)
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCompleteResolverUnbonding,
+			sdk.NewAttribute(types.AttributeKeyResolverID, resolver.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyDeposit, released.String()),
+			sdk.NewAttribute(types.AttributeKeyStatus, resolver.Status),
+		),
+	)
+}
 
-		subscription.RemainingDeposit.Amount = subscription.RemainingDeposit.Amount.Sub(pay)
-		subscription.RemainingBandwidth = subscription.RemainingBandwidth.Sub(bandwidth)
-		k.SetSubscription(ctx, subscription)
+// abortNodeUnbonding completes the unbonding of a node whose deposit failed to be released, by releasing whatever
+// is still locked by the node, so that it does not stay in the unbonding queue
+func abortNodeUnbonding(ctx sdk.Context, k keeper.Keeper, node types.Node) {
+	for _, subscription := range k.GetSubscriptionsOfNode(ctx, node.ID) {
+		if subscription.Status != types.StatusActive {
+			continue
+		}
+		
+		expireOrAbortSubscription(ctx, k, subscription)
+	}
+	
+	released := k.ReleaseDeposit(ctx, node.Owner, node.ID)
+	
+	k.RemoveNodeIDFromUnbondingQueue(ctx, node.UnbondingCompletionTime, node.ID)
+	node.Status = types.StatusDeRegistered
+	node.StatusModifiedAt = ctx.BlockHeight()
+	k.SetNode(ctx, node)
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCompleteNodeUnbonding,
+			sdk.NewAttribute(types.AttributeKeyNodeID, node.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyDeposit, released.String()),
+			sdk.NewAttribute(types.AttributeKeyStatus, node.Status),
+		),
+	)
+}
 
-		scs := k.GetSessionsCountOfSubscription(ctx, subscription.ID)
-		k.SetSessionsCountOfSubscription(ctx, subscription.ID, scs+1)
+// expireSubscription settles the sessions in progress of the subscription, if any, before settling the subscription
+func expireSubscription(ctx sdk.Context, k keeper.Keeper, subscription types.Subscription) sdk.Error {
+	for _, session := range k.GetActiveSessionsOfSubscription(ctx, subscription.ID) {
+		if _, err := k.SettleSession(ctx, session); err != nil {
+			return err
+		}
+	}
+	
+	// the subscription is settled along with its last session if its free service was revoked
+	subscription, _ = k.GetSubscription(ctx, subscription.ID)
+	if subscription.Status != types.StatusActive {
+		return nil
 	}
+	
+	return k.SettleSubscription(ctx, subscription)
+}
 
-	k.DeleteActiveSessionIDs(ctx, _height)
+// expireOrAbortSubscription expires the subscription, or aborts it when it fails to expire, so that it does not
+// stay in the expiry queue with its deposit locked
+func expireOrAbortSubscription(ctx sdk.Context, k keeper.Keeper, subscription types.Subscription) {
+	cacheCtx, write := ctx.CacheContext()
+	if err := expireSubscription(cacheCtx, k, subscription); err != nil {
+		ctx.Logger().Error("failed to expire the subscription", "id", subscription.ID, "err", err.Error())
+		k.AbortSubscription(ctx, subscription)
+		return
+	}
+	
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
 }
 
 func handleRegisterNode(ctx sdk.Context, k keeper.Keeper, msg types.MsgRegisterNode) sdk.Result {
+	if k.GetParams(ctx).ExceedsMaxPricesPerGB(msg.PricesPerGB) {
+		return types.ErrorPricesExceedMax().Result()
+	}
+	
 	nc := k.GetNodesCount(ctx)
 	node := types.Node{
 		ID:               hub.NewNodeID(nc),
@@ -133,13 +352,14 @@ func handleRegisterNode(ctx sdk.Context, k keeper.Keeper, msg types.MsgRegisterN
 	if nca >= k.FreeNodesCount(ctx) {
 		node.Deposit = k.Deposit(ctx)
 
-		if err := k.AddDeposit(ctx, node.Owner, node.Deposit); err != nil {
+		if err := k.LockDeposit(ctx, node.Owner, node.ID, node.Deposit); err != nil {
 			return err.Result()
 		}
 	}
 
 	k.SetNode(ctx, node)
 	k.SetNodeIDByAddress(ctx, node.Owner, nca, node.ID)
+	k.AddNodeIDToActiveList(ctx, node.StatusModifiedAt, node.ID)
 
 	k.SetNodesCount(ctx, nc+1)
 	k.SetNodesCountOfAddress(ctx, node.Owner, nca+1)
@@ -164,17 +384,21 @@ func handleUpdateNodeInfo(ctx sdk.Context, k keeper.Keeper, msg types.MsgUpdateN
 	if !msg.From.Equals(node.Owner) {
 		return types.ErrorUnauthorized().Result()
 	}
-	if node.Status == types.StatusDeRegistered {
+	if node.Status == types.StatusDeRegistered || node.Status == types.StatusUnbonding {
 		return types.ErrorInvalidNodeStatus().Result()
 	}
+	if k.GetParams(ctx).ExceedsMaxPricesPerGB(msg.PricesPerGB) {
+		return types.ErrorPricesExceedMax().Result()
+	}
 
 	_node := types.Node{
-		Type:          msg.T,
-		Version:       msg.Version,
-		Moniker:       msg.Moniker,
-		PricesPerGB:   msg.PricesPerGB,
-		InternetSpeed: msg.InternetSpeed,
-		Encryption:    msg.Encryption,
+		Type:                  msg.T,
+		Version:               msg.Version,
+		Moniker:               msg.Moniker,
+		PricesPerGB:           msg.PricesPerGB,
+		InternetSpeed:         msg.InternetSpeed,
+		Encryption:            msg.Encryption,
+		MaxConcurrentSessions: msg.MaxConcurrentSessions,
 	}
 	node = node.UpdateInfo(_node)
 
@@ -187,6 +411,33 @@ func handleUpdateNodeInfo(ctx sdk.Context, k keeper.Keeper, msg types.MsgUpdateN
 			sdk.NewAttribute(AttributeKeyFromAddress, msg.From.String()),
 		),
 	)
+	
+	return sdk.Result{Events: ctx.EventManager().Events(),
+		Data: types.ModuleCdc.MustMarshalJSON(node)}
+}
+
+func handleUpdateNodePlans(ctx sdk.Context, k keeper.Keeper, msg types.MsgUpdateNodePlans) sdk.Result {
+	node, found := k.GetNode(ctx, msg.ID)
+	if !found {
+		return types.ErrorNodeDoesNotExist().Result()
+	}
+	if !msg.From.Equals(node.Owner) {
+		return types.ErrorUnauthorized().Result()
+	}
+	if node.Status == types.StatusDeRegistered || node.Status == types.StatusUnbonding {
+		return types.ErrorInvalidNodeStatus().Result()
+	}
+	
+	node.Plans = msg.Plans
+	k.SetNode(ctx, node)
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMsgUpdateNodePlans,
+			sdk.NewAttribute(types.AttributeKeyNodeID, msg.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyFromAddress, msg.From.String()),
+		),
+	)
 
 	return sdk.Result{Events: ctx.EventManager().Events(),
 		Data: types.ModuleCdc.MustMarshalJSON(node)}
@@ -200,17 +451,14 @@ func handleAddFreeClient(ctx sdk.Context, k keeper.Keeper, msg types.MsgAddFreeC
 	if !msg.From.Equals(node.Owner) {
 		return types.ErrorUnauthorized().Result()
 	}
-	if node.Status == types.StatusDeRegistered {
+	if node.Status == types.StatusDeRegistered || node.Status == types.StatusUnbonding {
 		return types.ErrorInvalidNodeStatus().Result()
 	}
-
-	k.SetFreeNodeOfClient(ctx, msg.Client, msg.NodeID)
-	k.SetFreeClientOfNode(ctx, msg.NodeID, msg.Client)
-
-	freeClient := types.FreeClient{
-		NodeID: msg.NodeID,
-		Client: msg.Client,
+	if msg.ExpiresAt != 0 && msg.ExpiresAt <= ctx.BlockHeight() {
+		return types.ErrorInvalidField("expires_at").Result()
 	}
+
+	freeClient := types.NewFreeClient(msg.NodeID, msg.Client, msg.Bandwidth, msg.ExpiresAt, msg.MaxSubscriptions)
 	k.SetFreeClient(ctx, freeClient)
 
 	ctx.EventManager().EmitEvent(
@@ -236,13 +484,22 @@ func handleRemoveFreeClient(ctx sdk.Context, k keeper.Keeper, msg types.MsgRemov
 		return types.ErrorInvalidNodeStatus().Result()
 	}
 
-	_, found = k.GetFreeClientOfNode(ctx, msg.NodeID, msg.Client)
+	_, found = k.GetFreeClient(ctx, msg.NodeID, msg.Client)
 	if !found {
 		return types.ErrorFreeClientDoesNotExist().Result()
 	}
 
-	k.RemoveFreeClientOfNode(ctx, msg.NodeID, msg.Client)
-	k.RemoveFreeClient(ctx, msg.NodeID)
+	k.RemoveFreeClient(ctx, msg.NodeID, msg.Client)
+	
+	// the subscriptions of the client which were not locked are no longer covered by the grant
+	for _, subscription := range k.GetSubscriptionsOfAddress(ctx, msg.Client) {
+		if subscription.Status != types.StatusActive || !subscription.NodeID.IsEqual(msg.NodeID) ||
+			!k.IsFreeServiceRevoked(ctx, subscription) {
+			continue
+		}
+		
+		expireOrAbortSubscription(ctx, k, subscription)
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -250,11 +507,13 @@ func handleRemoveFreeClient(ctx sdk.Context, k keeper.Keeper, msg types.MsgRemov
 			sdk.NewAttribute(AttributeKeyNodeID, msg.NodeID.String()),
 			sdk.NewAttribute(AttributeKeyClientAddress, msg.Client.String()),
 			sdk.NewAttribute(AttributeKeyFromAddress, msg.From.String()),
-		), )
+		))
 
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
 
+// handleRegisterVPNOnResolver requests a listing of the node on the resolver. The node is listed only after
+// the resolver owner accepts the request.
 func handleRegisterVPNOnResolver(ctx sdk.Context, k keeper.Keeper, msg types.MsgRegisterVPNOnResolver) sdk.Result {
 	node, found := k.GetNode(ctx, msg.NodeID)
 	if !found {
@@ -263,20 +522,32 @@ func handleRegisterVPNOnResolver(ctx sdk.Context, k keeper.Keeper, msg types.Msg
 	if !msg.From.Equals(node.Owner) {
 		return types.ErrorUnauthorized().Result()
 	}
-	if node.Status == types.StatusDeRegistered {
+	if node.Status == types.StatusDeRegistered || node.Status == types.StatusUnbonding {
 		return types.ErrorInvalidNodeStatus().Result()
 	}
+	if node.Blacklisted {
+		return types.ErrorNodeBlacklisted().Result()
+	}
 
 	resolver, found := k.GetResolver(ctx, msg.ResolverID)
 	if !found {
 		return types.ErrorResolverDoesNotExist().Result()
 	}
-	if resolver.Status == types.StatusDeRegistered {
+	if resolver.Status == types.StatusDeRegistered || resolver.Status == types.StatusUnbonding {
 		return types.ErrorInvalidResolverStatus().Result()
 	}
+	if resolver.Blacklisted {
+		return types.ErrorResolverBlacklisted().Result()
+	}
 
-	k.SetResolverOfNode(ctx, node.ID, resolver.ID)
-	k.SetNodeOfResolver(ctx, resolver.ID, node.ID)
+	if _, found = k.GetResolverOfNode(ctx, node.ID, resolver.ID); found {
+		return types.ErrorListingAlreadyExists().Result()
+	}
+	if _, found = k.GetPendingNodeOfResolver(ctx, resolver.ID, node.ID); found {
+		return types.ErrorListingAlreadyExists().Result()
+	}
+	
+	k.SetPendingNodeOfResolver(ctx, resolver.ID, node.ID)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -290,24 +561,30 @@ func handleRegisterVPNOnResolver(ctx sdk.Context, k keeper.Keeper, msg types.Msg
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
 
+// handleDeregisterVPNOnResolver revokes a listing of the node on the resolver by either the node owner or
+// the resolver owner. The subscriptions in flight keep the commission they locked in at their start.
 func handleDeregisterVPNOnResolver(ctx sdk.Context, k keeper.Keeper, msg types.MsgDeregisterVPNOnResolver) sdk.Result {
 	node, found := k.GetNode(ctx, msg.NodeID)
 	if !found {
 		return types.ErrorNodeDoesNotExist().Result()
 	}
-	if !msg.From.Equals(node.Owner) {
+	
+	resolver, found := k.GetResolver(ctx, msg.ResolverID)
+	if !found {
+		return types.ErrorResolverDoesNotExist().Result()
+	}
+	if !msg.From.Equals(node.Owner) && !msg.From.Equals(resolver.Owner) {
 		return types.ErrorUnauthorized().Result()
 	}
-	if node.Status == types.StatusDeRegistered {
+	if msg.From.Equals(node.Owner) && node.Status == types.StatusDeRegistered {
 		return types.ErrorInvalidNodeStatus().Result()
 	}
 
-	resolver, found := k.GetResolverOfNode(ctx, msg.NodeID, msg.ResolverID)
-	if !found {
-		return types.ErrorResolverDoesNotExist().Result()
+	if _, found = k.GetResolverOfNode(ctx, msg.NodeID, msg.ResolverID); !found {
+		return types.ErrorListingDoesNotExist().Result()
 	}
 
-	k.RemoveVPNNodeOnResolver(ctx, msg.NodeID, resolver)
+	k.RemoveVPNNodeOnResolver(ctx, msg.NodeID, msg.ResolverID)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -329,18 +606,15 @@ func handleDeregisterNode(ctx sdk.Context, k keeper.Keeper, msg types.MsgDeregis
 	if !msg.From.Equals(node.Owner) {
 		return types.ErrorUnauthorized().Result()
 	}
-	if node.Status == types.StatusDeRegistered {
+	if node.Status != types.StatusRegistered && node.Status != types.StatusInactive {
 		return types.ErrorInvalidNodeStatus().Result()
 	}
 
-	if node.Deposit.IsPositive() {
-		if err := k.SubtractDeposit(ctx, node.Owner, node.Deposit); err != nil {
-			return err.Result()
-		}
-	}
-
-	node.Status = types.StatusDeRegistered
+	k.RemoveNodeIDFromActiveList(ctx, node.StatusModifiedAt, node.ID)
+	node.Status = types.StatusUnbonding
 	node.StatusModifiedAt = ctx.BlockHeight()
+	node.UnbondingCompletionTime = ctx.BlockTime().Add(k.NodeUnbondingPeriod(ctx))
+	k.AddNodeIDToUnbondingQueue(ctx, node.UnbondingCompletionTime, node.ID)
 
 	k.SetNode(ctx, node)
 
@@ -350,8 +624,87 @@ func handleDeregisterNode(ctx sdk.Context, k keeper.Keeper, msg types.MsgDeregis
 			sdk.NewAttribute(AttributeKeyFromAddress, msg.From.String()),
 			sdk.NewAttribute(AttributeKeyStatus, node.Status),
 			sdk.NewAttribute(AttributeKeyNodeID, msg.ID.String()),
-		), )
+		))
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleNodeHeartbeat(ctx sdk.Context, k keeper.Keeper, msg types.MsgNodeHeartbeat) sdk.Result {
+	node, found := k.GetNode(ctx, msg.ID)
+	if !found {
+		return types.ErrorNodeDoesNotExist().Result()
+	}
+	if !msg.From.Equals(node.Owner) {
+		return types.ErrorUnauthorized().Result()
+	}
+	if node.Status == types.StatusDeRegistered || node.Status == types.StatusUnbonding {
+		return types.ErrorInvalidNodeStatus().Result()
+	}
+	
+	k.RemoveNodeIDFromActiveList(ctx, node.StatusModifiedAt, node.ID)
+	k.AddNodeIDToActiveList(ctx, ctx.BlockHeight(), node.ID)
+	node.Status = types.StatusRegistered
+	node.StatusModifiedAt = ctx.BlockHeight()
+	
+	k.SetNode(ctx, node)
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMsgNodeHeartbeat,
+			sdk.NewAttribute(AttributeKeyFromAddress, msg.From.String()),
+			sdk.NewAttribute(AttributeKeyNodeID, node.ID.String()),
+			sdk.NewAttribute(AttributeKeyStatus, node.Status),
+		),
+	)
+	
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
 
+func handleTopUpNodeDeposit(ctx sdk.Context, k keeper.Keeper, msg types.MsgTopUpNodeDeposit) sdk.Result {
+	node, found := k.GetNode(ctx, msg.ID)
+	if !found {
+		return types.ErrorNodeDoesNotExist().Result()
+	}
+	if !msg.From.Equals(node.Owner) {
+		return types.ErrorUnauthorized().Result()
+	}
+	if node.Status == types.StatusDeRegistered || node.Status == types.StatusUnbonding {
+		return types.ErrorInvalidNodeStatus().Result()
+	}
+	if msg.Deposit.Denom != node.Deposit.Denom {
+		return types.ErrorInvalidDeposit().Result()
+	}
+	
+	if err := k.LockDeposit(ctx, node.Owner, node.ID, msg.Deposit); err != nil {
+		return err.Result()
+	}
+	
+	node.Deposit = node.Deposit.Add(msg.Deposit)
+	
+	// a jailed node is released once the amount slashed from its deposit is topped up, a node in a free slot
+	// has nothing to be slashed and is released by any top-up
+	if node.Jailed {
+		if node.Slashed == nil || msg.Deposit.IsGTE(*node.Slashed) {
+			node.Jailed = false
+			node.Slashed = nil
+		} else {
+			slashed := node.Slashed.Sub(msg.Deposit)
+			node.Slashed = &slashed
+		}
+	}
+	
+	k.SetNode(ctx, node)
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMsgTopUpNodeDeposit,
+			sdk.NewAttribute(AttributeKeyFromAddress, msg.From.String()),
+			sdk.NewAttribute(AttributeKeyNodeID, node.ID.String()),
+			sdk.NewAttribute(AttributeKeyDeposit, node.Deposit.String()),
+			sdk.NewAttribute(AttributeKeyJailed, strconv.FormatBool(node.Jailed)),
+		),
+	)
+	
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
 
@@ -364,40 +717,109 @@ func handleStartSubscription(ctx sdk.Context, k keeper.Keeper, msg types.MsgStar
 	if node.Status != types.StatusRegistered {
 		return types.ErrorInvalidNodeStatus().Result()
 	}
+	if node.Jailed {
+		return types.ErrorNodeJailed().Result()
+	}
+	if node.Blacklisted {
+		return types.ErrorNodeBlacklisted().Result()
+	}
 
 	_, found = k.GetResolverOfNode(ctx, msg.NodeID, msg.ResolverID)
 	if !found {
 		return types.ErrorResolverDoesNotExist().Result()
 	}
+	
+	resolver, _ := k.GetResolver(ctx, msg.ResolverID)
+	if resolver.Status != types.StatusRegistered {
+		return types.ErrorInvalidResolverStatus().Result()
+	}
+	if resolver.Blacklisted {
+		return types.ErrorResolverBlacklisted().Result()
+	}
 
-	freeClients := k.GetFreeClientsOfNode(ctx, msg.NodeID)
-	if !types.IsFreeClient(freeClients, msg.From) {
-		if err := k.AddDeposit(ctx, msg.From, msg.Deposit); err != nil {
+	params := k.GetParams(ctx)
+	plan, quoted, found := node.QuoteDeposit(msg.Plan, msg.Deposit, params.ExchangeRates)
+	if !found {
+		return types.ErrorPlanDoesNotExist().Result()
+	}
+	
+	// the deposit is worth its value in the denomination of the plan at the exchange rates in force
+	deposit, found := params.ExchangeRates.Value(msg.Deposit, plan.Price.Denom)
+	if !found || deposit.IsLT(plan.Price) {
+		return types.ErrorInvalidDeposit().Result()
+	}
+	if plan.IsPerGB() && params.ExceedsMaxPricesPerGB(sdk.Coins{plan.Price}) {
+		return types.ErrorPricesExceedMax().Result()
+	}
+	
+	var periods int64
+	if plan.IsTimeBased() {
+		var err sdk.Error
+		if periods, err = plan.Periods(deposit); err != nil {
 			return err.Result()
 		}
 	}
-
-	bandwidth, err := node.DepositToBandwidth(msg.Deposit)
-	if err != nil {
-		return err.Result()
+	
+	k.RevokeInactiveFreeClient(ctx, msg.NodeID, msg.From)
+	
+	freeClient, isFreeClient := k.GetActiveFreeClient(ctx, msg.NodeID, msg.From)
+	if isFreeClient && freeClient.MaxSubscriptions > 0 &&
+		k.GetActiveSubscriptionsCountOfClientOnNode(ctx, msg.From, msg.NodeID) >= freeClient.MaxSubscriptions {
+		return types.ErrorFreeClientLimitReached().Result()
 	}
-	pricePerGB := node.FindPricePerGB(msg.Deposit.Denom)
-
 	sc := k.GetSubscriptionsCount(ctx)
+	depositLocked := !(isFreeClient && freeClient.IsUnlimited())
+	if depositLocked {
+		if err := k.LockDepositCoins(ctx, msg.From, hub.NewSubscriptionID(sc), msg.Deposit); err != nil {
+			return err.Result()
+		}
+	}
+
 	subscription := types.Subscription{
 		ID:                 hub.NewSubscriptionID(sc),
 		ResolverID:         msg.ResolverID,
 		NodeID:             node.ID,
 		Client:             msg.From,
-		PricePerGB:         pricePerGB,
-		TotalDeposit:       msg.Deposit,
-		RemainingDeposit:   msg.Deposit,
-		RemainingBandwidth: bandwidth,
+		PricePerGB:         sdk.NewInt64Coin(deposit.Denom, 0),
+		Commission:         resolver.Commission,
+		Plan:               plan,
+		TotalDeposit:       deposit,
+		RemainingDeposit:   deposit,
+		RemainingBandwidth: hub.NewBandwidthFromInt64(0, 0),
 		Status:             types.StatusActive,
 		StatusModifiedAt:   ctx.BlockHeight(),
+		QuotedPrice:        quoted,
+		DepositLocked:      depositLocked,
+	}
+	if depositLocked {
+		subscription.Deposits = msg.Deposit
+	}
+	if len(msg.Deposit) > 1 {
+		subscription.ExchangeRates = params.ExchangeRates.Filter(msg.Deposit)
+	}
+	
+	switch {
+	case plan.IsTimeBased():
+		subscription.ExpiresAt = ctx.BlockTime().Add(time.Duration(periods) * plan.Period())
+	case plan.Type == types.PlanTypeFlatRate:
+		subscription.ExpiresAt = ctx.BlockTime().Add(plan.Period())
+		subscription.RemainingBandwidth = plan.Bandwidth
+	default:
+		bandwidth, err := plan.DepositToBandwidth(deposit)
+		if err != nil {
+			return err.Result()
+		}
+		
+		subscription.PricePerGB = plan.Price
+		subscription.RemainingBandwidth = bandwidth
 	}
+	
 	k.SetSubscription(ctx, subscription)
 	k.SetSubscriptionsCount(ctx, sc+1)
+	
+	if !subscription.ExpiresAt.IsZero() {
+		k.AddSubscriptionIDToExpiryQueue(ctx, subscription.ExpiresAt, subscription.ID)
+	}
 
 	nsc := k.GetSubscriptionsCountOfNode(ctx, node.ID)
 	k.SetSubscriptionIDByNodeID(ctx, node.ID, nsc, subscription.ID)
@@ -433,85 +855,178 @@ func handleEndSubscription(ctx sdk.Context, k keeper.Keeper, msg types.MsgEndSub
 		return types.ErrorInvalidSubscriptionStatus().Result()
 	}
 
-	scs := k.GetSessionsCountOfSubscription(ctx, subscription.ID)
-
-	_, found = k.GetSessionIDBySubscriptionID(ctx, subscription.ID, scs)
-	if found {
+	if len(k.GetActiveSessionsOfSubscription(ctx, subscription.ID)) > 0 {
 		return types.ErrorSessionAlreadyExists().Result()
 	}
 
-	freeClients := k.GetFreeClientsOfNode(ctx, subscription.NodeID)
-
-	if !types.IsFreeClient(freeClients, msg.From) && !subscription.RemainingDeposit.IsZero() {
-		if err := k.SubtractDeposit(ctx, subscription.Client, subscription.RemainingDeposit); err != nil {
-			return err.Result()
-		}
+	if err := k.SettleSubscription(ctx, subscription); err != nil {
+		return err.Result()
 	}
 
-	subscription.Status = types.StatusInactive
-	subscription.StatusModifiedAt = ctx.BlockHeight()
-
-	k.SetSubscription(ctx, subscription)
-
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			EventTypeMsgEndSubscription,
 			sdk.NewAttribute(AttributeSubscriptionID, subscription.ID.String()),
-			sdk.NewAttribute(AttributeKeyStatus, subscription.Status),
+			sdk.NewAttribute(AttributeKeyStatus, types.StatusInactive),
 		),
 	)
 
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
 
-func handleUpdateSessionInfo(ctx sdk.Context, k keeper.Keeper, msg types.MsgUpdateSessionInfo) sdk.Result {
+// handleStartSession opens a new session of the subscription, up to the maximum concurrent sessions of its plan.
+// The ID of the session is returned, both the node owner and the client sign the bandwidth of the session against it.
+func handleStartSession(ctx sdk.Context, k keeper.Keeper, msg types.MsgStartSession) sdk.Result {
 	subscription, found := k.GetSubscription(ctx, msg.SubscriptionID)
 	if !found {
 		return types.ErrorSubscriptionDoesNotExist().Result()
 	}
-	if subscription.Status == types.StatusInactive {
+	if !msg.From.Equals(subscription.Client) {
+		return types.ErrorUnauthorized().Result()
+	}
+	if subscription.Status == types.StatusInactive || subscription.IsExpired(ctx.BlockTime()) {
 		return types.ErrorInvalidSubscriptionStatus().Result()
 	}
-	if !bytes.Equal(msg.ClientSignature.PubKey.Address(), subscription.Client.Bytes()) {
-		return types.ErrorUnauthorized().Result()
+	if k.IsFreeServiceRevoked(ctx, subscription) {
+		return types.ErrorFreeClientDoesNotExist().Result()
 	}
+	
+	node, found := k.GetNode(ctx, subscription.NodeID)
+	if !found {
+		return types.ErrorNodeDoesNotExist().Result()
+	}
+	if node.Status != types.StatusRegistered {
+		return types.ErrorInvalidNodeStatus().Result()
+	}
+	if node.Jailed {
+		return types.ErrorNodeJailed().Result()
+	}
+	if node.Blacklisted {
+		return types.ErrorNodeBlacklisted().Result()
+	}
+	
+	active := k.GetActiveSessionsOfSubscription(ctx, subscription.ID)
+	if uint64(len(active)) >= subscription.Plan.ConcurrentSessions() {
+		return types.ErrorSessionsLimitReached().Result()
+	}
+	
+	sc := k.GetSessionsCount(ctx)
+	session := types.Session{
+		ID:               hub.NewSessionID(sc),
+		SubscriptionID:   subscription.ID,
+		Bandwidth:        hub.NewBandwidthFromInt64(0, 0),
+		Status:           types.StatusActive,
+		StatusModifiedAt: ctx.BlockHeight(),
+	}
+	
+	k.SetSession(ctx, session)
+	k.SetSessionsCount(ctx, sc+1)
+	k.AddSessionIDToActiveList(ctx, ctx.BlockHeight(), session.ID)
+	
+	scs := k.GetSessionsCountOfSubscription(ctx, subscription.ID)
+	k.SetSessionIDBySubscriptionID(ctx, subscription.ID, scs, session.ID)
+	k.SetSessionsCountOfSubscription(ctx, subscription.ID, scs+1)
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMsgStartSession,
+			sdk.NewAttribute(AttributeSubscriptionID, session.SubscriptionID.String()),
+			sdk.NewAttribute(AttributeSessionID, session.ID.String()),
+			sdk.NewAttribute(AttributeKeyFromAddress, msg.From.String()),
+		),
+	)
+	return sdk.Result{Events: ctx.EventManager().Events(), Data: types.ModuleCdc.MustMarshalJSON(session.ID)}
+}
 
-	node, _ := k.GetNode(ctx, subscription.NodeID)
-	if !bytes.Equal(msg.NodeOwnerSignature.PubKey.Address(), node.Owner.Bytes()) {
-		return types.ErrorUnauthorized().Result()
+func handleUpdateSessionInfo(ctx sdk.Context, k keeper.Keeper, msg types.MsgUpdateSessionInfo) sdk.Result {
+	update := types.NewSessionUpdate(msg.SessionID, msg.Bandwidth, msg.NodeOwnerSignature, msg.ClientSignature)
+	
+	session, err := updateSessionInfo(ctx, k, msg.From, update)
+	if err != nil {
+		return err.Result()
 	}
+	
+	return sdk.Result{Events: ctx.EventManager().Events(), Data: types.ModuleCdc.MustMarshalJSON(session.Bandwidth)}
+}
 
-	scs := k.GetSessionsCountOfSubscription(ctx, subscription.ID)
-	data := hub.NewBandwidthSignatureData(subscription.ID, scs, msg.Bandwidth).Bytes()
-	if !msg.NodeOwnerSignature.VerifyBytes(data, msg.NodeOwnerSignature.Signature) {
-		return types.ErrorInvalidBandwidthSignature().Result()
+// handleBatchUpdateSessions applies every update of the batch on its own, so an invalid update does not fail
+// the others. The result of each update is returned in the order of the updates.
+func handleBatchUpdateSessions(ctx sdk.Context, k keeper.Keeper, msg types.MsgBatchUpdateSessions) sdk.Result {
+	results := make([]types.SessionUpdateResult, 0, len(msg.Updates))
+	for _, update := range msg.Updates {
+		result := types.SessionUpdateResult{
+			SessionID: update.SessionID,
+			Code:      sdk.CodeOK,
+		}
+		
+		cacheCtx, write := ctx.CacheContext()
+		if _, err := updateSessionInfo(cacheCtx, k, msg.From, update); err != nil {
+			result.Codespace = err.Codespace()
+			result.Code = err.Code()
+			result.Log = err.Result().Log
+		} else {
+			write()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		}
+		
+		results = append(results, result)
 	}
-	if !msg.ClientSignature.VerifyBytes(data, msg.ClientSignature.Signature) {
-		return types.ErrorInvalidBandwidthSignature().Result()
+	
+	return sdk.Result{Events: ctx.EventManager().Events(), Data: types.ModuleCdc.MustMarshalJSON(results)}
+}
+
+// updateSessionInfo updates the bandwidth of an active session. The concurrent sessions of a subscription
+// share its remaining bandwidth, so the bandwidths of all the active sessions together can not exceed it.
+func updateSessionInfo(ctx sdk.Context, k keeper.Keeper,
+	from sdk.AccAddress, update types.SessionUpdate) (types.Session, sdk.Error) {
+	session, found := k.GetSession(ctx, update.SessionID)
+	if !found {
+		return session, types.ErrorSessionDoesNotExist()
 	}
-	if subscription.RemainingBandwidth.AnyLT(msg.Bandwidth) {
-		return types.ErrorInvalidBandwidth().Result()
+	if session.Status != types.StatusActive {
+		return session, types.ErrorInvalidSessionStatus()
 	}
-	var session types.Session
-
-	id, found := k.GetSessionIDBySubscriptionID(ctx, subscription.ID, scs)
+	
+	subscription, found := k.GetSubscription(ctx, session.SubscriptionID)
 	if !found {
-		sc := k.GetSessionsCount(ctx)
-		session = types.Session{
-			ID:             hub.NewSessionID(sc),
-			SubscriptionID: subscription.ID,
-			Bandwidth:      hub.NewBandwidthFromInt64(0, 0),
+		return session, types.ErrorSubscriptionDoesNotExist()
+	}
+	if subscription.Status == types.StatusInactive || subscription.IsExpired(ctx.BlockTime()) {
+		return session, types.ErrorInvalidSubscriptionStatus()
+	}
+	if !bytes.Equal(update.ClientSignature.PubKey.Address(), subscription.Client.Bytes()) {
+		return session, types.ErrorUnauthorized()
+	}
+
+	node, _ := k.GetNode(ctx, subscription.NodeID)
+	if !bytes.Equal(update.NodeOwnerSignature.PubKey.Address(), node.Owner.Bytes()) {
+		return session, types.ErrorUnauthorized()
+	}
+
+	data := hub.NewBandwidthSignatureData(subscription.ID, session.ID, update.Bandwidth).Bytes()
+	if !update.NodeOwnerSignature.VerifyBytes(data, update.NodeOwnerSignature.Signature) {
+		return session, types.ErrorInvalidBandwidthSignature()
+	}
+	if !update.ClientSignature.VerifyBytes(data, update.ClientSignature.Signature) {
+		return session, types.ErrorInvalidBandwidthSignature()
+	}
+	
+	if !subscription.Plan.IsTimeBased() {
+		bandwidth := update.Bandwidth
+		for _, _session := range k.GetActiveSessionsOfSubscription(ctx, subscription.ID) {
+			if !_session.ID.IsEqual(session.ID) {
+				bandwidth = bandwidth.Add(_session.Bandwidth)
+			}
+		}
+		
+		if subscription.RemainingBandwidth.AnyLT(bandwidth) {
+			return session, types.ErrorInvalidBandwidth()
 		}
-		k.SetSessionsCount(ctx, sc+1)
-		k.SetSessionIDBySubscriptionID(ctx, subscription.ID, scs, session.ID)
-	} else {
-		session, _ = k.GetSession(ctx, id)
 	}
 
 	k.RemoveSessionIDFromActiveList(ctx, session.StatusModifiedAt, session.ID)
 	k.AddSessionIDToActiveList(ctx, ctx.BlockHeight(), session.ID)
-	session.Bandwidth = msg.Bandwidth
-	session.Status = types.StatusActive
+	session.Bandwidth = update.Bandwidth
 	session.StatusModifiedAt = ctx.BlockHeight()
 
 	k.SetSession(ctx, session)
@@ -521,109 +1036,279 @@ func handleUpdateSessionInfo(ctx sdk.Context, k keeper.Keeper, msg types.MsgUpda
 			EventTypeMsgUpdateSessionInfo,
 			sdk.NewAttribute(AttributeSubscriptionID, session.SubscriptionID.String()),
 			sdk.NewAttribute(AttributeSessionID, session.ID.String()),
-			sdk.NewAttribute(AttributeKeyFromAddress, msg.From.String()),
+			sdk.NewAttribute(AttributeKeyFromAddress, from.String()),
 		),
 	)
-	return sdk.Result{Events: ctx.EventManager().Events(), Data: types.ModuleCdc.MustMarshalJSON(session.Bandwidth)}
+	return session, nil
 }
 
 func handleEndSession(ctx sdk.Context, k keeper.Keeper, msg types.MsgEndSession) sdk.Result {
-	subscription, found := k.GetSubscription(ctx, msg.SubscriptionID)
+	session, found := k.GetSession(ctx, msg.SessionID)
+	if !found {
+		return types.ErrorSessionDoesNotExist().Result()
+	}
+	if session.Status != types.StatusActive {
+		return types.ErrorInvalidSessionStatus().Result()
+	}
+	
+	subscription, found := k.GetSubscription(ctx, session.SubscriptionID)
 	if !found {
 		return types.ErrorSubscriptionDoesNotExist().Result()
 	}
 	if subscription.Status == types.StatusInactive {
 		return types.ErrorInvalidSubscriptionStatus().Result()
 	}
-
+	
 	node, _ := k.GetNode(ctx, subscription.NodeID)
 	if !msg.From.Equals(node.Owner) {
 		return types.ErrorUnauthorized().Result()
 	}
+	
+	settlement, err := k.SettleSession(ctx, session)
+	if err != nil {
+		return err.Result()
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMsgEndSession,
+			sdk.NewAttribute(types.AttributeSubscriptionID, session.SubscriptionID.String()),
+			sdk.NewAttribute(types.AttributeSessionID, session.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyFromAddress, msg.From.String()),
+		),
+	)
+	
+	return sdk.Result{Events: ctx.EventManager().Events(), Data: types.ModuleCdc.MustMarshalJSON(settlement)}
+}
 
-	scs := k.GetSessionsCountOfSubscription(ctx, subscription.ID)
-
-	id, found := k.GetSessionIDBySubscriptionID(ctx, subscription.ID, scs)
+// handleSubmitEvidence slashes the node of the subscription when the evidence proves that the node owner
+// signed conflicting bandwidths. Only the client or the resolver of the subscription can submit the evidence.
+func handleSubmitEvidence(ctx sdk.Context, k keeper.Keeper, msg types.MsgSubmitEvidence) sdk.Result {
+	subscription, found := k.GetSubscription(ctx, msg.Evidence.SubscriptionID)
 	if !found {
-		return types.ErrorInvalidSessionStatus().Result()
+		return types.ErrorSubscriptionDoesNotExist().Result()
 	}
+	
+	node, found := k.GetNode(ctx, subscription.NodeID)
+	if !found {
+		return types.ErrorNodeDoesNotExist().Result()
+	}
+	if node.Status == types.StatusDeRegistered {
+		return types.ErrorInvalidNodeStatus().Result()
+	}
+	
+	resolver, _ := k.GetResolver(ctx, subscription.ResolverID)
+	if !msg.From.Equals(subscription.Client) && !msg.From.Equals(resolver.Owner) {
+		return types.ErrorUnauthorized().Result()
+	}
+	
+	session, found := k.GetSession(ctx, msg.Evidence.SessionID)
+	if !found || !session.SubscriptionID.IsEqual(subscription.ID) {
+		return types.ErrorSessionDoesNotExist().Result()
+	}
+	if _, found = k.GetEvidence(ctx, session.ID); found {
+		return types.ErrorDuplicateEvidence().Result()
+	}
+	if !msg.Evidence.Verify(node.Owner.Bytes()) || !msg.Evidence.IsConflicting(session.Bandwidth) {
+		return types.ErrorInvalidEvidence().Result()
+	}
+	
+	k.SetEvidence(ctx, msg.Evidence)
+	
+	amount, err := k.SlashNode(ctx, node, msg.From)
+	if err != nil {
+		return err.Result()
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMsgSubmitEvidence,
+			sdk.NewAttribute(AttributeKeyFromAddress, msg.From.String()),
+			sdk.NewAttribute(AttributeSubscriptionID, subscription.ID.String()),
+			sdk.NewAttribute(AttributeKeyNodeID, node.ID.String()),
+			sdk.NewAttribute(AttributeKeyAmount, amount.String()),
+		),
+	)
+	
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
 
-	session, _ := k.GetSession(ctx, id)
-
-	session.Status = types.StatusInactive
-	session.StatusModifiedAt = ctx.BlockHeight()
-
-	freeClients := k.GetFreeClientsOfNode(ctx, subscription.NodeID)
-
-	bandwidth := session.Bandwidth.CeilTo(hub.GB.Quo(subscription.PricePerGB.Amount))
-
-	pay := sdk.NewInt(0)
-	if !types.IsFreeClient(freeClients, subscription.Client) {
-		amount := bandwidth.Sum().Mul(subscription.PricePerGB.Amount).Quo(hub.GB)
-		payCoin := sdk.NewCoin(subscription.PricePerGB.Denom, amount)
-
-		pay = payCoin.Amount
-		if !pay.IsZero() {
-			node, _ := k.GetNode(ctx, subscription.NodeID)
-
-			_resolver, found := k.GetResolver(ctx, subscription.ResolverID)
-			if !found {
-				panic("no resolver found")
-			}
-
-			commission := _resolver.GetCommission(payCoin)
-
-			if commission.IsPositive() {
-				if err := k.SendDeposit(ctx, subscription.Client, _resolver.Owner, commission); err != nil {
-					panic(err)
-				}
+// handleOpenChannel locks the deposit of the client in a new payment channel to the node until the timeout.
+// The ID of the channel is returned, the client signs the cumulative vouchers of the channel against it.
+func handleOpenChannel(ctx sdk.Context, k keeper.Keeper, msg types.MsgOpenChannel) sdk.Result {
+	node, found := k.GetNode(ctx, msg.NodeID)
+	if !found {
+		return types.ErrorNodeDoesNotExist().Result()
+	}
+	if node.Status != types.StatusRegistered {
+		return types.ErrorInvalidNodeStatus().Result()
+	}
+	if node.Jailed {
+		return types.ErrorNodeJailed().Result()
+	}
+	if node.Blacklisted {
+		return types.ErrorNodeBlacklisted().Result()
+	}
+	
+	cc := k.GetChannelsCount(ctx)
+	if err := k.LockDeposit(ctx, msg.From, hub.NewChannelID(cc), msg.Deposit); err != nil {
+		return err.Result()
+	}
+	
+	channel := types.Channel{
+		ID:               hub.NewChannelID(cc),
+		NodeID:           node.ID,
+		Client:           msg.From,
+		Deposit:          msg.Deposit,
+		Redeemed:         sdk.NewInt64Coin(msg.Deposit.Denom, 0),
+		ExpiresAt:        ctx.BlockTime().Add(msg.Timeout),
+		Status:           types.StatusActive,
+		StatusModifiedAt: ctx.BlockHeight(),
+	}
+	
+	k.AddChannel(ctx, channel)
+	k.SetChannelsCount(ctx, cc+1)
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMsgOpenChannel,
+			sdk.NewAttribute(AttributeKeyChannelID, channel.ID.String()),
+			sdk.NewAttribute(AttributeKeyNodeID, channel.NodeID.String()),
+			sdk.NewAttribute(AttributeKeyFromAddress, msg.From.String()),
+			sdk.NewAttribute(AttributeKeyDeposit, msg.Deposit.String()),
+		),
+	)
+	return sdk.Result{Events: ctx.EventManager().Events(), Data: types.ModuleCdc.MustMarshalJSON(channel.ID)}
+}
 
-				if err := k.SendDeposit(ctx, subscription.Client, node.Owner, payCoin.Sub(commission)); err != nil {
-					panic(err)
-				}
-			}
+// handleRedeemVoucher pays the node owner the difference between the cumulative amount of the voucher
+// and the amount already redeemed from the channel. A voucher can be redeemed until the channel is closed.
+func handleRedeemVoucher(ctx sdk.Context, k keeper.Keeper, msg types.MsgRedeemVoucher) sdk.Result {
+	channel, found := k.GetChannel(ctx, msg.ChannelID)
+	if !found {
+		return types.ErrorChannelDoesNotExist().Result()
+	}
+	if channel.Status != types.StatusActive {
+		return types.ErrorInvalidChannelStatus().Result()
+	}
+	
+	node, _ := k.GetNode(ctx, channel.NodeID)
+	if !msg.From.Equals(node.Owner) {
+		return types.ErrorUnauthorized().Result()
+	}
+	if !bytes.Equal(msg.ClientSignature.PubKey.Address(), channel.Client.Bytes()) {
+		return types.ErrorUnauthorized().Result()
+	}
+	
+	data := hub.NewVoucherSignatureData(channel.ID, msg.Amount).Bytes()
+	if !msg.ClientSignature.VerifyBytes(data, msg.ClientSignature.Signature) {
+		return types.ErrorInvalidVoucher().Result()
+	}
+	if msg.Amount.Denom != channel.Deposit.Denom ||
+		!channel.Redeemed.IsLT(msg.Amount) || channel.Deposit.IsLT(msg.Amount) {
+		return types.ErrorInvalidVoucher().Result()
+	}
+	
+	amount := msg.Amount.Sub(channel.Redeemed)
+	if err := k.SendLockedDeposit(ctx, channel.Client, channel.ID, node.Owner, amount, deposit.ReasonSettlement); err != nil {
+		return err.Result()
+	}
+	
+	channel.Redeemed = msg.Amount
+	k.SetChannel(ctx, channel)
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMsgRedeemVoucher,
+			sdk.NewAttribute(AttributeKeyChannelID, channel.ID.String()),
+			sdk.NewAttribute(AttributeKeyFromAddress, msg.From.String()),
+			sdk.NewAttribute(AttributeKeyAmount, amount.String()),
+		),
+	)
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
 
-			if commission.IsZero() {
-				if err := k.SendDeposit(ctx, subscription.Client, node.Owner, payCoin); err != nil {
-					panic(err)
-				}
-			}
+// handleCloseChannel returns the unredeemed deposit of the channel to the client. The node owner can close
+// the channel at any time, the client only after the channel times out.
+func handleCloseChannel(ctx sdk.Context, k keeper.Keeper, msg types.MsgCloseChannel) sdk.Result {
+	channel, found := k.GetChannel(ctx, msg.ChannelID)
+	if !found {
+		return types.ErrorChannelDoesNotExist().Result()
+	}
+	if channel.Status != types.StatusActive {
+		return types.ErrorInvalidChannelStatus().Result()
+	}
+	
+	node, _ := k.GetNode(ctx, channel.NodeID)
+	switch {
+	case msg.From.Equals(node.Owner):
+	case msg.From.Equals(channel.Client):
+		if !channel.IsExpired(ctx.BlockTime()) {
+			return types.ErrorChannelNotExpired().Result()
 		}
+	default:
+		return types.ErrorUnauthorized().Result()
 	}
-
-	subscription.RemainingDeposit.Amount = subscription.RemainingDeposit.Amount.Sub(pay)
-	subscription.RemainingBandwidth = subscription.RemainingBandwidth.Sub(bandwidth)
-	k.SetSubscription(ctx, subscription)
-
-	k.SetSession(ctx, session)
-	k.SetSessionsCountOfSubscription(ctx, subscription.ID, scs+1)
-	k.RemoveSessionIDFromActiveList(ctx, session.StatusModifiedAt, session.ID)
-
+	
+	refund := channel.Unredeemed()
+	if !refund.IsZero() {
+		if err := k.UnlockDeposit(ctx, channel.Client, channel.ID, refund); err != nil {
+			return err.Result()
+		}
+	}
+	
+	channel.Status = types.StatusInactive
+	channel.StatusModifiedAt = ctx.BlockHeight()
+	k.SetChannel(ctx, channel)
+	
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
-			EventTypeMsgUpdateSessionInfo,
-			sdk.NewAttribute(AttributeSubscriptionID, session.SubscriptionID.String()),
-			sdk.NewAttribute(AttributeSessionID, session.ID.String()),
+			EventTypeMsgCloseChannel,
+			sdk.NewAttribute(AttributeKeyChannelID, channel.ID.String()),
 			sdk.NewAttribute(AttributeKeyFromAddress, msg.From.String()),
+			sdk.NewAttribute(AttributeKeyAmount, refund.String()),
+			sdk.NewAttribute(AttributeKeyStatus, channel.Status),
 		),
 	)
-
-	return sdk.Result{Events: ctx.EventManager().Events(), Data: types.ModuleCdc.MustMarshalJSON(session.Status)}
+	return sdk.Result{Events: ctx.EventManager().Events()}
 }
 
+// handleRegisterResolver registers a resolver with a self-stake of at least the minimum resolver deposit,
+// which stays locked in the deposit module until the unbonding of the resolver completes.
 func handleRegisterResolver(ctx sdk.Context, k keeper.Keeper, msg types.MsgRegisterResolver) sdk.Result {
+	minDeposit := k.ResolverMinDeposit(ctx)
+	if msg.Deposit.Denom != minDeposit.Denom || msg.Deposit.IsLT(minDeposit) {
+		return types.ErrorInvalidDeposit().Result()
+	}
+	
 	rc := k.GetResolverCount(ctx)
+	if err := k.LockDeposit(ctx, msg.From, hub.NewResolverID(rc), msg.Deposit); err != nil {
+		return err.Result()
+	}
+	
 
 	resolver := types.Resolver{
-		ID:               hub.NewResolverID(rc),
-		Owner:            msg.From,
-		Commission:       msg.Commission,
-		Status:           types.StatusRegistered,
-		StatusModifiedAt: ctx.BlockHeight(),
+		ID:                      hub.NewResolverID(rc),
+		Owner:                   msg.From,
+		Moniker:                 msg.Moniker,
+		Endpoint:                msg.Endpoint,
+		Regions:                 msg.Regions,
+		Deposit:                 msg.Deposit,
+		Commission:              msg.Commission,
+		MaxCommissionRate:       msg.MaxCommissionRate,
+		MaxCommissionChangeRate: msg.MaxCommissionChangeRate,
+		CommissionUpdatedAt:     ctx.BlockHeight(),
+		Status:                  types.StatusRegistered,
+		StatusModifiedAt:        ctx.BlockHeight(),
 	}
 
 	rca := k.GetResolversCountOfAddress(ctx, resolver.Owner)
 	k.SetResolver(ctx, resolver)
+	k.SetResolverCommission(ctx, types.ResolverCommission{
+		ResolverID: resolver.ID,
+		Commission: resolver.Commission,
+		Height:     ctx.BlockHeight(),
+	})
 	k.SetResolverIDByAddress(ctx, resolver.Owner, rca, resolver.ID)
 
 	k.SetResolverCountOfAddress(ctx, resolver.Owner, rca+1)
@@ -634,6 +1319,7 @@ func handleRegisterResolver(ctx sdk.Context, k keeper.Keeper, msg types.MsgRegis
 			EventTypeMsgRegisterResolver,
 			sdk.NewAttribute(AttributeKeyClientAddress, resolver.Owner.String()),
 			sdk.NewAttribute(AttributeKeyResolverID, resolver.ID.String()),
+			sdk.NewAttribute(AttributeKeyDeposit, resolver.Deposit.String()),
 			sdk.NewAttribute(AttributeKeyStatus, resolver.Status),
 		))
 
@@ -642,6 +1328,8 @@ func handleRegisterResolver(ctx sdk.Context, k keeper.Keeper, msg types.MsgRegis
 	}
 }
 
+// handleUpdateResolverInfo updates the info of the resolver. A change of the commission is limited by the
+// maximum rates of the resolver and the commission change interval, and applies to new subscriptions only.
 func handleUpdateResolverInfo(ctx sdk.Context, k keeper.Keeper, msg types.MsgUpdateResolverInfo) sdk.Result {
 	resolver, found := k.GetResolver(ctx, msg.ResolverID)
 	if !found {
@@ -650,15 +1338,37 @@ func handleUpdateResolverInfo(ctx sdk.Context, k keeper.Keeper, msg types.MsgUpd
 	if !msg.From.Equals(resolver.Owner) {
 		return types.ErrorUnauthorized().Result()
 	}
-	if resolver.Status == types.StatusDeRegistered {
+	if resolver.Status == types.StatusDeRegistered || resolver.Status == types.StatusUnbonding {
 		return types.ErrorInvalidResolverStatus().Result()
 	}
 
 	_resolver := types.Resolver{
-		Commission: msg.Commission,
+		Moniker:  msg.Moniker,
+		Endpoint: msg.Endpoint,
+		Regions:  msg.Regions,
+	}
+	
+	// the commission is optional, it is validated and recorded only if it is given and differs
+	commissionChanged := msg.Commission != nil && !msg.Commission.Equal(resolver.Commission)
+	if commissionChanged {
+		if err := resolver.ValidateCommissionChange(*msg.Commission,
+			ctx.BlockHeight(), k.CommissionChangeInterval(ctx)); err != nil {
+			return err.Result()
+		}
+		
+		_resolver.Commission = *msg.Commission
 	}
 
 	resolver = resolver.UpdateInfo(_resolver)
+	if commissionChanged {
+		resolver.CommissionUpdatedAt = ctx.BlockHeight()
+		k.SetResolverCommission(ctx, types.ResolverCommission{
+			ResolverID: resolver.ID,
+			Commission: resolver.Commission,
+			Height:     ctx.BlockHeight(),
+		})
+	}
+	
 	k.SetResolver(ctx, resolver)
 
 	ctx.EventManager().EmitEvent(
@@ -666,7 +1376,7 @@ func handleUpdateResolverInfo(ctx sdk.Context, k keeper.Keeper, msg types.MsgUpd
 			EventTypeMsgUpdateResolverInfo,
 			sdk.NewAttribute(AttributeKeyClientAddress, msg.From.String()),
 			sdk.NewAttribute(AttributeKeyResolverID, msg.ResolverID.String()),
-			sdk.NewAttribute(AttributeKeyCommission, msg.Commission.String()),
+			sdk.NewAttribute(AttributeKeyCommission, resolver.Commission.String()),
 		),
 	)
 
@@ -688,8 +1398,10 @@ func handleDeregisterResolver(ctx sdk.Context, k keeper.Keeper, msg types.MsgDer
 		return types.ErrorInvalidResolverStatus().Result()
 	}
 
-	resolver.Status = types.StatusDeRegistered
+	resolver.Status = types.StatusUnbonding
 	resolver.StatusModifiedAt = ctx.BlockHeight()
+	resolver.UnbondingCompletionTime = ctx.BlockTime().Add(k.ResolverUnbondingPeriod(ctx))
+	k.AddResolverIDToUnbondingQueue(ctx, resolver.UnbondingCompletionTime, resolver.ID)
 
 	k.SetResolver(ctx, resolver)
 
@@ -705,3 +1417,75 @@ func handleDeregisterResolver(ctx sdk.Context, k keeper.Keeper, msg types.MsgDer
 		Events: ctx.EventManager().Events(),
 	}
 }
+
+// handleAcceptVPNOnResolver lists a node which requested a listing on the resolver
+func handleAcceptVPNOnResolver(ctx sdk.Context, k keeper.Keeper, msg types.MsgAcceptVPNOnResolver) sdk.Result {
+	resolver, found := k.GetResolver(ctx, msg.ResolverID)
+	if !found {
+		return types.ErrorResolverDoesNotExist().Result()
+	}
+	if !msg.From.Equals(resolver.Owner) {
+		return types.ErrorUnauthorized().Result()
+	}
+	if resolver.Status != types.StatusRegistered {
+		return types.ErrorInvalidResolverStatus().Result()
+	}
+	
+	if _, found = k.GetPendingNodeOfResolver(ctx, msg.ResolverID, msg.NodeID); !found {
+		return types.ErrorListingRequestDoesNotExist().Result()
+	}
+	
+	node, found := k.GetNode(ctx, msg.NodeID)
+	if !found {
+		return types.ErrorNodeDoesNotExist().Result()
+	}
+	if node.Status == types.StatusDeRegistered || node.Status == types.StatusUnbonding {
+		return types.ErrorInvalidNodeStatus().Result()
+	}
+	if node.Blacklisted {
+		return types.ErrorNodeBlacklisted().Result()
+	}
+	
+	k.RemovePendingNodeOfResolver(ctx, resolver.ID, node.ID)
+	k.SetResolverOfNode(ctx, node.ID, resolver.ID)
+	k.SetNodeOfResolver(ctx, resolver.ID, node.ID)
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMsgAcceptVPNOnResolver,
+			sdk.NewAttribute(AttributeKeyResolverID, msg.ResolverID.String()),
+			sdk.NewAttribute(AttributeKeyNodeID, msg.NodeID.String()),
+			sdk.NewAttribute(AttributeKeyFromAddress, msg.From.String()),
+		),
+	)
+	
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// handleRejectVPNOnResolver drops a listing request of a node on the resolver
+func handleRejectVPNOnResolver(ctx sdk.Context, k keeper.Keeper, msg types.MsgRejectVPNOnResolver) sdk.Result {
+	resolver, found := k.GetResolver(ctx, msg.ResolverID)
+	if !found {
+		return types.ErrorResolverDoesNotExist().Result()
+	}
+	if !msg.From.Equals(resolver.Owner) {
+		return types.ErrorUnauthorized().Result()
+	}
+	
+	if _, found = k.GetPendingNodeOfResolver(ctx, msg.ResolverID, msg.NodeID); !found {
+		return types.ErrorListingRequestDoesNotExist().Result()
+	}
+	
+	k.RemovePendingNodeOfResolver(ctx, msg.ResolverID, msg.NodeID)
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMsgRejectVPNOnResolver,
+			sdk.NewAttribute(AttributeKeyResolverID, msg.ResolverID.String()),
+			sdk.NewAttribute(AttributeKeyNodeID, msg.NodeID.String()),
+			sdk.NewAttribute(AttributeKeyFromAddress, msg.From.String()),
+		),
+	)
+	
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
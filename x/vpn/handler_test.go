@@ -2,8 +2,10 @@ package vpn
 
 import (
 	"testing"
+	"time"
 	
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/stretchr/testify/require"
 	
 	hub "github.com/sentinel-official/hub/types"
@@ -135,21 +137,21 @@ func Test_handleUpdateNodeInfo(t *testing.T) {
 	node = types.TestNode
 	node.Status = StatusDeRegistered
 	k.SetNode(ctx, node)
-	msg := NewMsgUpdateNodeInfo(node.Owner, node.ID, "new_node_type", "new_version", "new_moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, types.TestBandwidthPos1, "new_encryption")
+	msg := NewMsgUpdateNodeInfo(node.Owner, node.ID, "new_node_type", "new_version", "new_moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, types.TestBandwidthPos1, "new_encryption", 0)
 	res := handler(ctx, *msg)
 	require.False(t, res.IsOK())
 	
-	msg = NewMsgUpdateNodeInfo(node.Owner, hub.NewNodeID(3), "new_node_type", "new_version", "new_moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, types.TestBandwidthPos1, "new_encryption")
+	msg = NewMsgUpdateNodeInfo(node.Owner, hub.NewNodeID(3), "new_node_type", "new_version", "new_moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, types.TestBandwidthPos1, "new_encryption", 0)
 	res = handler(ctx, *msg)
 	require.False(t, res.IsOK())
 	
-	msg = NewMsgUpdateNodeInfo(types.TestAddress2, node.ID, "new_node_type", "new_version", "new_moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, types.TestBandwidthPos1, "new_encryption")
+	msg = NewMsgUpdateNodeInfo(types.TestAddress2, node.ID, "new_node_type", "new_version", "new_moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, types.TestBandwidthPos1, "new_encryption", 0)
 	res = handler(ctx, *msg)
 	require.False(t, res.IsOK())
 	
 	node.Status = StatusInactive
 	k.SetNode(ctx, node)
-	msg = NewMsgUpdateNodeInfo(node.Owner, node.ID, "new_node_type", "new_version", "new_moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, types.TestBandwidthPos1, "new_encryption")
+	msg = NewMsgUpdateNodeInfo(node.Owner, node.ID, "new_node_type", "new_version", "new_moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, types.TestBandwidthPos1, "new_encryption", 0)
 	res = handler(ctx, *msg)
 	require.True(t, res.IsOK())
 	
@@ -163,7 +165,7 @@ func Test_handleUpdateNodeInfo(t *testing.T) {
 	
 	node.Status = StatusRegistered
 	k.SetNode(ctx, node)
-	msg = NewMsgUpdateNodeInfo(node.Owner, node.ID, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, types.TestBandwidthPos1, "encryption")
+	msg = NewMsgUpdateNodeInfo(node.Owner, node.ID, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, types.TestBandwidthPos1, "encryption", 3)
 	res = handler(ctx, *msg)
 	require.True(t, res.IsOK())
 	
@@ -174,6 +176,11 @@ func Test_handleUpdateNodeInfo(t *testing.T) {
 	require.Equal(t, "moniker", node.Moniker)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, node.PricesPerGB)
 	require.Equal(t, "encryption", node.Encryption)
+	require.Equal(t, uint64(3), node.MaxConcurrentSessions)
+	
+	plan, found := node.FindPlan(0, "stake")
+	require.Equal(t, true, found)
+	require.Equal(t, uint64(3), plan.ConcurrentSessions())
 }
 
 func Test_handleDeregisterNode(t *testing.T) {
@@ -224,6 +231,13 @@ func Test_handleDeregisterNode(t *testing.T) {
 	res = handler(ctx, *msg)
 	require.True(t, res.IsOK())
 	
+	node, found = k.GetNode(ctx, node.ID)
+	require.Equal(t, true, found)
+	require.Equal(t, StatusUnbonding, node.Status)
+	
+	ctx = ctx.WithBlockTime(node.UnbondingCompletionTime)
+	EndBlock(ctx, k)
+	
 	coins := bk.GetCoins(ctx, node.Owner)
 	require.Equal(t, sdk.Coins{}, coins)
 	
@@ -249,6 +263,13 @@ func Test_handleDeregisterNode(t *testing.T) {
 	res = handler(ctx, *msg)
 	require.True(t, res.IsOK())
 	
+	node, found = k.GetNode(ctx, node.ID)
+	require.Equal(t, true, found)
+	require.Equal(t, StatusUnbonding, node.Status)
+	
+	ctx = ctx.WithBlockTime(node.UnbondingCompletionTime)
+	EndBlock(ctx, k)
+	
 	coins = bk.GetCoins(ctx, node.Owner)
 	require.Equal(t, sdk.Coins{}, coins)
 	
@@ -260,7 +281,7 @@ func Test_handleDeregisterNode(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}.Add(sdk.Coins{sdk.NewInt64Coin("stake", 100)}), coins)
 	
-	err = k.AddDeposit(ctx, node.Owner, sdk.NewInt64Coin("stake", 100).Add(sdk.NewInt64Coin("stake", 100)))
+	err = k.LockDeposit(ctx, node.Owner, node.ID, sdk.NewInt64Coin("stake", 100).Add(sdk.NewInt64Coin("stake", 100)))
 	require.Nil(t, err)
 	
 	node.Status = StatusDeRegistered
@@ -312,18 +333,84 @@ func Test_handleDeregisterNode(t *testing.T) {
 	res = handler(ctx, *msg)
 	require.True(t, res.IsOK())
 	
+	node, found = k.GetNode(ctx, node.ID)
+	require.Equal(t, true, found)
+	require.Equal(t, StatusUnbonding, node.Status)
+	
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, true, found)
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, deposit.Coins)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}.Add(sdk.Coins{sdk.NewInt64Coin("stake", 100)}), deposit.Coins)
+	
+	EndBlock(ctx.WithBlockTime(node.UnbondingCompletionTime.Add(-1)), k)
+	
+	node, found = k.GetNode(ctx, node.ID)
+	require.Equal(t, true, found)
+	require.Equal(t, StatusUnbonding, node.Status)
+	
+	ctx = ctx.WithBlockTime(node.UnbondingCompletionTime)
+	EndBlock(ctx, k)
+	
+	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
+	require.Equal(t, true, found)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}.Add(sdk.Coins{sdk.NewInt64Coin("stake", 100)}), deposit.Coins)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, deposit.Free())
 	
 	coins = bk.GetCoins(ctx, node.Owner)
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, coins)
+	require.Equal(t, sdk.Coins(nil), coins)
 	
 	node, found = k.GetNode(ctx, node.ID)
 	require.Equal(t, true, found)
 	require.Equal(t, StatusDeRegistered, node.Status)
 }
 
+func Test_handleNodeHeartbeat(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	handler := NewHandler(k)
+	
+	msg := NewMsgNodeHeartbeat(types.TestAddress1, hub.NewNodeID(0))
+	res := handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	
+	node := types.TestNode
+	k.SetNode(ctx, node)
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	
+	node.Status = StatusInactive
+	k.SetNode(ctx, node)
+	msg = NewMsgNodeHeartbeat(types.TestAddress2, node.ID)
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	
+	ctx = ctx.WithBlockHeight(10)
+	msg = NewMsgNodeHeartbeat(node.Owner, node.ID)
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
+	
+	node, _ = k.GetNode(ctx, node.ID)
+	require.Equal(t, StatusRegistered, node.Status)
+	require.Equal(t, int64(10), node.StatusModifiedAt)
+	require.Equal(t, hub.IDs{node.ID}, k.GetActiveNodeIDs(ctx, 10))
+	
+	ctx = ctx.WithBlockHeight(20)
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
+	require.Equal(t, hub.IDs(nil), k.GetActiveNodeIDs(ctx, 10))
+	require.Equal(t, hub.IDs{node.ID}, k.GetActiveNodeIDs(ctx, 20))
+	
+	ctx = ctx.WithBlockHeight(20 + k.NodeInactiveInterval(ctx))
+	EndBlock(ctx, k)
+	
+	node, _ = k.GetNode(ctx, node.ID)
+	require.Equal(t, StatusInactive, node.Status)
+	require.Equal(t, hub.IDs(nil), k.GetActiveNodeIDs(ctx, 20))
+	
+	subscription := NewMsgStartSubscription(types.TestAddress2, hub.NewResolverID(0), node.ID,
+		sdk.Coins{sdk.NewInt64Coin("stake", 100)}, 0)
+	res = handler(ctx, *subscription)
+	require.False(t, res.IsOK())
+}
+
 func Test_handleStartSubscription(t *testing.T) {
 	ctx, k, dk, bk := keeper.CreateTestInput(t, false)
 	
@@ -340,14 +427,14 @@ func Test_handleStartSubscription(t *testing.T) {
 	require.Equal(t, types.Subscription{}, subscription)
 	
 	handler := NewHandler(k)
-	msg := NewMsgStartSubscription(types.TestAddress2, hub.NewResolverID(0), hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100))
+	msg := NewMsgStartSubscription(types.TestAddress2, hub.NewResolverID(0), hub.NewNodeID(1), sdk.Coins{sdk.NewInt64Coin("stake", 100)}, 0)
 	res := handler(ctx, *msg)
 	require.False(t, res.IsOK())
 	
 	resolver = types.TestResolver
 	resolver.Status = StatusDeRegistered
 	k.SetResolver(ctx, resolver)
-	msg = NewMsgStartSubscription(types.TestAddress2, hub.NewResolverID(0), node.ID, sdk.NewInt64Coin("stake", 100))
+	msg = NewMsgStartSubscription(types.TestAddress2, hub.NewResolverID(0), node.ID, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, 0)
 	res = handler(ctx, *msg)
 	require.False(t, res.IsOK())
 	
@@ -357,7 +444,7 @@ func Test_handleStartSubscription(t *testing.T) {
 	node.Status = StatusDeRegistered
 	k.SetNode(ctx, node)
 	k.SetResolverOfNode(ctx, node.ID, resolver.ID)
-	msg = NewMsgStartSubscription(types.TestAddress2, hub.NewResolverID(0), node.ID, sdk.NewInt64Coin("stake", 100))
+	msg = NewMsgStartSubscription(types.TestAddress2, hub.NewResolverID(0), node.ID, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, 0)
 	res = handler(ctx, *msg)
 	require.False(t, res.IsOK())
 	
@@ -371,7 +458,7 @@ func Test_handleStartSubscription(t *testing.T) {
 	
 	node.Status = StatusRegistered
 	k.SetNode(ctx, node)
-	msg = NewMsgStartSubscription(types.TestAddress2, hub.NewResolverID(2), node.ID, sdk.NewInt64Coin("stake", 100))
+	msg = NewMsgStartSubscription(types.TestAddress2, hub.NewResolverID(2), node.ID, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, 0)
 	res = handler(ctx, *msg)
 	require.False(t, res.IsOK())
 	
@@ -383,7 +470,7 @@ func Test_handleStartSubscription(t *testing.T) {
 	require.Equal(t, false, found)
 	require.Equal(t, types.Subscription{}, subscription)
 	
-	msg = NewMsgStartSubscription(types.TestAddress2, hub.NewResolverID(2), node.ID, sdk.NewInt64Coin("invalid", 100))
+	msg = NewMsgStartSubscription(types.TestAddress2, hub.NewResolverID(2), node.ID, sdk.Coins{sdk.NewInt64Coin("invalid", 100)}, 0)
 	res = handler(ctx, *msg)
 	require.False(t, res.IsOK())
 	
@@ -399,7 +486,7 @@ func Test_handleStartSubscription(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, coins)
 	
-	msg = NewMsgStartSubscription(types.TestAddress2, hub.NewResolverID(0), node.ID, sdk.NewInt64Coin("stake", 100))
+	msg = NewMsgStartSubscription(types.TestAddress2, hub.NewResolverID(0), node.ID, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, 0)
 	res = handler(ctx, *msg)
 	require.True(t, res.IsOK())
 	
@@ -432,7 +519,7 @@ func Test_handleStartSubscription(t *testing.T) {
 	subscriptions := k.GetSubscriptionsOfNode(ctx, node.ID)
 	require.Equal(t, []types.Subscription{types.TestSubscription}, subscriptions)
 	
-	msg = NewMsgStartSubscription(types.TestAddress2, hub.NewResolverID(0), node.ID, sdk.NewInt64Coin("stake", 100))
+	msg = NewMsgStartSubscription(types.TestAddress2, hub.NewResolverID(0), node.ID, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, 0)
 	res = handler(ctx, *msg)
 	require.False(t, res.IsOK())
 	
@@ -440,7 +527,7 @@ func Test_handleStartSubscription(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}.Add(sdk.Coins{sdk.NewInt64Coin("stake", 100)}), coins)
 	
-	msg = NewMsgStartSubscription(types.TestAddress2, hub.NewResolverID(0), node.ID, sdk.NewInt64Coin("stake", 100))
+	msg = NewMsgStartSubscription(types.TestAddress2, hub.NewResolverID(0), node.ID, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, 0)
 	res = handler(ctx, *msg)
 	require.True(t, res.IsOK())
 	
@@ -476,8 +563,8 @@ func Test_handleStartSubscription(t *testing.T) {
 	require.Equal(t, types.TestSubscription, subscriptions[0])
 	require.Equal(t, subscription, subscriptions[1])
 	
-	k.SetFreeClientOfNode(ctx, hub.NewNodeID(0), types.TestAddress2)
-	msg = NewMsgStartSubscription(types.TestAddress2, hub.NewResolverID(0), node.ID, sdk.NewInt64Coin("stake", 100))
+	k.SetFreeClient(ctx, types.NewFreeClient(hub.NewNodeID(0), types.TestAddress2, types.TestBandwidthZero, 0, 0))
+	msg = NewMsgStartSubscription(types.TestAddress2, hub.NewResolverID(0), node.ID, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, 0)
 	res = handler(ctx, *msg)
 	require.True(t, res.IsOK())
 	
@@ -561,7 +648,7 @@ func Test_handleEndSubscription(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, coins)
 	
-	err = k.AddDeposit(ctx, types.TestAddress2, sdk.NewInt64Coin("stake", 100))
+	err = k.LockDeposit(ctx, types.TestAddress2, hub.NewSubscriptionID(0), sdk.NewInt64Coin("stake", 100))
 	require.Nil(t, err)
 	
 	coins = bk.GetCoins(ctx, types.TestAddress2)
@@ -608,15 +695,13 @@ func Test_handleEndSubscription(t *testing.T) {
 	
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress2)
 	require.Equal(t, true, found)
-	require.Equal(t, sdk.Coins(nil), deposit.Coins)
-	
-	coins = bk.GetCoins(ctx, types.TestAddress2)
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, coins)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, deposit.Coins)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, deposit.Free())
 	
 	coins = bk.GetCoins(ctx, types.TestAddress2)
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, coins)
+	require.Equal(t, sdk.Coins(nil), coins)
 	
-	err = k.AddDeposit(ctx, types.TestAddress2, sdk.NewInt64Coin("stake", 100))
+	err = k.LockDeposit(ctx, types.TestAddress2, hub.NewSubscriptionID(0), sdk.NewInt64Coin("stake", 100))
 	require.Nil(t, err)
 	
 	coins, err = bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
@@ -634,299 +719,710 @@ func Test_handleEndSubscription(t *testing.T) {
 	k.SetSubscription(ctx, types.TestSubscription)
 	k.SetSession(ctx, types.TestSession)
 	k.SetSessionsCountOfSubscription(ctx, subscription.ID, 1)
-	k.SetSessionIDBySubscriptionID(ctx, subscription.ID, 1, hub.NewSessionID(0))
+	k.SetSessionIDBySubscriptionID(ctx, subscription.ID, 0, hub.NewSessionID(0))
 	
 	msg = NewMsgEndSubscription(types.TestAddress2, subscription.ID)
 	res = handler(ctx, *msg)
 	require.False(t, res.IsOK())
 }
 
-func Test_handleUpdateSessionInfo(t *testing.T) {
+func Test_handleStartSession(t *testing.T) {
 	ctx, k, _, _ := keeper.CreateTestInput(t, false)
-	
-	session, found := k.GetSession(ctx, hub.NewSessionID(0))
-	require.Equal(t, false, found)
-	require.Equal(t, types.Session{}, session)
-	
 	handler := NewHandler(k)
-	msg := NewMsgUpdateSessionInfo(types.TestAddress2, hub.NewSubscriptionID(1), types.TestBandwidthPos1, types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos1)
+	
+	msg := NewMsgStartSession(types.TestAddress2, hub.NewSubscriptionID(0))
 	res := handler(ctx, *msg)
 	require.False(t, res.IsOK())
 	
 	subscription := types.TestSubscription
 	subscription.Status = StatusInactive
 	k.SetSubscription(ctx, subscription)
-	k.SetSessionsCountOfSubscription(ctx, subscription.ID, 0)
 	
-	msg = NewMsgUpdateSessionInfo(types.TestAddress2, subscription.ID, types.TestBandwidthPos1, types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos1)
 	res = handler(ctx, *msg)
 	require.False(t, res.IsOK())
 	
-	session, found = k.GetSession(ctx, hub.NewSessionID(0))
-	require.Equal(t, false, found)
-	require.Equal(t, types.Session{}, session)
-	
-	count := k.GetSessionsCount(ctx)
-	require.Equal(t, uint64(0), count)
-	
-	count = k.GetSessionsCountOfSubscription(ctx, subscription.ID)
-	require.Equal(t, uint64(0), count)
+	subscription.Status = StatusActive
+	k.SetSubscription(ctx, subscription)
 	
-	msg = NewMsgUpdateSessionInfo(subscription.Client, subscription.ID, types.TestBandwidthPos1, types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos1)
+	msg = NewMsgStartSession(types.TestAddress1, subscription.ID)
 	res = handler(ctx, *msg)
 	require.False(t, res.IsOK())
 	
+	msg = NewMsgStartSession(types.TestAddress2, subscription.ID)
+	res = handler(ctx, *msg)
+	require.Equal(t, types.ErrorNodeDoesNotExist().Code(), res.Code)
+	
 	node := types.TestNode
 	k.SetNode(ctx, node)
-	subscription.Status = StatusActive
-	k.SetSubscription(ctx, subscription)
-	k.SetSessionsCountOfSubscription(ctx, subscription.ID, 0)
-	msg = NewMsgUpdateSessionInfo(types.TestAddress2, subscription.ID, types.TestBandwidthPos1, types.TestClientStdSignaturePos1, types.TestNodeOwnerStdSignaturePos1)
 	res = handler(ctx, *msg)
-	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorInvalidNodeStatus().Code(), res.Code)
 	
-	msg = NewMsgUpdateSessionInfo(types.TestAddress2, subscription.ID, types.TestBandwidthPos1, types.TestClientStdSignaturePos1, types.TestClientStdSignaturePos1)
+	node.Status = StatusInactive
+	k.SetNode(ctx, node)
 	res = handler(ctx, *msg)
-	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorInvalidNodeStatus().Code(), res.Code)
 	
-	k.SetSessionsCountOfSubscription(ctx, subscription.ID, 1)
-	msg = NewMsgUpdateSessionInfo(types.TestAddress2, subscription.ID, types.TestBandwidthPos2, types.TestNodeOwnerStdSignaturePos2, types.TestClientStdSignaturePos2)
+	node.Status = StatusRegistered
+	node.Jailed = true
+	k.SetNode(ctx, node)
 	res = handler(ctx, *msg)
-	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorNodeJailed().Code(), res.Code)
+	
+	node.Jailed = false
+	node.Blacklisted = true
+	k.SetNode(ctx, node)
+	res = handler(ctx, *msg)
+	require.Equal(t, types.ErrorNodeBlacklisted().Code(), res.Code)
 	
-	msg = NewMsgUpdateSessionInfo(types.TestAddress2, subscription.ID, types.TestBandwidthPos1, types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos1)
+	node.Blacklisted = false
+	k.SetNode(ctx, node)
 	res = handler(ctx, *msg)
 	require.True(t, res.IsOK())
 	
-	id, _ := k.GetSessionIDBySubscriptionID(ctx, subscription.ID, k.GetSessionsCountOfSubscription(ctx, subscription.ID))
-	session, found = k.GetSession(ctx, id)
+	session, found := k.GetSession(ctx, hub.NewSessionID(0))
 	require.Equal(t, true, found)
-	require.Equal(t, types.TestSession, session)
+	require.Equal(t, subscription.ID, session.SubscriptionID)
+	require.Equal(t, StatusActive, session.Status)
+	require.Equal(t, types.TestBandwidthZero, session.Bandwidth)
 	
-	count = k.GetSessionsCount(ctx)
-	require.Equal(t, uint64(1), count)
+	id, found := k.GetSessionIDBySubscriptionID(ctx, subscription.ID, 0)
+	require.Equal(t, true, found)
+	require.Equal(t, session.ID, id)
+	require.Equal(t, uint64(1), k.GetSessionsCount(ctx))
+	require.Equal(t, uint64(1), k.GetSessionsCountOfSubscription(ctx, subscription.ID))
 	
-	count = k.GetSessionsCountOfSubscription(ctx, subscription.ID)
-	require.Equal(t, uint64(1), count)
-	msg = NewMsgUpdateSessionInfo(subscription.Client, subscription.ID, types.TestBandwidthPos1, types.TestNodeOwnerStdSignaturePos2, types.TestClientStdSignaturePos1)
 	res = handler(ctx, *msg)
 	require.False(t, res.IsOK())
 	
-	session, found = k.GetSession(ctx, session.ID)
-	require.Equal(t, true, found)
-	require.Equal(t, types.TestSession, session)
+	subscription.Plan.MaxConcurrentSessions = 2
+	k.SetSubscription(ctx, subscription)
 	
-	count = k.GetSessionsCount(ctx)
-	require.Equal(t, uint64(1), count)
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
 	
-	count = k.GetSessionsCountOfSubscription(ctx, subscription.ID)
-	require.Equal(t, uint64(1), count)
+	session, found = k.GetSession(ctx, hub.NewSessionID(1))
+	require.Equal(t, true, found)
+	require.Equal(t, subscription.ID, session.SubscriptionID)
+	require.Equal(t, uint64(2), k.GetSessionsCountOfSubscription(ctx, subscription.ID))
+	require.Len(t, k.GetActiveSessionsOfSubscription(ctx, subscription.ID), 2)
 	
-	msg = NewMsgUpdateSessionInfo(subscription.Client, subscription.ID, types.TestBandwidthPos1, types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos2)
 	res = handler(ctx, *msg)
 	require.False(t, res.IsOK())
+	require.Equal(t, uint64(2), k.GetSessionsCount(ctx))
+}
+
+func Test_handleUpdateSessionInfo(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	handler := NewHandler(k)
 	
-	session, found = k.GetSession(ctx, session.ID)
-	require.Equal(t, true, found)
-	require.Equal(t, types.TestSession, session)
+	msg := NewMsgUpdateSessionInfo(types.TestAddress2, hub.NewSessionID(0), types.TestBandwidthPos1, types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos1)
+	res := handler(ctx, *msg)
+	require.False(t, res.IsOK())
 	
-	count = k.GetSessionsCount(ctx)
-	require.Equal(t, uint64(1), count)
+	node := types.TestNode
+	node.Status = StatusRegistered
+	k.SetNode(ctx, node)
+	subscription := types.TestSubscription
+	subscription.Plan.MaxConcurrentSessions = 2
+	subscription.RemainingBandwidth = types.TestBandwidthPos2
+	k.SetSubscription(ctx, subscription)
 	
-	count = k.GetSessionsCountOfSubscription(ctx, subscription.ID)
-	require.Equal(t, uint64(1), count)
+	start := NewMsgStartSession(subscription.Client, subscription.ID)
+	res = handler(ctx, *start)
+	require.True(t, res.IsOK())
 	
-	msg = NewMsgUpdateSessionInfo(subscription.Client, subscription.ID, types.TestBandwidthPos2, types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos1)
+	msg = NewMsgUpdateSessionInfo(types.TestAddress2, hub.NewSessionID(0), types.TestBandwidthPos1, types.TestClientStdSignaturePos1, types.TestNodeOwnerStdSignaturePos1)
 	res = handler(ctx, *msg)
 	require.False(t, res.IsOK())
 	
-	session, found = k.GetSession(ctx, session.ID)
-	require.Equal(t, true, found)
-	require.Equal(t, types.TestSession, session)
+	msg = NewMsgUpdateSessionInfo(types.TestAddress2, hub.NewSessionID(0), types.TestBandwidthPos1, types.TestNodeOwnerStdSignaturePos2, types.TestClientStdSignaturePos1)
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
 	
-	count = k.GetSessionsCount(ctx)
-	require.Equal(t, uint64(1), count)
+	msg = NewMsgUpdateSessionInfo(types.TestAddress2, hub.NewSessionID(0), types.TestBandwidthPos1, types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos2)
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
 	
-	count = k.GetSessionsCountOfSubscription(ctx, subscription.ID)
-	require.Equal(t, uint64(1), count)
+	session, _ := k.GetSession(ctx, hub.NewSessionID(0))
+	require.Equal(t, types.TestBandwidthZero, session.Bandwidth)
 	
-	msg = NewMsgUpdateSessionInfo(subscription.Client, subscription.ID, types.TestBandwidthNeg, types.TestNodeOwnerStdSignatureNeg, types.TestClientStdSignatureNeg)
+	msg = NewMsgUpdateSessionInfo(types.TestAddress2, hub.NewSessionID(0), types.TestBandwidthPos1, types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos1)
 	res = handler(ctx, *msg)
-	require.False(t, res.IsOK())
+	require.True(t, res.IsOK())
 	
-	session, found = k.GetSession(ctx, session.ID)
-	require.Equal(t, true, found)
-	require.Equal(t, types.TestSession, session)
+	session, _ = k.GetSession(ctx, hub.NewSessionID(0))
+	require.Equal(t, types.TestBandwidthPos1, session.Bandwidth)
+	require.Equal(t, StatusActive, session.Status)
 	
-	count = k.GetSessionsCount(ctx)
-	require.Equal(t, uint64(1), count)
+	res = handler(ctx, *start)
+	require.True(t, res.IsOK())
 	
-	count = k.GetSessionsCountOfSubscription(ctx, subscription.ID)
-	require.Equal(t, uint64(1), count)
+	sign := func(bandwidth hub.Bandwidth) (auth.StdSignature, auth.StdSignature) {
+		data := hub.NewBandwidthSignatureData(subscription.ID, hub.NewSessionID(1), bandwidth).Bytes()
+		nodeOwnerSignature, _ := types.TestPrivKey1.Sign(data)
+		clientSignature, _ := types.TestPrivKey2.Sign(data)
+		
+		return auth.StdSignature{PubKey: types.TestPubkey1, Signature: nodeOwnerSignature},
+			auth.StdSignature{PubKey: types.TestPubkey2, Signature: clientSignature}
+	}
 	
-	msg = NewMsgUpdateSessionInfo(node.Owner, subscription.ID, types.TestBandwidthZero, types.TestNodeOwnerStdSignatureZero, types.TestClientStdSignatureZero)
+	msg = NewMsgUpdateSessionInfo(types.TestAddress2, hub.NewSessionID(1), types.TestBandwidthPos1, types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos1)
 	res = handler(ctx, *msg)
 	require.False(t, res.IsOK())
 	
-	session, found = k.GetSession(ctx, session.ID)
-	require.Equal(t, true, found)
-	require.Equal(t, types.TestSession, session)
-	
-	count = k.GetSessionsCount(ctx)
-	require.Equal(t, uint64(1), count)
-	
-	count = k.GetSessionsCountOfSubscription(ctx, subscription.ID)
-	require.Equal(t, uint64(1), count)
+	nodeOwnerSignature, clientSignature := sign(types.TestBandwidthPos2)
+	msg = NewMsgUpdateSessionInfo(types.TestAddress2, hub.NewSessionID(1), types.TestBandwidthPos2, nodeOwnerSignature, clientSignature)
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
 	
-	msg = NewMsgUpdateSessionInfo(node.Owner, subscription.ID, types.TestBandwidthPos1, types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos1)
+	nodeOwnerSignature, clientSignature = sign(types.TestBandwidthPos1)
+	msg = NewMsgUpdateSessionInfo(types.TestAddress2, hub.NewSessionID(1), types.TestBandwidthPos1, nodeOwnerSignature, clientSignature)
 	res = handler(ctx, *msg)
 	require.True(t, res.IsOK())
 	
-	session, found = k.GetSession(ctx, hub.NewSessionID(0))
-	require.Equal(t, true, found)
+	session, _ = k.GetSession(ctx, hub.NewSessionID(1))
 	require.Equal(t, types.TestBandwidthPos1, session.Bandwidth)
 	
-	count = k.GetSessionsCount(ctx)
-	require.Equal(t, uint64(1), count)
+	subscription, _ = k.GetSubscription(ctx, subscription.ID)
+	subscription.Status = StatusInactive
+	k.SetSubscription(ctx, subscription)
 	
-	count = k.GetSessionsCountOfSubscription(ctx, subscription.ID)
-	require.Equal(t, uint64(1), count)
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
 }
 
-func Test_HandleRegisterResolver(t *testing.T) {
+func Test_handleBatchUpdateSessions(t *testing.T) {
 	ctx, k, _, _ := keeper.CreateTestInput(t, false)
 	handler := NewHandler(k)
 	
-	resolver := types.TestResolver
-	
-	data, found := k.GetResolver(ctx, resolver.ID)
-	require.False(t, found)
-	require.Equal(t, uint64(0), k.GetResolverCount(ctx))
+	node := types.TestNode
+	node.Status = StatusRegistered
+	k.SetNode(ctx, node)
+	subscription := types.TestSubscription
+	subscription.Plan.MaxConcurrentSessions = 2
+	subscription.RemainingBandwidth = types.TestBandwidthPos2
+	k.SetSubscription(ctx, subscription)
 	
-	msg := NewMsgRegisterResolver(resolver.Owner, resolver.Commission)
-	res := handler(ctx, msg)
+	start := NewMsgStartSession(subscription.Client, subscription.ID)
+	res := handler(ctx, *start)
 	require.True(t, res.IsOK())
-	
-	data, found = k.GetResolver(ctx, resolver.ID)
-	require.True(t, found)
-	require.Equal(t, data, resolver)
-	require.Equal(t, uint64(1), k.GetResolverCount(ctx))
-	require.Equal(t, uint64(1), k.GetResolversCountOfAddress(ctx, resolver.Owner))
-	
-	msg = NewMsgRegisterResolver(resolver.Owner, resolver.Commission)
-	res = handler(ctx, msg)
+	res = handler(ctx, *start)
 	require.True(t, res.IsOK())
 	
-	data, found = k.GetResolver(ctx, hub.NewResolverID(1))
-	require.True(t, found)
-	require.Equal(t, uint64(2), k.GetResolverCount(ctx))
-	require.Equal(t, uint64(2), k.GetResolversCountOfAddress(ctx, resolver.Owner))
-	require.Equal(t, 2, len(k.GetResolversOfAddress(ctx, resolver.Owner)))
+	valid := NewSessionUpdate(hub.NewSessionID(0), types.TestBandwidthPos1,
+		types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos1)
+	invalid := NewSessionUpdate(hub.NewSessionID(1), types.TestBandwidthPos1,
+		types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos1)
+	unknown := NewSessionUpdate(hub.NewSessionID(2), types.TestBandwidthPos1,
+		types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos1)
 	
-	resolver.Owner = types.TestAddress1
-	msg = NewMsgRegisterResolver(resolver.Owner, resolver.Commission)
-	res = handler(ctx, msg)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	msg := NewMsgBatchUpdateSessions(types.TestAddress1, []SessionUpdate{invalid, valid, unknown})
+	res = handler(ctx, *msg)
 	require.True(t, res.IsOK())
+	require.Equal(t, sdk.Events{sdk.NewEvent(
+		EventTypeMsgUpdateSessionInfo,
+		sdk.NewAttribute(AttributeSubscriptionID, subscription.ID.String()),
+		sdk.NewAttribute(AttributeSessionID, hub.NewSessionID(0).String()),
+		sdk.NewAttribute(AttributeKeyFromAddress, types.TestAddress1.String()),
+	)}, res.Events)
+	
+	var results []SessionUpdateResult
+	types.ModuleCdc.MustUnmarshalJSON(res.Data, &results)
+	require.Len(t, results, 3)
+	require.Equal(t, hub.NewSessionID(1), results[0].SessionID)
+	require.Equal(t, types.ErrorInvalidBandwidthSignature().Code(), results[0].Code)
+	require.Equal(t, hub.NewSessionID(0), results[1].SessionID)
+	require.Equal(t, sdk.CodeOK, results[1].Code)
+	require.Equal(t, types.ErrorSessionDoesNotExist().Code(), results[2].Code)
+	
+	session, _ := k.GetSession(ctx, hub.NewSessionID(0))
+	require.Equal(t, types.TestBandwidthPos1, session.Bandwidth)
+	session, _ = k.GetSession(ctx, hub.NewSessionID(1))
+	require.Equal(t, types.TestBandwidthZero, session.Bandwidth)
+}
+
+func Test_handleSubmitEvidence(t *testing.T) {
+	ctx, k, dk, bk := keeper.CreateTestInput(t, false)
+	handler := NewHandler(k)
 	
-	data, found = k.GetResolver(ctx, hub.NewResolverID(2))
-	require.True(t, found)
-	require.Equal(t, uint64(3), k.GetResolverCount(ctx))
-	require.Equal(t, uint64(1), k.GetResolversCountOfAddress(ctx, types.TestAddress1))
-	require.Equal(t, 1, len(k.GetResolversOfAddress(ctx, types.TestAddress1)))
+	evidence := types.NewEvidence(hub.NewSubscriptionID(0), hub.NewSessionID(0), types.TestBandwidthPos1,
+		types.TestNodeOwnerStdSignaturePos1, types.TestBandwidthSkew, types.TestNodeOwnerStdSignatureSkew)
 	
-	updateResolverInfoMsg := NewMsgUpdateResolverInfo(types.TestAddress2, hub.NewResolverID(4), sdk.NewDecWithPrec(2, 1))
-	res = handler(ctx, updateResolverInfoMsg)
+	msg := NewMsgSubmitEvidence(types.TestAddress2, evidence)
+	res := handler(ctx, *msg)
 	require.False(t, res.IsOK())
-	require.Equal(t, types.ErrorResolverDoesNotExist().ABCILog(), res.Log)
 	
-	updateResolverInfoMsg = NewMsgUpdateResolverInfo(types.TestAddress2, hub.NewResolverID(2), sdk.NewDecWithPrec(2, 1))
-	res = handler(ctx, updateResolverInfoMsg)
+	_, err := bk.AddCoins(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 200)})
+	require.Nil(t, err)
+	err = k.LockDeposit(ctx, types.TestAddress1, types.TestNode.ID, sdk.NewInt64Coin("stake", 100))
+	require.Nil(t, err)
+	
+	node := types.TestNode
+	node.Status = StatusRegistered
+	k.SetNode(ctx, node)
+	k.SetSubscription(ctx, types.TestSubscription)
+	k.SetResolver(ctx, types.TestResolver)
+	k.SetResolverOfNode(ctx, node.ID, types.TestResolver.ID)
+	
+	msg = NewMsgSubmitEvidence(types.TestAddress2, evidence)
+	res = handler(ctx, *msg)
 	require.False(t, res.IsOK())
-	require.Equal(t, types.ErrorUnauthorized().ABCILog(), res.Log)
 	
-	resolver, _ = k.GetResolver(ctx, hub.NewResolverID(2))
-	resolver.Status = StatusDeRegistered
-	k.SetResolver(ctx, resolver)
+	k.SetSession(ctx, types.TestSession)
 	
-	updateResolverInfoMsg = NewMsgUpdateResolverInfo(types.TestAddress1, hub.NewResolverID(2), sdk.NewDecWithPrec(2, 1))
-	res = handler(ctx, updateResolverInfoMsg)
+	msg = NewMsgSubmitEvidence(types.TestAddress1, evidence)
+	res = handler(ctx, *msg)
 	require.False(t, res.IsOK())
-	require.Equal(t, types.ErrorInvalidResolverStatus().ABCILog(), res.Log)
 	
-	resolver.Status = StatusRegistered
-	k.SetResolver(ctx, resolver)
-	updateResolverInfoMsg = NewMsgUpdateResolverInfo(types.TestAddress1, hub.NewResolverID(2), sdk.NewDecWithPrec(2, 1))
-	res = handler(ctx, updateResolverInfoMsg)
+	invalid := evidence
+	invalid.Signature2 = types.TestClientStdSignaturePos2
+	msg = NewMsgSubmitEvidence(types.TestAddress2, invalid)
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	
+	honest := types.NewEvidence(hub.NewSubscriptionID(0), hub.NewSessionID(0), types.TestBandwidthPos1,
+		types.TestNodeOwnerStdSignaturePos1, types.TestBandwidthPos2, types.TestNodeOwnerStdSignaturePos2)
+	msg = NewMsgSubmitEvidence(types.TestAddress2, honest)
+	res = handler(ctx, *msg)
+	require.Equal(t, types.ErrorInvalidEvidence().Code(), res.Code)
+	
+	node, _ = k.GetNode(ctx, node.ID)
+	require.False(t, node.Jailed)
+	
+	msg = NewMsgSubmitEvidence(types.TestAddress2, evidence)
+	res = handler(ctx, *msg)
 	require.True(t, res.IsOK())
 	
-	require.Equal(t, uint64(3), k.GetResolverCount(ctx))
-	require.Equal(t, uint64(1), k.GetResolversCountOfAddress(ctx, types.TestAddress1))
-	require.Equal(t, uint64(2), k.GetResolversCountOfAddress(ctx, types.TestAddress3))
-	require.Equal(t, 1, len(k.GetResolversOfAddress(ctx, types.TestAddress1)))
-	require.Equal(t, 2, len(k.GetResolversOfAddress(ctx, types.TestAddress3)))
+	node, _ = k.GetNode(ctx, node.ID)
+	require.True(t, node.Jailed)
+	require.Equal(t, sdk.NewInt64Coin("stake", 90), node.Deposit)
+	require.Equal(t, sdk.NewInt64Coin("stake", 10), *node.Slashed)
+	require.Nil(t, node.IsValid())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, bk.GetCoins(ctx, types.TestAddress2))
 	
-	resolver, found = k.GetResolver(ctx, hub.NewResolverID(2))
-	require.True(t, found)
-	require.Equal(t, sdk.NewDecWithPrec(2, 1), resolver.Commission)
+	deposit, _ := dk.GetDeposit(ctx, types.TestAddress1)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 90)}, deposit.Coins)
 	
-	deRegisterResolverMsg := NewMsgDeregisterResolver(types.TestAddress2, hub.NewResolverID(k.GetResolverCount(ctx)+1))
-	res = handler(ctx, deRegisterResolverMsg)
+	res = handler(ctx, *msg)
 	require.False(t, res.IsOK())
-	require.Equal(t, types.ErrorResolverDoesNotExist().ABCILog(), res.Log)
 	
-	deRegisterResolverMsg = NewMsgDeregisterResolver(types.TestAddress2, hub.NewResolverID(2))
-	res = handler(ctx, deRegisterResolverMsg)
-	require.False(t, res.IsOK())
-	require.Equal(t, types.ErrorUnauthorized().ABCILog(), res.Log)
+	_, err = bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 90)})
+	require.Nil(t, err)
 	
-	resolver.Status = StatusDeRegistered
-	k.SetResolver(ctx, resolver)
-	deRegisterResolverMsg = NewMsgDeregisterResolver(types.TestAddress1, hub.NewResolverID(2))
-	res = handler(ctx, deRegisterResolverMsg)
+	subscription := NewMsgStartSubscription(types.TestAddress2, types.TestResolver.ID, node.ID,
+		sdk.Coins{sdk.NewInt64Coin("stake", 100)}, 0)
+	res = handler(ctx, *subscription)
 	require.False(t, res.IsOK())
 	
-	resolver.Status = StatusRegistered
-	k.SetResolver(ctx, resolver)
-	
-	deRegisterResolverMsg = NewMsgDeregisterResolver(types.TestAddress1, hub.NewResolverID(2))
-	res = handler(ctx, deRegisterResolverMsg)
+	topUp := NewMsgTopUpNodeDeposit(types.TestAddress1, node.ID, sdk.NewInt64Coin("stake", 5))
+	res = handler(ctx, *topUp)
 	require.True(t, res.IsOK())
-}
-
-func Test_handleFreeClientsOfNode(t *testing.T) {
-	ctx, k, _, _ := keeper.CreateTestInput(t, false)
-	handler := NewHandler(k)
-	node := types.TestNode
 	
-	require.Equal(t, 0, len(k.GetAllNodes(ctx)))
-	require.Equal(t, uint64(0), k.GetNodesCount(ctx))
+	node, _ = k.GetNode(ctx, node.ID)
+	require.True(t, node.Jailed)
+	require.Equal(t, sdk.NewInt64Coin("stake", 5), *node.Slashed)
 	
-	msg := NewMsgRegisterNode(node.Owner, node.Type, node.Version, node.Moniker, node.PricesPerGB, node.InternetSpeed, node.Encryption)
-	res := handler(ctx, *msg)
+	topUp = NewMsgTopUpNodeDeposit(types.TestAddress1, node.ID, sdk.NewInt64Coin("stake", 5))
+	res = handler(ctx, *topUp)
 	require.True(t, res.IsOK())
 	
-	node, found := k.GetNode(ctx, hub.NewNodeID(0))
-	require.Equal(t, true, found)
-	require.Equal(t, hub.NewNodeID(0), node.ID)
-	require.Equal(t, "moniker", node.Moniker)
+	node, _ = k.GetNode(ctx, node.ID)
+	require.False(t, node.Jailed)
+	require.Nil(t, node.Slashed)
+	require.Equal(t, sdk.NewInt64Coin("stake", 100), node.Deposit)
 	
-	count := k.GetNodesCount(ctx)
+	res = handler(ctx, *subscription)
+	require.True(t, res.IsOK())
+	
+	// a node in a free slot loses nothing to a slash, and is released by any top-up
+	node.ID = hub.NewNodeID(1)
+	node.Deposit = sdk.NewInt64Coin("stake", 0)
+	k.SetNode(ctx, node)
+	
+	_, err = k.SlashNode(ctx, node, types.TestAddress2)
+	require.Nil(t, err)
+	node, _ = k.GetNode(ctx, node.ID)
+	require.True(t, node.Jailed)
+	require.Equal(t, sdk.NewInt64Coin("stake", 0), *node.Slashed)
+	
+	topUp = NewMsgTopUpNodeDeposit(types.TestAddress1, node.ID, sdk.NewInt64Coin("stake", 1))
+	res = handler(ctx, *topUp)
+	require.True(t, res.IsOK())
+	
+	node, _ = k.GetNode(ctx, node.ID)
+	require.False(t, node.Jailed)
+	require.Equal(t, sdk.NewInt64Coin("stake", 1), node.Deposit)
+}
+
+func Test_handleOpenChannel(t *testing.T) {
+	ctx, k, dk, bk := keeper.CreateTestInput(t, false)
+	handler := NewHandler(k)
+	
+	msg := NewMsgOpenChannel(types.TestAddress2, hub.NewNodeID(0), sdk.NewInt64Coin("stake", 100), time.Hour)
+	res := handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	
+	node := types.TestNode
+	k.SetNode(ctx, node)
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	
+	node.Status = StatusRegistered
+	k.SetNode(ctx, node)
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
+	
+	channel, found := k.GetChannel(ctx, hub.NewChannelID(0))
+	require.Equal(t, true, found)
+	require.Equal(t, node.ID, channel.NodeID)
+	require.Equal(t, types.TestAddress2, channel.Client)
+	require.Equal(t, sdk.NewInt64Coin("stake", 100), channel.Deposit)
+	require.Equal(t, sdk.NewInt64Coin("stake", 0), channel.Redeemed)
+	require.Equal(t, ctx.BlockTime().Add(time.Hour), channel.ExpiresAt)
+	require.Equal(t, StatusActive, channel.Status)
+	require.Equal(t, uint64(1), k.GetChannelsCount(ctx))
+	require.Equal(t, []types.Channel{channel}, k.GetChannelsOfNode(ctx, node.ID))
+	require.Equal(t, []types.Channel{channel}, k.GetChannelsOfAddress(ctx, types.TestAddress2))
+	
+	deposit, found := dk.GetDeposit(ctx, types.TestAddress2)
+	require.Equal(t, true, found)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, deposit.Coins)
+	require.Equal(t, sdk.Coins(nil), bk.GetCoins(ctx, types.TestAddress2))
+}
+
+func Test_handleRedeemVoucher(t *testing.T) {
+	ctx, k, dk, bk := keeper.CreateTestInput(t, false)
+	handler := NewHandler(k)
+	
+	sign := func(amount sdk.Coin) auth.StdSignature {
+		data := hub.NewVoucherSignatureData(hub.NewChannelID(0), amount).Bytes()
+		signature, _ := types.TestPrivKey2.Sign(data)
+		return auth.StdSignature{PubKey: types.TestPubkey2, Signature: signature}
+	}
+	
+	amount := sdk.NewInt64Coin("stake", 30)
+	msg := NewMsgRedeemVoucher(types.TestAddress1, hub.NewChannelID(0), amount, sign(amount))
+	res := handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	
+	k.SetNode(ctx, types.TestNode)
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	require.Nil(t, k.LockDeposit(ctx, types.TestAddress2, types.TestChannel.ID, sdk.NewInt64Coin("stake", 100)))
+	k.AddChannel(ctx, types.TestChannel)
+	
+	msg = NewMsgRedeemVoucher(types.TestAddress2, hub.NewChannelID(0), amount, sign(amount))
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	
+	msg = NewMsgRedeemVoucher(types.TestAddress1, hub.NewChannelID(0), sdk.NewInt64Coin("stake", 40), sign(amount))
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	
+	msg = NewMsgRedeemVoucher(types.TestAddress1, hub.NewChannelID(0), amount, sign(amount))
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
+	
+	channel, _ := k.GetChannel(ctx, hub.NewChannelID(0))
+	require.Equal(t, amount, channel.Redeemed)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 30)}, bk.GetCoins(ctx, types.TestAddress1))
+	
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	
+	amount = sdk.NewInt64Coin("stake", 101)
+	msg = NewMsgRedeemVoucher(types.TestAddress1, hub.NewChannelID(0), amount, sign(amount))
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	
+	amount = sdk.NewInt64Coin("stake", 80)
+	msg = NewMsgRedeemVoucher(types.TestAddress1, hub.NewChannelID(0), amount, sign(amount))
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
+	
+	channel, _ = k.GetChannel(ctx, hub.NewChannelID(0))
+	require.Equal(t, amount, channel.Redeemed)
+	require.Equal(t, sdk.NewInt64Coin("stake", 20), channel.Unredeemed())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 80)}, bk.GetCoins(ctx, types.TestAddress1))
+	
+	deposit, _ := dk.GetDeposit(ctx, types.TestAddress2)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 20)}, deposit.Coins)
+}
+
+func Test_handleCloseChannel(t *testing.T) {
+	ctx, k, dk, bk := keeper.CreateTestInput(t, false)
+	handler := NewHandler(k)
+	
+	msg := NewMsgCloseChannel(types.TestAddress2, hub.NewChannelID(0))
+	res := handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	
+	k.SetNode(ctx, types.TestNode)
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	require.Nil(t, k.LockDeposit(ctx, types.TestAddress2, types.TestChannel.ID, sdk.NewInt64Coin("stake", 100)))
+	
+	channel := types.TestChannel
+	channel.ExpiresAt = ctx.BlockTime().Add(time.Hour)
+	k.AddChannel(ctx, channel)
+	
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	
+	msg = NewMsgCloseChannel(types.TestAddress3, hub.NewChannelID(0))
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	
+	ctx = ctx.WithBlockTime(channel.ExpiresAt)
+	msg = NewMsgCloseChannel(types.TestAddress2, hub.NewChannelID(0))
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
+	
+	channel, _ = k.GetChannel(ctx, hub.NewChannelID(0))
+	require.Equal(t, StatusInactive, channel.Status)
+	require.Equal(t, sdk.Coins(nil), bk.GetCoins(ctx, types.TestAddress2))
+	
+	deposit, _ := dk.GetDeposit(ctx, types.TestAddress2)
+	require.Equal(t, true, deposit.Locked().IsZero())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, deposit.Free())
+	
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	
+	require.Nil(t, k.LockDeposit(ctx, types.TestAddress2, hub.NewChannelID(1), sdk.NewInt64Coin("stake", 100)))
+	channel = types.TestChannel
+	channel.ID = hub.NewChannelID(1)
+	channel.Redeemed = sdk.NewInt64Coin("stake", 40)
+	channel.ExpiresAt = ctx.BlockTime().Add(time.Hour)
+	k.AddChannel(ctx, channel)
+	
+	msg = NewMsgCloseChannel(types.TestAddress1, channel.ID)
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
+	
+	channel, _ = k.GetChannel(ctx, channel.ID)
+	require.Equal(t, StatusInactive, channel.Status)
+	require.Equal(t, sdk.Coins(nil), bk.GetCoins(ctx, types.TestAddress2))
+	
+	deposit, _ = dk.GetDeposit(ctx, types.TestAddress2)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 60)}, deposit.Free())
+}
+
+func Test_HandleRegisterResolver(t *testing.T) {
+	ctx, k, dk, bk := keeper.CreateTestInput(t, false)
+	handler := NewHandler(k)
+	
+	resolver := types.TestResolver
+	
+	data, found := k.GetResolver(ctx, resolver.ID)
+	require.False(t, found)
+	require.Equal(t, uint64(0), k.GetResolverCount(ctx))
+	
+	msg := NewMsgRegisterResolver(resolver.Owner, resolver.Moniker, resolver.Endpoint,
+		resolver.Regions, resolver.Deposit, resolver.Commission, resolver.MaxCommissionRate, resolver.MaxCommissionChangeRate)
+	res := handler(ctx, msg)
+	require.False(t, res.IsOK())
+	
+	_, err := bk.AddCoins(ctx, types.TestAddress3, sdk.Coins{sdk.NewInt64Coin("stake", 200)})
+	require.Nil(t, err)
+	_, err = bk.AddCoins(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	
+	msg = NewMsgRegisterResolver(resolver.Owner, resolver.Moniker, resolver.Endpoint,
+		resolver.Regions, sdk.NewInt64Coin("stake", 99), resolver.Commission, resolver.MaxCommissionRate, resolver.MaxCommissionChangeRate)
+	res = handler(ctx, msg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorInvalidDeposit().ABCILog(), res.Log)
+	
+	msg = NewMsgRegisterResolver(resolver.Owner, resolver.Moniker, resolver.Endpoint,
+		resolver.Regions, resolver.Deposit, resolver.Commission, resolver.MaxCommissionRate, resolver.MaxCommissionChangeRate)
+	res = handler(ctx, msg)
+	require.True(t, res.IsOK())
+	
+	deposit, found := dk.GetDeposit(ctx, resolver.Owner)
+	require.True(t, found)
+	require.Equal(t, sdk.Coins{resolver.Deposit}, deposit.Coins)
+	
+	data, found = k.GetResolver(ctx, resolver.ID)
+	require.True(t, found)
+	require.Equal(t, data, resolver)
+	require.Equal(t, uint64(1), k.GetResolverCount(ctx))
+	require.Equal(t, types.ResolverCommissions{{ResolverID: resolver.ID, Commission: resolver.Commission}},
+		k.GetCommissionsOfResolver(ctx, resolver.ID))
+	require.Equal(t, uint64(1), k.GetResolversCountOfAddress(ctx, resolver.Owner))
+	
+	msg = NewMsgRegisterResolver(resolver.Owner, resolver.Moniker, resolver.Endpoint,
+		resolver.Regions, resolver.Deposit, resolver.Commission, resolver.MaxCommissionRate, resolver.MaxCommissionChangeRate)
+	res = handler(ctx, msg)
+	require.True(t, res.IsOK())
+	
+	data, found = k.GetResolver(ctx, hub.NewResolverID(1))
+	require.True(t, found)
+	require.Equal(t, uint64(2), k.GetResolverCount(ctx))
+	require.Equal(t, uint64(2), k.GetResolversCountOfAddress(ctx, resolver.Owner))
+	require.Equal(t, 2, len(k.GetResolversOfAddress(ctx, resolver.Owner)))
+	
+	resolver.Owner = types.TestAddress1
+	msg = NewMsgRegisterResolver(resolver.Owner, resolver.Moniker, resolver.Endpoint,
+		resolver.Regions, resolver.Deposit, resolver.Commission, resolver.MaxCommissionRate, resolver.MaxCommissionChangeRate)
+	res = handler(ctx, msg)
+	require.True(t, res.IsOK())
+	
+	data, found = k.GetResolver(ctx, hub.NewResolverID(2))
+	require.True(t, found)
+	require.Equal(t, uint64(3), k.GetResolverCount(ctx))
+	require.Equal(t, uint64(1), k.GetResolversCountOfAddress(ctx, types.TestAddress1))
+	require.Equal(t, 1, len(k.GetResolversOfAddress(ctx, types.TestAddress1)))
+	
+	updateResolverInfoMsg := NewMsgUpdateResolverInfo(types.TestAddress2, hub.NewResolverID(4), "", "", nil, decRef(sdk.NewDecWithPrec(2, 1)))
+	res = handler(ctx, updateResolverInfoMsg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorResolverDoesNotExist().ABCILog(), res.Log)
+	
+	updateResolverInfoMsg = NewMsgUpdateResolverInfo(types.TestAddress2, hub.NewResolverID(2), "", "", nil, decRef(sdk.NewDecWithPrec(2, 1)))
+	res = handler(ctx, updateResolverInfoMsg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorUnauthorized().ABCILog(), res.Log)
+	
+	resolver, _ = k.GetResolver(ctx, hub.NewResolverID(2))
+	resolver.Status = StatusDeRegistered
+	k.SetResolver(ctx, resolver)
+	
+	updateResolverInfoMsg = NewMsgUpdateResolverInfo(types.TestAddress1, hub.NewResolverID(2), "", "", nil, decRef(sdk.NewDecWithPrec(2, 1)))
+	res = handler(ctx, updateResolverInfoMsg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorInvalidResolverStatus().ABCILog(), res.Log)
+	
+	resolver.Status = StatusRegistered
+	k.SetResolver(ctx, resolver)
+	updateResolverInfoMsg = NewMsgUpdateResolverInfo(types.TestAddress1, hub.NewResolverID(2), "", "", nil, decRef(sdk.NewDecWithPrec(6, 1)))
+	res = handler(ctx, updateResolverInfoMsg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorCommissionRateTooHigh().ABCILog(), res.Log)
+	
+	updateResolverInfoMsg = NewMsgUpdateResolverInfo(types.TestAddress1, hub.NewResolverID(2), "", "", nil, decRef(sdk.NewDecWithPrec(3, 1)))
+	res = handler(ctx, updateResolverInfoMsg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorCommissionChangeTooHigh().ABCILog(), res.Log)
+	
+	updateResolverInfoMsg = NewMsgUpdateResolverInfo(types.TestAddress1, hub.NewResolverID(2), "", "", nil, decRef(sdk.NewDecWithPrec(2, 1)))
+	res = handler(ctx, updateResolverInfoMsg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorCommissionUpdatedTooSoon().ABCILog(), res.Log)
+	
+	updateResolverInfoMsg = NewMsgUpdateResolverInfo(types.TestAddress1, hub.NewResolverID(2), "moniker-0", "", nil, decRef(resolver.Commission))
+	res = handler(ctx, updateResolverInfoMsg)
+	require.True(t, res.IsOK())
+	
+	updateResolverInfoMsg = NewMsgUpdateResolverInfo(types.TestAddress1, hub.NewResolverID(2), "moniker-1", "", nil, nil)
+	res = handler(ctx, updateResolverInfoMsg)
+	require.True(t, res.IsOK())
+	
+	data, _ = k.GetResolver(ctx, hub.NewResolverID(2))
+	require.Equal(t, "moniker-1", data.Moniker)
+	require.Equal(t, resolver.Commission, data.Commission)
+	require.Equal(t, 1, len(k.GetCommissionsOfResolver(ctx, hub.NewResolverID(2))))
+	
+	ctx = ctx.WithBlockHeight(k.CommissionChangeInterval(ctx))
+	updateResolverInfoMsg = NewMsgUpdateResolverInfo(types.TestAddress1, hub.NewResolverID(2), "", "", nil, decRef(sdk.NewDecWithPrec(2, 1)))
+	res = handler(ctx, updateResolverInfoMsg)
+	require.True(t, res.IsOK())
+	
+	commissions := k.GetCommissionsOfResolver(ctx, hub.NewResolverID(2))
+	require.Equal(t, 2, len(commissions))
+	require.Equal(t, sdk.NewDecWithPrec(2, 1), commissions[1].Commission)
+	require.Equal(t, k.CommissionChangeInterval(ctx), commissions[1].Height)
+	
+	require.Equal(t, uint64(3), k.GetResolverCount(ctx))
+	require.Equal(t, uint64(1), k.GetResolversCountOfAddress(ctx, types.TestAddress1))
+	require.Equal(t, uint64(2), k.GetResolversCountOfAddress(ctx, types.TestAddress3))
+	require.Equal(t, 1, len(k.GetResolversOfAddress(ctx, types.TestAddress1)))
+	require.Equal(t, 2, len(k.GetResolversOfAddress(ctx, types.TestAddress3)))
+	
+	resolver, found = k.GetResolver(ctx, hub.NewResolverID(2))
+	require.True(t, found)
+	require.Equal(t, "moniker-1", resolver.Moniker)
+	require.Equal(t, sdk.NewDecWithPrec(2, 1), resolver.Commission)
+	require.Equal(t, k.CommissionChangeInterval(ctx), resolver.CommissionUpdatedAt)
+	
+	deRegisterResolverMsg := NewMsgDeregisterResolver(types.TestAddress2, hub.NewResolverID(k.GetResolverCount(ctx)+1))
+	res = handler(ctx, deRegisterResolverMsg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorResolverDoesNotExist().ABCILog(), res.Log)
+	
+	deRegisterResolverMsg = NewMsgDeregisterResolver(types.TestAddress2, hub.NewResolverID(2))
+	res = handler(ctx, deRegisterResolverMsg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorUnauthorized().ABCILog(), res.Log)
+	
+	resolver.Status = StatusDeRegistered
+	k.SetResolver(ctx, resolver)
+	deRegisterResolverMsg = NewMsgDeregisterResolver(types.TestAddress1, hub.NewResolverID(2))
+	res = handler(ctx, deRegisterResolverMsg)
+	require.False(t, res.IsOK())
+	
+	resolver.Status = StatusRegistered
+	k.SetResolver(ctx, resolver)
+	
+	deRegisterResolverMsg = NewMsgDeregisterResolver(types.TestAddress1, hub.NewResolverID(2))
+	res = handler(ctx, deRegisterResolverMsg)
+	require.True(t, res.IsOK())
+	
+	resolver, _ = k.GetResolver(ctx, hub.NewResolverID(2))
+	require.Equal(t, StatusUnbonding, resolver.Status)
+	require.Equal(t, sdk.Coins(nil), bk.GetCoins(ctx, types.TestAddress1))
+	
+	EndBlock(ctx.WithBlockTime(resolver.UnbondingCompletionTime), k)
+	resolver, _ = k.GetResolver(ctx, hub.NewResolverID(2))
+	require.Equal(t, StatusDeRegistered, resolver.Status)
+	require.Equal(t, sdk.Coins(nil), bk.GetCoins(ctx, types.TestAddress1))
+	
+	deposit, _ = dk.GetDeposit(ctx, types.TestAddress1)
+	require.Equal(t, true, deposit.Locked().IsZero())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, deposit.Free())
+}
+
+func Test_handleFreeClientsOfNode(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	handler := NewHandler(k)
+	node := types.TestNode
+	
+	require.Equal(t, 0, len(k.GetAllNodes(ctx)))
+	require.Equal(t, uint64(0), k.GetNodesCount(ctx))
+	
+	msg := NewMsgRegisterNode(node.Owner, node.Type, node.Version, node.Moniker, node.PricesPerGB, node.InternetSpeed, node.Encryption)
+	res := handler(ctx, *msg)
+	require.True(t, res.IsOK())
+	
+	node, found := k.GetNode(ctx, hub.NewNodeID(0))
+	require.Equal(t, true, found)
+	require.Equal(t, hub.NewNodeID(0), node.ID)
+	require.Equal(t, "moniker", node.Moniker)
+	
+	count := k.GetNodesCount(ctx)
 	require.Equal(t, uint64(1), count)
 	
 	count = k.GetNodesCountOfAddress(ctx, types.TestAddress1)
 	require.Equal(t, uint64(1), count)
 	
-	addClientMsg := NewMsgAddFreeClient(types.TestAddress2, hub.NewNodeID(3), types.TestAddress1)
+	addClientMsg := NewMsgAddFreeClient(types.TestAddress2, hub.NewNodeID(3), types.TestAddress1, hub.NewBandwidthFromInt64(0, 0), 0, 0)
 	res = handler(ctx, *addClientMsg)
 	require.False(t, res.IsOK())
 	require.Equal(t, types.ErrorNodeDoesNotExist().ABCILog(), res.Log)
 	
-	addClientMsg = NewMsgAddFreeClient(types.TestAddress2, node.ID, types.TestAddress1)
+	addClientMsg = NewMsgAddFreeClient(types.TestAddress2, node.ID, types.TestAddress1, hub.NewBandwidthFromInt64(0, 0), 0, 0)
 	res = handler(ctx, *addClientMsg)
 	require.False(t, res.IsOK())
 	require.Equal(t, types.ErrorUnauthorized().ABCILog(), res.Log)
 	
-	addClientMsg = NewMsgAddFreeClient(node.Owner, node.ID, types.TestAddress2)
+	addClientMsg = NewMsgAddFreeClient(node.Owner, node.ID, types.TestAddress2, hub.NewBandwidthFromInt64(0, 0), 0, 0)
 	res = handler(ctx, *addClientMsg)
 	require.True(t, res.IsOK())
 	
@@ -939,7 +1435,7 @@ func Test_handleFreeClientsOfNode(t *testing.T) {
 	node.Status = types.StatusDeRegistered
 	k.SetNode(ctx, node)
 	
-	addClientMsg = NewMsgAddFreeClient(types.TestAddress1, node.ID, types.TestAddress1)
+	addClientMsg = NewMsgAddFreeClient(types.TestAddress1, node.ID, types.TestAddress1, hub.NewBandwidthFromInt64(0, 0), 0, 0)
 	res = handler(ctx, *addClientMsg)
 	require.False(t, res.IsOK())
 	require.Equal(t, types.ErrorInvalidNodeStatus().ABCILog(), res.Log)
@@ -1006,9 +1502,37 @@ func Test_UpdateVPNOrResolver(t *testing.T) {
 	res = handler(ctx, *addMsg)
 	require.True(t, res.IsOK())
 	
+	require.Equal(t, []hub.NodeID{node.ID}, k.GetPendingNodesOfResolver(ctx, resolver.ID))
+	require.Equal(t, 0, len(k.GetResolversOfNode(ctx, node.ID)))
+	
+	addMsg = NewMsgRegisterVPNOnResolver(types.TestAddress1, node.ID, resolver.ID)
+	res = handler(ctx, *addMsg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorListingAlreadyExists().ABCILog(), res.Log)
+	
+	acceptMsg := NewMsgAcceptVPNOnResolver(types.TestAddress1, resolver.ID, node.ID)
+	res = handler(ctx, acceptMsg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorUnauthorized().ABCILog(), res.Log)
+	
+	acceptMsg = NewMsgAcceptVPNOnResolver(types.TestAddress3, resolver.ID, hub.NewNodeID(3))
+	res = handler(ctx, acceptMsg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorListingRequestDoesNotExist().ABCILog(), res.Log)
+	
+	acceptMsg = NewMsgAcceptVPNOnResolver(types.TestAddress3, resolver.ID, node.ID)
+	res = handler(ctx, acceptMsg)
+	require.True(t, res.IsOK())
+	
+	require.Equal(t, 0, len(k.GetPendingNodesOfResolver(ctx, resolver.ID)))
 	require.Equal(t, 1, len(k.GetResolversOfNode(ctx, node.ID)))
 	require.Equal(t, 1, len(k.GetNodesOfResolver(ctx, resolver.ID)))
 	
+	addMsg = NewMsgRegisterVPNOnResolver(types.TestAddress1, node.ID, resolver.ID)
+	res = handler(ctx, *addMsg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorListingAlreadyExists().ABCILog(), res.Log)
+	
 	resolver.Status = types.StatusDeRegistered
 	k.SetResolver(ctx, resolver)
 	
@@ -1062,4 +1586,430 @@ func Test_UpdateVPNOrResolver(t *testing.T) {
 	res = handler(ctx, *removeMsg)
 	require.True(t, res.IsOK())
 	
+	removeMsg = NewMsgDeregisterVPNOnResolver(types.TestAddress1, node.ID, resolver.ID)
+	res = handler(ctx, *removeMsg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorListingDoesNotExist().ABCILog(), res.Log)
+	
+	k.SetPendingNodeOfResolver(ctx, resolver.ID, node.ID)
+	
+	rejectMsg := NewMsgRejectVPNOnResolver(types.TestAddress1, resolver.ID, node.ID)
+	res = handler(ctx, rejectMsg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorUnauthorized().ABCILog(), res.Log)
+	
+	rejectMsg = NewMsgRejectVPNOnResolver(types.TestAddress3, resolver.ID, node.ID)
+	res = handler(ctx, rejectMsg)
+	require.True(t, res.IsOK())
+	require.Equal(t, 0, len(k.GetPendingNodesOfResolver(ctx, resolver.ID)))
+	
+	res = handler(ctx, rejectMsg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorListingRequestDoesNotExist().ABCILog(), res.Log)
+	
+	k.SetResolverOfNode(ctx, node.ID, resolver.ID)
+	k.SetNodeOfResolver(ctx, resolver.ID, node.ID)
+	
+	removeMsg = NewMsgDeregisterVPNOnResolver(types.TestAddress3, node.ID, resolver.ID)
+	res = handler(ctx, *removeMsg)
+	require.True(t, res.IsOK())
+	require.Equal(t, 0, len(k.GetResolversOfNode(ctx, node.ID)))
+	require.Equal(t, 0, len(k.GetNodesOfResolver(ctx, resolver.ID)))
+}
+
+func Test_EndBlock(t *testing.T) {
+	ctx, k, _, bk := keeper.CreateTestInput(t, false)
+	
+	session := types.TestSession
+	k.SetNode(ctx, types.TestNode)
+	k.SetResolver(ctx, types.TestResolver)
+	k.SetSubscription(ctx, types.TestSubscription)
+	k.SetSession(ctx, session)
+	k.AddSessionIDToActiveList(ctx, session.StatusModifiedAt, session.ID)
+	
+	ctx = ctx.WithBlockHeight(session.StatusModifiedAt + k.SessionInactiveInterval(ctx))
+	require.NotPanics(t, func() { EndBlock(ctx, k) })
+	
+	result, _ := k.GetSession(ctx, session.ID)
+	require.Equal(t, StatusInactive, result.Status)
+	require.Equal(t, ctx.BlockHeight(), result.StatusModifiedAt)
+	_, found := k.GetSettlement(ctx, session.ID)
+	require.Equal(t, false, found)
+	require.Len(t, k.GetActiveSessionIDs(ctx, session.StatusModifiedAt), 0)
+	require.Len(t, k.GetActiveSessionIDs(ctx, ctx.BlockHeight()), 0)
+	require.Equal(t, EventTypeAbortSession, ctx.EventManager().Events()[0].Type)
+	
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + k.SessionInactiveInterval(ctx))
+	require.NotPanics(t, func() { EndBlock(ctx, k) })
+	result, _ = k.GetSession(ctx, session.ID)
+	require.Equal(t, StatusInactive, result.Status)
+	
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	err = k.LockDeposit(ctx, types.TestAddress2, hub.NewSubscriptionID(0), sdk.NewInt64Coin("stake", 100))
+	require.Nil(t, err)
+	
+	session.ID = hub.NewSessionID(1)
+	session.StatusModifiedAt = ctx.BlockHeight()
+	k.SetSession(ctx, session)
+	k.AddSessionIDToActiveList(ctx, session.StatusModifiedAt, session.ID)
+	
+	ctx = ctx.WithBlockHeight(session.StatusModifiedAt + k.SessionInactiveInterval(ctx))
+	EndBlock(ctx, k)
+	
+	result, _ = k.GetSession(ctx, session.ID)
+	require.Equal(t, StatusInactive, result.Status)
+	settlement, found := k.GetSettlement(ctx, session.ID)
+	require.Equal(t, true, found)
+	require.Equal(t, sdk.NewInt64Coin("stake", 100), settlement.Amount)
+	require.Len(t, k.GetActiveSessionIDs(ctx, ctx.BlockHeight()-k.SessionInactiveInterval(ctx)), 0)
+}
+
+func Test_EndBlockAbortUnbonding(t *testing.T) {
+	ctx, k, dk, bk := keeper.CreateTestInput(t, false)
+	ctx = ctx.WithBlockTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	
+	_, err := bk.AddCoins(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	
+	node := types.TestNode
+	node.Status = StatusUnbonding
+	node.UnbondingCompletionTime = ctx.BlockTime()
+	k.SetNode(ctx, node)
+	k.AddNodeIDToUnbondingQueue(ctx, node.UnbondingCompletionTime, node.ID)
+	err = k.LockDeposit(ctx, node.Owner, node.ID, sdk.NewInt64Coin("stake", 60))
+	require.Nil(t, err)
+	
+	resolver := types.TestResolver
+	resolver.Owner = types.TestAddress1
+	resolver.Status = StatusUnbonding
+	resolver.UnbondingCompletionTime = ctx.BlockTime()
+	k.SetResolver(ctx, resolver)
+	k.AddResolverIDToUnbondingQueue(ctx, resolver.UnbondingCompletionTime, resolver.ID)
+	err = k.LockDeposit(ctx, resolver.Owner, resolver.ID, sdk.NewInt64Coin("stake", 40))
+	require.Nil(t, err)
+	
+	require.NotPanics(t, func() { EndBlock(ctx, k) })
+	
+	node, _ = k.GetNode(ctx, node.ID)
+	require.Equal(t, StatusDeRegistered, node.Status)
+	resolver, _ = k.GetResolver(ctx, resolver.ID)
+	require.Equal(t, StatusDeRegistered, resolver.Status)
+	
+	var nodes []hub.NodeID
+	k.IterateUnbondedNodeIDs(ctx, ctx.BlockTime(), func(_ int64, id hub.NodeID) bool {
+		nodes = append(nodes, id)
+		return false
+	})
+	require.Len(t, nodes, 0)
+	
+	var resolvers []hub.ResolverID
+	k.IterateUnbondedResolverIDs(ctx, ctx.BlockTime(), func(_ int64, id hub.ResolverID) bool {
+		resolvers = append(resolvers, id)
+		return false
+	})
+	require.Len(t, resolvers, 0)
+	
+	deposit, _ := dk.GetDeposit(ctx, types.TestAddress1)
+	require.Equal(t, true, deposit.Locked().IsZero())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, deposit.Free())
+}
+
+func Test_handleStartSubscriptionWithPlan(t *testing.T) {
+	ctx, k, dk, bk := keeper.CreateTestInput(t, false)
+	ctx = ctx.WithBlockTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	handler := NewHandler(k)
+	
+	node := types.TestNode
+	node.Status = StatusRegistered
+	k.SetNode(ctx, node)
+	k.SetResolver(ctx, types.TestResolver)
+	k.SetResolverOfNode(ctx, node.ID, types.TestResolver.ID)
+	
+	plans := []types.Plan{{Type: PlanTypePerHour, Price: sdk.NewInt64Coin("stake", 30)}}
+	res := handler(ctx, *NewMsgUpdateNodePlans(types.TestAddress2, node.ID, plans))
+	require.False(t, res.IsOK())
+	res = handler(ctx, *NewMsgUpdateNodePlans(node.Owner, node.ID, plans))
+	require.True(t, res.IsOK())
+	
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	
+	res = handler(ctx, *NewMsgStartSubscription(types.TestAddress2, types.TestResolver.ID, node.ID, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, 2))
+	require.False(t, res.IsOK())
+	res = handler(ctx, *NewMsgStartSubscription(types.TestAddress2, types.TestResolver.ID, node.ID, sdk.Coins{sdk.NewInt64Coin("stake", 20)}, 1))
+	require.False(t, res.IsOK())
+	res = handler(ctx, *NewMsgStartSubscription(types.TestAddress2, types.TestResolver.ID, node.ID,
+		sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewIntWithDecimal(1, 30)}}, 1))
+	require.Equal(t, types.ErrorDurationExceedsMax().Code(), res.Code)
+	res = handler(ctx, *NewMsgStartSubscription(types.TestAddress2, types.TestResolver.ID, node.ID, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, 1))
+	require.True(t, res.IsOK())
+	
+	subscription, found := k.GetSubscription(ctx, hub.NewSubscriptionID(0))
+	require.Equal(t, true, found)
+	require.Equal(t, PlanTypePerHour, subscription.Plan.Type)
+	require.Equal(t, types.TestBandwidthZero, subscription.RemainingBandwidth)
+	require.Equal(t, ctx.BlockTime().Add(3*time.Hour), subscription.ExpiresAt)
+	require.Nil(t, subscription.IsValid())
+	
+	EndBlock(ctx.WithBlockTime(subscription.ExpiresAt.Add(-time.Second)), k)
+	subscription, _ = k.GetSubscription(ctx, subscription.ID)
+	require.Equal(t, StatusActive, subscription.Status)
+	
+	EndBlock(ctx.WithBlockTime(subscription.ExpiresAt), k)
+	subscription, _ = k.GetSubscription(ctx, subscription.ID)
+	require.Equal(t, StatusInactive, subscription.Status)
+	require.Equal(t, sdk.NewInt64Coin("stake", 0), subscription.RemainingDeposit)
+	
+	require.Equal(t, sdk.Coins(nil), bk.GetCoins(ctx, types.TestAddress2))
+	deposit, _ := dk.GetDeposit(ctx, types.TestAddress2)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, deposit.Free())
+	paid := bk.GetCoins(ctx, node.Owner).Add(bk.GetCoins(ctx, types.TestResolver.Owner))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 90)}, paid)
+}
+
+func Test_handleStartSubscriptionWithSeveralDenoms(t *testing.T) {
+	ctx, k, dk, bk := keeper.CreateTestInput(t, false)
+	ctx = ctx.WithBlockTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	handler := NewHandler(k)
+	
+	node := types.TestNode
+	node.Status = StatusRegistered
+	node.Plans = []types.Plan{{Type: PlanTypePerHour, Price: sdk.NewInt64Coin("stake", 30)}}
+	k.SetNode(ctx, node)
+	k.SetResolver(ctx, types.TestResolver)
+	k.SetResolverOfNode(ctx, node.ID, types.TestResolver.ID)
+	
+	coins := sdk.Coins{sdk.NewInt64Coin("atom", 200), sdk.NewInt64Coin("stake", 50)}
+	_, err := bk.AddCoins(ctx, types.TestAddress2, coins)
+	require.Nil(t, err)
+	
+	res := handler(ctx, *NewMsgStartSubscription(types.TestAddress2, types.TestResolver.ID, node.ID, coins, 1))
+	require.Equal(t, types.ErrorInvalidDeposit().Code(), res.Code)
+	
+	params := k.GetParams(ctx)
+	params.ExchangeRates = types.ExchangeRates{
+		types.NewExchangeRate("atom", sdk.NewDecWithPrec(5, 1)),
+		types.NewExchangeRate("stake", sdk.NewDec(2)),
+		types.NewExchangeRate("udvpn", sdk.NewDec(3)),
+	}
+	k.SetParams(ctx, params)
+	
+	res = handler(ctx, *NewMsgStartSubscription(types.TestAddress2, types.TestResolver.ID, node.ID, coins, 1))
+	require.True(t, res.IsOK())
+	
+	subscription, found := k.GetSubscription(ctx, hub.NewSubscriptionID(0))
+	require.Equal(t, true, found)
+	require.Equal(t, sdk.NewInt64Coin("atom", 120), subscription.Plan.Price)
+	require.Equal(t, sdk.NewInt64Coin("atom", 400), subscription.TotalDeposit)
+	require.Equal(t, coins, subscription.Deposits)
+	require.Equal(t, params.ExchangeRates[:2], subscription.ExchangeRates)
+	require.Equal(t, ctx.BlockTime().Add(3*time.Hour), subscription.ExpiresAt)
+	require.Nil(t, subscription.IsValid())
+	
+	deposit, _ := dk.GetDeposit(ctx, types.TestAddress2)
+	require.Equal(t, coins, deposit.Locked())
+	
+	EndBlock(ctx.WithBlockTime(subscription.ExpiresAt), k)
+	subscription, _ = k.GetSubscription(ctx, subscription.ID)
+	require.Equal(t, StatusInactive, subscription.Status)
+	require.Equal(t, true, subscription.Deposits.Empty())
+	
+	deposit, _ = dk.GetDeposit(ctx, types.TestAddress2)
+	require.Equal(t, true, deposit.Locked().IsZero())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, deposit.Free())
+	paid := bk.GetCoins(ctx, node.Owner).Add(bk.GetCoins(ctx, types.TestResolver.Owner))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("atom", 200), sdk.NewInt64Coin("stake", 40)}, paid)
+}
+
+func Test_handleStartSubscriptionMaxPricesPerGB(t *testing.T) {
+	ctx, k, _, bk := keeper.CreateTestInput(t, false)
+	handler := NewHandler(k)
+	
+	node := types.TestNode
+	node.Status = StatusRegistered
+	k.SetNode(ctx, node)
+	k.SetResolver(ctx, types.TestResolver)
+	k.SetResolverOfNode(ctx, node.ID, types.TestResolver.ID)
+	
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("atom", 800)})
+	require.Nil(t, err)
+	
+	params := k.GetParams(ctx)
+	params.ExchangeRates = types.ExchangeRates{
+		types.NewExchangeRate("atom", sdk.NewDecWithPrec(5, 1)),
+		types.NewExchangeRate("stake", sdk.NewDec(2)),
+	}
+	params.MaxPricesPerGB = sdk.Coins{sdk.NewInt64Coin("atom", 300)}
+	k.SetParams(ctx, params)
+	
+	res := handler(ctx, *NewMsgStartSubscription(types.TestAddress2, types.TestResolver.ID, node.ID, sdk.Coins{sdk.NewInt64Coin("atom", 400)}, 0))
+	require.Equal(t, types.ErrorPricesExceedMax().Code(), res.Code)
+	
+	params.MaxPricesPerGB = sdk.Coins{sdk.NewInt64Coin("atom", 400)}
+	k.SetParams(ctx, params)
+	
+	res = handler(ctx, *NewMsgStartSubscription(types.TestAddress2, types.TestResolver.ID, node.ID, sdk.Coins{sdk.NewInt64Coin("atom", 400)}, 0))
+	require.True(t, res.IsOK())
+	
+	subscription, _ := k.GetSubscription(ctx, hub.NewSubscriptionID(0))
+	require.Equal(t, sdk.NewInt64Coin("atom", 400), subscription.PricePerGB)
+}
+
+func Test_EndBlockAbortSubscription(t *testing.T) {
+	ctx, k, dk, bk := keeper.CreateTestInput(t, false)
+	ctx = ctx.WithBlockTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	handler := NewHandler(k)
+	
+	node := types.TestNode
+	node.Status = StatusRegistered
+	node.Plans = []types.Plan{{Type: PlanTypePerHour, Price: sdk.NewInt64Coin("stake", 30)}}
+	k.SetNode(ctx, node)
+	k.SetResolver(ctx, types.TestResolver)
+	k.SetResolverOfNode(ctx, node.ID, types.TestResolver.ID)
+	
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	res := handler(ctx, *NewMsgStartSubscription(types.TestAddress2, types.TestResolver.ID, node.ID, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, 1))
+	require.True(t, res.IsOK())
+	
+	subscription, _ := k.GetSubscription(ctx, hub.NewSubscriptionID(0))
+	err = k.UnlockDeposit(ctx, types.TestAddress2, subscription.ID, sdk.NewInt64Coin("stake", 50))
+	require.Nil(t, err)
+	
+	ctx = ctx.WithBlockTime(subscription.ExpiresAt).WithEventManager(sdk.NewEventManager())
+	require.NotPanics(t, func() { EndBlock(ctx, k) })
+	
+	subscription, _ = k.GetSubscription(ctx, subscription.ID)
+	require.Equal(t, StatusInactive, subscription.Status)
+	require.Equal(t, sdk.NewInt64Coin("stake", 0), subscription.RemainingDeposit)
+	require.Equal(t, EventTypeAbortSubscription, ctx.EventManager().Events()[len(ctx.EventManager().Events())-1].Type)
+	
+	var expired []hub.SubscriptionID
+	k.IterateExpiredSubscriptionIDs(ctx, ctx.BlockTime(), func(_ int64, id hub.SubscriptionID) bool {
+		expired = append(expired, id)
+		return false
+	})
+	require.Len(t, expired, 0)
+	
+	deposit, _ := dk.GetDeposit(ctx, types.TestAddress2)
+	require.Equal(t, true, deposit.Locked().IsZero())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, deposit.Free())
+}
+
+func Test_handleStartSubscriptionWithFreeClient(t *testing.T) {
+	ctx, k, dk, bk := keeper.CreateTestInput(t, false)
+	ctx = ctx.WithBlockHeight(1)
+	handler := NewHandler(k)
+	
+	node := types.TestNode
+	node.Status = StatusRegistered
+	k.SetNode(ctx, node)
+	k.SetResolver(ctx, types.TestResolver)
+	k.SetResolverOfNode(ctx, node.ID, types.TestResolver.ID)
+	
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 200)})
+	require.Nil(t, err)
+	
+	res := handler(ctx, *NewMsgAddFreeClient(node.Owner, node.ID, types.TestAddress2, types.TestBandwidthPos1, 1, 1))
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorInvalidField("expires_at").ABCILog(), res.Log)
+	res = handler(ctx, *NewMsgAddFreeClient(node.Owner, node.ID, types.TestAddress2, types.TestBandwidthPos1, 10, 1))
+	require.True(t, res.IsOK())
+	
+	freeClient, found := k.GetFreeClient(ctx, node.ID, types.TestAddress2)
+	require.Equal(t, true, found)
+	require.Equal(t, types.TestBandwidthPos1, freeClient.Bandwidth)
+	require.Equal(t, int64(10), freeClient.ExpiresAt)
+	require.Equal(t, uint64(1), freeClient.MaxSubscriptions)
+	
+	msg := NewMsgStartSubscription(types.TestAddress2, types.TestResolver.ID, node.ID, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, 0)
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, bk.GetCoins(ctx, types.TestAddress2))
+	subscription, _ := k.GetSubscription(ctx, hub.NewSubscriptionID(0))
+	require.Equal(t, true, subscription.DepositLocked)
+	
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorFreeClientLimitReached().ABCILog(), res.Log)
+	
+	res = handler(ctx, *NewMsgEndSubscription(types.TestAddress2, hub.NewSubscriptionID(0)))
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, bk.GetCoins(ctx, types.TestAddress2))
+	
+	deposit, _ := dk.GetDeposit(ctx, types.TestAddress2)
+	require.Equal(t, true, deposit.Locked().IsZero())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, deposit.Free())
+	
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
+	require.Equal(t, types.EventTypeFreeClientExpired, res.Events[0].Type)
+	require.Equal(t, 0, len(k.GetFreeClientsOfNode(ctx, node.ID)))
+	
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
+	require.Equal(t, true, bk.GetCoins(ctx, types.TestAddress2).IsZero())
+}
+
+func Test_handleRemoveFreeClientDuringSubscription(t *testing.T) {
+	ctx, k, dk, bk := keeper.CreateTestInput(t, false)
+	ctx = ctx.WithBlockHeight(1)
+	handler := NewHandler(k)
+	
+	node := types.TestNode
+	node.Status = StatusRegistered
+	k.SetNode(ctx, node)
+	k.SetResolver(ctx, types.TestResolver)
+	k.SetResolverOfNode(ctx, node.ID, types.TestResolver.ID)
+	
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	
+	res := handler(ctx, *NewMsgAddFreeClient(node.Owner, node.ID, types.TestAddress2, types.TestBandwidthZero, 0, 0))
+	require.True(t, res.IsOK())
+	
+	msg := NewMsgStartSubscription(types.TestAddress2, types.TestResolver.ID, node.ID, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, 0)
+	for i := 0; i < 2; i++ {
+		res = handler(ctx, *msg)
+		require.True(t, res.IsOK())
+		res = handler(ctx, *NewMsgStartSession(types.TestAddress2, hub.NewSubscriptionID(uint64(i))))
+		require.True(t, res.IsOK())
+	}
+	
+	subscription, _ := k.GetSubscription(ctx, hub.NewSubscriptionID(0))
+	require.Equal(t, false, subscription.DepositLocked)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, bk.GetCoins(ctx, types.TestAddress2))
+	
+	// the grant is replaced, the subscription ends with the settlement of its session
+	res = handler(ctx, *NewMsgAddFreeClient(node.Owner, node.ID, types.TestAddress2, types.TestBandwidthPos1, 0, 0))
+	require.True(t, res.IsOK())
+	res = handler(ctx, *NewMsgStartSession(types.TestAddress2, subscription.ID))
+	require.Equal(t, types.ErrorFreeClientDoesNotExist().Code(), res.Code)
+	
+	session, _ := k.GetSession(ctx, hub.NewSessionID(0))
+	_, err = k.SettleSession(ctx, session)
+	require.Nil(t, err)
+	subscription, _ = k.GetSubscription(ctx, subscription.ID)
+	require.Equal(t, StatusInactive, subscription.Status)
+	
+	// the grant is removed, the subscriptions which were not locked end at once
+	res = handler(ctx, *NewMsgRemoveFreeClient(node.Owner, node.ID, types.TestAddress2))
+	require.True(t, res.IsOK())
+	
+	subscription, _ = k.GetSubscription(ctx, hub.NewSubscriptionID(1))
+	require.Equal(t, StatusInactive, subscription.Status)
+	session, _ = k.GetSession(ctx, hub.NewSessionID(1))
+	require.Equal(t, StatusInactive, session.Status)
+	res = handler(ctx, *NewMsgStartSession(types.TestAddress2, subscription.ID))
+	require.False(t, res.IsOK())
+	
+	deposit, _ := dk.GetDeposit(ctx, types.TestAddress2)
+	require.Equal(t, true, deposit.Locked().IsZero())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, bk.GetCoins(ctx, types.TestAddress2))
+}
+
+func decRef(d sdk.Dec) *sdk.Dec {
+	return &d
 }
@@ -0,0 +1,200 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func (k Keeper) SetChannelsCount(ctx sdk.Context, count uint64) {
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(count)
+	
+	store := ctx.KVStore(k.subscriptionKey)
+	store.Set(types.ChannelsCountKey, value)
+}
+
+func (k Keeper) GetChannelsCount(ctx sdk.Context) (count uint64) {
+	store := ctx.KVStore(k.subscriptionKey)
+	
+	value := store.Get(types.ChannelsCountKey)
+	if value == nil {
+		return 0
+	}
+	
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &count)
+	return count
+}
+
+func (k Keeper) SetChannel(ctx sdk.Context, channel types.Channel) {
+	key := types.ChannelKey(channel.ID)
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(channel)
+	
+	store := ctx.KVStore(k.subscriptionKey)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetChannel(ctx sdk.Context, id hub.ChannelID) (channel types.Channel, found bool) {
+	store := ctx.KVStore(k.subscriptionKey)
+	
+	key := types.ChannelKey(id)
+	value := store.Get(key)
+	if value == nil {
+		return channel, false
+	}
+	
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &channel)
+	return channel, true
+}
+
+func (k Keeper) SetChannelsCountOfNode(ctx sdk.Context, id hub.NodeID, count uint64) {
+	key := types.ChannelsCountOfNodeKey(id)
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(count)
+	
+	store := ctx.KVStore(k.subscriptionKey)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetChannelsCountOfNode(ctx sdk.Context, id hub.NodeID) (count uint64) {
+	store := ctx.KVStore(k.subscriptionKey)
+	
+	key := types.ChannelsCountOfNodeKey(id)
+	value := store.Get(key)
+	if value == nil {
+		return 0
+	}
+	
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &count)
+	return count
+}
+
+func (k Keeper) SetChannelIDByNodeID(ctx sdk.Context, i hub.NodeID, j uint64, id hub.ChannelID) {
+	key := types.ChannelIDByNodeIDKey(i, j)
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(id)
+	
+	store := ctx.KVStore(k.subscriptionKey)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetChannelIDByNodeID(ctx sdk.Context, i hub.NodeID, j uint64) (id hub.ChannelID, found bool) {
+	store := ctx.KVStore(k.subscriptionKey)
+	
+	key := types.ChannelIDByNodeIDKey(i, j)
+	value := store.Get(key)
+	if value == nil {
+		return nil, false
+	}
+	
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &id)
+	return id, true
+}
+
+func (k Keeper) SetChannelsCountOfAddress(ctx sdk.Context, address sdk.AccAddress, count uint64) {
+	key := types.ChannelsCountOfAddressKey(address)
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(count)
+	
+	store := ctx.KVStore(k.subscriptionKey)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetChannelsCountOfAddress(ctx sdk.Context, address sdk.AccAddress) (count uint64) {
+	store := ctx.KVStore(k.subscriptionKey)
+	
+	key := types.ChannelsCountOfAddressKey(address)
+	value := store.Get(key)
+	if value == nil {
+		return 0
+	}
+	
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &count)
+	return count
+}
+
+func (k Keeper) SetChannelIDByAddress(ctx sdk.Context, address sdk.AccAddress, i uint64, id hub.ChannelID) {
+	key := types.ChannelIDByAddressKey(address, i)
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(id)
+	
+	store := ctx.KVStore(k.subscriptionKey)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetChannelIDByAddress(ctx sdk.Context,
+	address sdk.AccAddress, i uint64) (id hub.ChannelID, found bool) {
+	store := ctx.KVStore(k.subscriptionKey)
+	
+	key := types.ChannelIDByAddressKey(address, i)
+	value := store.Get(key)
+	if value == nil {
+		return nil, false
+	}
+	
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &id)
+	return id, true
+}
+
+// AddChannel stores a new channel and indexes it by its node and its client
+func (k Keeper) AddChannel(ctx sdk.Context, channel types.Channel) {
+	k.SetChannel(ctx, channel)
+	
+	cn := k.GetChannelsCountOfNode(ctx, channel.NodeID)
+	k.SetChannelIDByNodeID(ctx, channel.NodeID, cn, channel.ID)
+	k.SetChannelsCountOfNode(ctx, channel.NodeID, cn+1)
+	
+	ca := k.GetChannelsCountOfAddress(ctx, channel.Client)
+	k.SetChannelIDByAddress(ctx, channel.Client, ca, channel.ID)
+	k.SetChannelsCountOfAddress(ctx, channel.Client, ca+1)
+}
+
+func (k Keeper) GetChannelsOfNode(ctx sdk.Context, id hub.NodeID) (channels []types.Channel) {
+	count := k.GetChannelsCountOfNode(ctx, id)
+	
+	channels = make([]types.Channel, 0, count)
+	for i := uint64(0); i < count; i++ {
+		_id, _ := k.GetChannelIDByNodeID(ctx, id, i)
+		
+		channel, _ := k.GetChannel(ctx, _id)
+		channels = append(channels, channel)
+	}
+	
+	return channels
+}
+
+func (k Keeper) GetChannelsOfAddress(ctx sdk.Context, address sdk.AccAddress) (channels []types.Channel) {
+	count := k.GetChannelsCountOfAddress(ctx, address)
+	
+	channels = make([]types.Channel, 0, count)
+	for i := uint64(0); i < count; i++ {
+		id, _ := k.GetChannelIDByAddress(ctx, address, i)
+		
+		channel, _ := k.GetChannel(ctx, id)
+		channels = append(channels, channel)
+	}
+	
+	return channels
+}
+
+func (k Keeper) GetAllChannels(ctx sdk.Context) (channels []types.Channel) {
+	k.IterateChannels(ctx, func(_ int64, channel types.Channel) bool {
+		channels = append(channels, channel)
+		return false
+	})
+	
+	return channels
+}
+
+func (k Keeper) IterateChannels(ctx sdk.Context, fn func(index int64, channel types.Channel) (stop bool)) {
+	store := ctx.KVStore(k.subscriptionKey)
+	
+	iterator := sdk.KVStorePrefixIterator(store, types.ChannelKeyPrefix)
+	defer iterator.Close()
+	
+	for i := int64(0); iterator.Valid(); iterator.Next() {
+		var channel types.Channel
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &channel)
+		
+		if stop := fn(i, channel); stop {
+			break
+		}
+		i++
+	}
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"testing"
+	
+	"github.com/stretchr/testify/require"
+	
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func TestKeeper_SetChannelsCount(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+	
+	count := k.GetChannelsCount(ctx)
+	require.Equal(t, uint64(0), count)
+	
+	k.SetChannelsCount(ctx, 1)
+	count = k.GetChannelsCount(ctx)
+	require.Equal(t, uint64(1), count)
+	
+	k.SetChannelsCount(ctx, 2)
+	count = k.GetChannelsCount(ctx)
+	require.Equal(t, uint64(2), count)
+}
+
+func TestKeeper_GetChannelsCount(t *testing.T) {
+	TestKeeper_SetChannelsCount(t)
+}
+
+func TestKeeper_SetChannel(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+	
+	_, found := k.GetChannel(ctx, hub.NewChannelID(0))
+	require.Equal(t, false, found)
+	
+	k.SetChannel(ctx, types.TestChannel)
+	result, found := k.GetChannel(ctx, types.TestChannel.ID)
+	require.Equal(t, true, found)
+	require.Equal(t, types.TestChannel, result)
+}
+
+func TestKeeper_GetChannel(t *testing.T) {
+	TestKeeper_SetChannel(t)
+}
+
+func TestKeeper_AddChannel(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+	
+	channels := k.GetChannelsOfNode(ctx, types.TestChannel.NodeID)
+	require.Equal(t, []types.Channel{}, channels)
+	channels = k.GetChannelsOfAddress(ctx, types.TestChannel.Client)
+	require.Equal(t, []types.Channel{}, channels)
+	
+	k.AddChannel(ctx, types.TestChannel)
+	require.Equal(t, uint64(1), k.GetChannelsCountOfNode(ctx, types.TestChannel.NodeID))
+	require.Equal(t, uint64(1), k.GetChannelsCountOfAddress(ctx, types.TestChannel.Client))
+	
+	channel := types.TestChannel
+	channel.ID = hub.NewChannelID(1)
+	k.AddChannel(ctx, channel)
+	
+	channels = k.GetChannelsOfNode(ctx, types.TestChannel.NodeID)
+	require.Equal(t, []types.Channel{types.TestChannel, channel}, channels)
+	channels = k.GetChannelsOfAddress(ctx, types.TestChannel.Client)
+	require.Equal(t, []types.Channel{types.TestChannel, channel}, channels)
+	channels = k.GetChannelsOfAddress(ctx, types.TestAddress1)
+	require.Equal(t, []types.Channel{}, channels)
+	channels = k.GetAllChannels(ctx)
+	require.Equal(t, []types.Channel{types.TestChannel, channel}, channels)
+}
@@ -2,16 +2,73 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/deposit"
 )
 
-func (k Keeper) AddDeposit(ctx sdk.Context, address sdk.AccAddress, coin sdk.Coin) sdk.Error {
-	return k.deposit.Add(ctx, address, sdk.Coins{coin})
+// lockReason is the reason written to the deposit ledger for a lock of the holder
+func lockReason(holder hub.ID) string {
+	switch holder.(type) {
+	case hub.NodeID:
+		return deposit.ReasonNodeBond
+	case hub.ResolverID:
+		return deposit.ReasonResolverBond
+	case hub.SubscriptionID:
+		return deposit.ReasonSubscriptionEscrow
+	default:
+		return deposit.ReasonChannelEscrow
+	}
 }
 
-func (k Keeper) SubtractDeposit(ctx sdk.Context, address sdk.AccAddress, coin sdk.Coin) sdk.Error {
-	return k.deposit.Subtract(ctx, address, sdk.Coins{coin})
+// LockDeposit locks the coin of the deposit of the address for the node, resolver, subscription or channel
+func (k Keeper) LockDeposit(ctx sdk.Context, address sdk.AccAddress, holder hub.ID, coin sdk.Coin) sdk.Error {
+	return k.deposit.Lock(ctx, address, holder.String(), sdk.Coins{coin}, lockReason(holder))
 }
 
-func (k Keeper) SendDeposit(ctx sdk.Context, from, toAddress sdk.AccAddress, coin sdk.Coin) sdk.Error {
-	return k.deposit.SendFromDepositToAccount(ctx, from, toAddress, sdk.Coins{coin})
+// UnlockDeposit refunds the coin locked by the holder to the free deposit of the address
+func (k Keeper) UnlockDeposit(ctx sdk.Context, address sdk.AccAddress, holder hub.ID, coin sdk.Coin) sdk.Error {
+	return k.deposit.Unlock(ctx, address, holder.String(), sdk.Coins{coin}, deposit.ReasonRefund)
+}
+
+// LockDepositCoins locks the coins of the deposit of the address for the subscription funded in several
+// denominations
+func (k Keeper) LockDepositCoins(ctx sdk.Context, address sdk.AccAddress, holder hub.ID, coins sdk.Coins) sdk.Error {
+	return k.deposit.Lock(ctx, address, holder.String(), coins, lockReason(holder))
+}
+
+// UnlockDepositCoins refunds the coins locked by the holder to the free deposit of the address
+func (k Keeper) UnlockDepositCoins(ctx sdk.Context, address sdk.AccAddress, holder hub.ID, coins sdk.Coins) sdk.Error {
+	return k.deposit.Unlock(ctx, address, holder.String(), coins, deposit.ReasonRefund)
+}
+
+// SendLockedDeposit sends the coin locked by the holder in the deposit of the address from to the address to,
+// for the reason written to the deposit ledger
+func (k Keeper) SendLockedDeposit(ctx sdk.Context, from sdk.AccAddress, holder hub.ID,
+	to sdk.AccAddress, coin sdk.Coin, reason string) sdk.Error {
+	return k.deposit.SendFromLockToAccount(ctx, from, holder.String(), to, sdk.Coins{coin}, reason)
+}
+
+// ReleaseDeposit refunds all the coins still locked by the holder to the free deposit of the address,
+// and returns them
+func (k Keeper) ReleaseDeposit(ctx sdk.Context, address sdk.AccAddress, holder hub.ID) sdk.Coins {
+	_deposit, found := k.deposit.GetDeposit(ctx, address)
+	if !found {
+		return sdk.Coins{}
+	}
+	
+	lock, found := _deposit.GetLock(holder.String())
+	if !found || lock.Coins.IsZero() {
+		return sdk.Coins{}
+	}
+	
+	if err := k.deposit.Unlock(ctx, address, holder.String(), lock.Coins, deposit.ReasonRefund); err != nil {
+		return sdk.Coins{}
+	}
+	
+	return lock.Coins
+}
+
+func (k Keeper) GetDeposit(ctx sdk.Context, address sdk.AccAddress) (deposit.Deposit, bool) {
+	return k.deposit.GetDeposit(ctx, address)
 }
@@ -2,71 +2,83 @@ package keeper
 
 import (
 	"testing"
+	"time"
 	
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	
+	hub "github.com/sentinel-official/hub/types"
+	_deposit "github.com/sentinel-official/hub/x/deposit"
 	"github.com/sentinel-official/hub/x/vpn/types"
 )
 
-func TestKeeper_AddDeposit(t *testing.T) {
+func TestKeeper_LockDeposit(t *testing.T) {
 	ctx, k, dk, bk := CreateTestInput(t, false)
 	
 	deposit, found := dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, false, found)
 	require.Equal(t, sdk.Coins(nil), deposit.Coins)
 	
-	err := k.AddDeposit(ctx, types.TestAddress1, sdk.Coin{})
+	err := k.LockDeposit(ctx, types.TestAddress1, types.TestNode.ID, sdk.Coin{})
 	require.NotNil(t, err)
-	err = k.AddDeposit(ctx, types.TestAddress1, sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-100)})
+	err = k.LockDeposit(ctx, types.TestAddress1, types.TestNode.ID, sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-100)})
 	require.NotNil(t, err)
-	err = k.AddDeposit(ctx, types.TestAddress1, sdk.NewInt64Coin("stake", 0))
+	err = k.LockDeposit(ctx, types.TestAddress1, types.TestNode.ID, sdk.NewInt64Coin("stake", 0))
 	require.NotNil(t, err)
-	err = k.AddDeposit(ctx, types.TestAddress1, sdk.NewInt64Coin("stake", 100))
+	err = k.LockDeposit(ctx, types.TestAddress1, types.TestNode.ID, sdk.NewInt64Coin("stake", 100))
 	require.NotNil(t, err)
 	
 	coins, err := bk.AddCoins(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
 	require.Nil(t, err)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, coins)
 	
-	err = k.AddDeposit(ctx, types.TestAddress1, sdk.Coin{})
+	err = k.LockDeposit(ctx, types.TestAddress1, types.TestNode.ID, sdk.Coin{})
 	require.NotNil(t, err)
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, false, found)
 	require.Equal(t, sdk.Coins(nil), deposit.Coins)
 	
-	err = k.AddDeposit(ctx, types.TestAddress1, sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-100)})
+	err = k.LockDeposit(ctx, types.TestAddress1, types.TestNode.ID, sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-100)})
 	require.NotNil(t, err)
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, false, found)
 	require.Equal(t, sdk.Coins(nil), deposit.Coins)
 	
-	err = k.AddDeposit(ctx, types.TestAddress1, sdk.NewInt64Coin("stake", 0))
+	err = k.LockDeposit(ctx, types.TestAddress1, types.TestNode.ID, sdk.NewInt64Coin("stake", 0))
 	require.NotNil(t, err)
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, false, found)
 	require.Equal(t, sdk.Coins(nil), deposit.Coins)
 	
-	err = k.AddDeposit(ctx, types.TestAddress1, sdk.NewInt64Coin("stake", 100).Add(sdk.NewInt64Coin("stake", 100)))
+	err = k.LockDeposit(ctx, types.TestAddress1, types.TestNode.ID, sdk.NewInt64Coin("stake", 100).Add(sdk.NewInt64Coin("stake", 100)))
 	require.NotNil(t, err)
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, false, found)
 	require.Equal(t, sdk.Coins(nil), deposit.Coins)
 	
-	err = k.AddDeposit(ctx, types.TestAddress1, sdk.NewInt64Coin("stake", 100))
+	err = k.LockDeposit(ctx, types.TestAddress1, types.TestNode.ID, sdk.NewInt64Coin("stake", 100))
 	require.Nil(t, err)
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, true, found)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, deposit.Coins)
 	
-	err = k.AddDeposit(ctx, types.TestAddress1, sdk.NewInt64Coin("stake", 100))
+	err = k.LockDeposit(ctx, types.TestAddress1, types.TestNode.ID, sdk.NewInt64Coin("stake", 100))
 	require.NotNil(t, err)
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, true, found)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, deposit.Coins)
+	
+	err = k.LockDeposit(ctx, types.TestAddress1, hub.NewSubscriptionID(0), sdk.NewInt64Coin("stake", 0))
+	require.NotNil(t, err)
+	
+	reasons := make([]string, 0)
+	for _, entry := range dk.GetLedgerEntriesOfAddress(ctx, types.TestAddress1, time.Time{}, time.Time{}, 0, 0) {
+		reasons = append(reasons, entry.Reason)
+	}
+	require.Equal(t, []string{_deposit.ReasonNodeBond, _deposit.ReasonNodeBond}, reasons)
 }
 
-func TestKeeper_SubtractDeposit(t *testing.T) {
+func TestKeeper_UnlockDeposit(t *testing.T) {
 	ctx, k, dk, bk := CreateTestInput(t, false)
 	
 	coins := bk.GetCoins(ctx, types.TestAddress1)
@@ -75,22 +87,22 @@ func TestKeeper_SubtractDeposit(t *testing.T) {
 	require.Equal(t, false, found)
 	require.Equal(t, sdk.Coins(nil), deposit.Coins)
 	
-	err := k.SubtractDeposit(ctx, types.TestAddress1, sdk.Coin{})
+	err := k.UnlockDeposit(ctx, types.TestAddress1, types.TestNode.ID, sdk.Coin{})
 	require.NotNil(t, err)
-	err = k.SubtractDeposit(ctx, types.TestAddress1, sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-100)})
+	err = k.UnlockDeposit(ctx, types.TestAddress1, types.TestNode.ID, sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-100)})
 	require.NotNil(t, err)
-	err = k.SubtractDeposit(ctx, types.TestAddress1, sdk.NewInt64Coin("stake", 0))
+	err = k.UnlockDeposit(ctx, types.TestAddress1, types.TestNode.ID, sdk.NewInt64Coin("stake", 0))
 	require.NotNil(t, err)
-	err = k.SubtractDeposit(ctx, types.TestAddress1, sdk.NewInt64Coin("stake", 100))
+	err = k.UnlockDeposit(ctx, types.TestAddress1, types.TestNode.ID, sdk.NewInt64Coin("stake", 100))
 	require.NotNil(t, err)
 	
 	coins, err = bk.AddCoins(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
 	require.Nil(t, err)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, coins)
-	err = k.AddDeposit(ctx, types.TestAddress1, sdk.NewInt64Coin("stake", 100))
+	err = k.LockDeposit(ctx, types.TestAddress1, types.TestNode.ID, sdk.NewInt64Coin("stake", 100))
 	require.Nil(t, err)
 	
-	err = k.SubtractDeposit(ctx, types.TestAddress1, sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-100)})
+	err = k.UnlockDeposit(ctx, types.TestAddress1, types.TestNode.ID, sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-100)})
 	require.NotNil(t, err)
 	coins = bk.GetCoins(ctx, types.TestAddress1)
 	require.Equal(t, sdk.Coins(nil), coins)
@@ -98,7 +110,7 @@ func TestKeeper_SubtractDeposit(t *testing.T) {
 	require.Equal(t, true, found)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, deposit.Coins)
 	
-	err = k.SubtractDeposit(ctx, types.TestAddress1, sdk.NewInt64Coin("stake", 0))
+	err = k.UnlockDeposit(ctx, types.TestAddress1, types.TestNode.ID, sdk.NewInt64Coin("stake", 0))
 	require.NotNil(t, err)
 	coins = bk.GetCoins(ctx, types.TestAddress1)
 	require.Equal(t, sdk.Coins(nil), coins)
@@ -106,7 +118,7 @@ func TestKeeper_SubtractDeposit(t *testing.T) {
 	require.Equal(t, true, found)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, deposit.Coins)
 	
-	err = k.SubtractDeposit(ctx, types.TestAddress1, sdk.NewInt64Coin("stake", 100).Add(sdk.NewInt64Coin("stake", 100)))
+	err = k.UnlockDeposit(ctx, types.TestAddress1, types.TestNode.ID, sdk.NewInt64Coin("stake", 100).Add(sdk.NewInt64Coin("stake", 100)))
 	require.NotNil(t, err)
 	coins = bk.GetCoins(ctx, types.TestAddress1)
 	require.Equal(t, sdk.Coins(nil), coins)
@@ -114,24 +126,25 @@ func TestKeeper_SubtractDeposit(t *testing.T) {
 	require.Equal(t, true, found)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, deposit.Coins)
 	
-	err = k.SubtractDeposit(ctx, types.TestAddress1, sdk.NewInt64Coin("stake", 100))
+	err = k.UnlockDeposit(ctx, types.TestAddress1, types.TestNode.ID, sdk.NewInt64Coin("stake", 100))
 	require.Nil(t, err)
 	coins = bk.GetCoins(ctx, types.TestAddress1)
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, coins)
+	require.Equal(t, sdk.Coins(nil), coins)
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, true, found)
-	require.Equal(t, sdk.Coins(nil), deposit.Coins)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, deposit.Coins)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, deposit.Free())
 	
-	err = k.SubtractDeposit(ctx, types.TestAddress1, sdk.NewInt64Coin("stake", 100))
+	err = k.UnlockDeposit(ctx, types.TestAddress1, types.TestNode.ID, sdk.NewInt64Coin("stake", 100))
 	require.NotNil(t, err)
 	coins = bk.GetCoins(ctx, types.TestAddress1)
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, coins)
+	require.Equal(t, sdk.Coins(nil), coins)
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, true, found)
-	require.Equal(t, sdk.Coins(nil), deposit.Coins)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, deposit.Free())
 }
 
-func TestKeeper_SendDeposit(t *testing.T) {
+func TestKeeper_SendLockedDeposit(t *testing.T) {
 	ctx, k, dk, bk := CreateTestInput(t, false)
 	
 	coins := bk.GetCoins(ctx, types.TestAddress1)
@@ -143,22 +156,22 @@ func TestKeeper_SendDeposit(t *testing.T) {
 	require.Equal(t, false, found)
 	require.Equal(t, sdk.Coins(nil), deposit.Coins)
 	
-	err := k.SendDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.Coin{})
+	err := k.SendLockedDeposit(ctx, types.TestAddress1, types.TestNode.ID, types.TestAddress2, sdk.Coin{}, _deposit.ReasonSlash)
 	require.NotNil(t, err)
-	err = k.SendDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-100)})
+	err = k.SendLockedDeposit(ctx, types.TestAddress1, types.TestNode.ID, types.TestAddress2, sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-100)}, _deposit.ReasonSlash)
 	require.NotNil(t, err)
-	err = k.SendDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.NewInt64Coin("stake", 0))
+	err = k.SendLockedDeposit(ctx, types.TestAddress1, types.TestNode.ID, types.TestAddress2, sdk.NewInt64Coin("stake", 0), _deposit.ReasonSlash)
 	require.NotNil(t, err)
-	err = k.SendDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.NewInt64Coin("stake", 100))
+	err = k.SendLockedDeposit(ctx, types.TestAddress1, types.TestNode.ID, types.TestAddress2, sdk.NewInt64Coin("stake", 100), _deposit.ReasonSlash)
 	require.NotNil(t, err)
 	
 	coins, err = bk.AddCoins(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
 	require.Nil(t, err)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, coins)
-	err = k.AddDeposit(ctx, types.TestAddress1, sdk.NewInt64Coin("stake", 100))
+	err = k.LockDeposit(ctx, types.TestAddress1, types.TestNode.ID, sdk.NewInt64Coin("stake", 100))
 	require.Nil(t, err)
 	
-	err = k.SendDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-100)})
+	err = k.SendLockedDeposit(ctx, types.TestAddress1, types.TestNode.ID, types.TestAddress2, sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-100)}, _deposit.ReasonSlash)
 	require.NotNil(t, err)
 	coins = bk.GetCoins(ctx, types.TestAddress2)
 	require.Equal(t, sdk.Coins{}, coins)
@@ -166,7 +179,7 @@ func TestKeeper_SendDeposit(t *testing.T) {
 	require.Equal(t, true, found)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, deposit.Coins)
 	
-	err = k.SendDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.NewInt64Coin("stake", 0))
+	err = k.SendLockedDeposit(ctx, types.TestAddress1, types.TestNode.ID, types.TestAddress2, sdk.NewInt64Coin("stake", 0), _deposit.ReasonSlash)
 	require.NotNil(t, err)
 	coins = bk.GetCoins(ctx, types.TestAddress2)
 	require.Equal(t, sdk.Coins{}, coins)
@@ -174,7 +187,7 @@ func TestKeeper_SendDeposit(t *testing.T) {
 	require.Equal(t, true, found)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, deposit.Coins)
 	
-	err = k.SendDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.NewInt64Coin("stake", 100).Add(sdk.NewInt64Coin("stake", 100)))
+	err = k.SendLockedDeposit(ctx, types.TestAddress1, types.TestNode.ID, types.TestAddress2, sdk.NewInt64Coin("stake", 100).Add(sdk.NewInt64Coin("stake", 100)), _deposit.ReasonSlash)
 	require.NotNil(t, err)
 	coins = bk.GetCoins(ctx, types.TestAddress2)
 	require.Equal(t, sdk.Coins{}, coins)
@@ -182,7 +195,7 @@ func TestKeeper_SendDeposit(t *testing.T) {
 	require.Equal(t, true, found)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, deposit.Coins)
 	
-	err = k.SendDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.NewInt64Coin("stake", 100))
+	err = k.SendLockedDeposit(ctx, types.TestAddress1, types.TestNode.ID, types.TestAddress2, sdk.NewInt64Coin("stake", 100), _deposit.ReasonSlash)
 	require.Nil(t, err)
 	coins = bk.GetCoins(ctx, types.TestAddress2)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, coins)
@@ -190,11 +203,16 @@ func TestKeeper_SendDeposit(t *testing.T) {
 	require.Equal(t, true, found)
 	require.Equal(t, sdk.Coins(nil), deposit.Coins)
 	
-	err = k.SendDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.NewInt64Coin("stake", 100))
+	err = k.SendLockedDeposit(ctx, types.TestAddress1, types.TestNode.ID, types.TestAddress2, sdk.NewInt64Coin("stake", 100), _deposit.ReasonSlash)
 	require.NotNil(t, err)
 	coins = bk.GetCoins(ctx, types.TestAddress2)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, coins)
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, true, found)
 	require.Equal(t, sdk.Coins(nil), deposit.Coins)
+	
+	entries := dk.GetLedgerEntriesOfAddress(ctx, types.TestAddress2, time.Time{}, time.Time{}, 0, 0)
+	require.Len(t, entries, 1)
+	require.Equal(t, _deposit.ReasonSlash, entries[0].Reason)
+	require.Equal(t, types.TestNode.ID.String(), entries[0].Holder)
 }
@@ -7,61 +7,55 @@ import (
 	"github.com/sentinel-official/hub/x/vpn/types"
 )
 
-func (k Keeper) SetFreeNodeOfClient(ctx sdk.Context, client sdk.AccAddress, id hub.NodeID) {
-	key := types.FreeNodesOfClientKey(client, id)
-	value := k.cdc.MustMarshalBinaryLengthPrefixed(id)
-	
+// SetFreeClient stores the grant under the (node, client) key and indexes the node under the client
+func (k Keeper) SetFreeClient(ctx sdk.Context, freeClient types.FreeClient) {
 	store := ctx.KVStore(k.nodeKey)
-	store.Set(key, value)
-}
-
-func (k Keeper) SetFreeClientOfNode(ctx sdk.Context, id hub.NodeID, client sdk.AccAddress) {
-	key := types.FreeClientOfNodeKey(id, client)
-	value := k.cdc.MustMarshalBinaryLengthPrefixed(client)
 	
-	store := ctx.KVStore(k.nodeKey)
-	store.Set(key, value)
+	key := types.FreeClientKey(freeClient.NodeID, freeClient.Client)
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(freeClient))
+	
+	key = types.FreeNodeOfClientKey(freeClient.Client, freeClient.NodeID)
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(freeClient.NodeID))
 }
 
-func (k Keeper) GetFreeClientsOfNode(ctx sdk.Context, nodeID hub.NodeID) (freeClients []sdk.AccAddress) {
+func (k Keeper) GetFreeClient(ctx sdk.Context, nodeID hub.NodeID,
+	client sdk.AccAddress) (freeClient types.FreeClient, found bool) {
 	store := ctx.KVStore(k.nodeKey)
 	
-	key := types.FreeClientOfNodeKey(nodeID, nil)
-	iter := sdk.KVStorePrefixIterator(store, key)
-	defer iter.Close()
+	key := types.FreeClientKey(nodeID, client)
+	value := store.Get(key)
+	if value == nil {
+		return freeClient, false
+	}
 	
-	for ; iter.Valid(); iter.Next() {
-		var freeClient sdk.AccAddress
-		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &freeClient)
-		freeClients = append(freeClients, freeClient)
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &freeClient)
+	if freeClient.ConsumedBandwidth.AnyNil() {
+		freeClient.ConsumedBandwidth = hub.NewBandwidthFromInt64(0, 0)
 	}
 	
-	return freeClients
+	return freeClient, true
 }
 
-func (k Keeper) GetFreeClientOfNode(ctx sdk.Context, nodeID hub.NodeID,
-	client sdk.AccAddress) (address sdk.AccAddress, found bool) {
+func (k Keeper) GetFreeClientsOfNode(ctx sdk.Context, nodeID hub.NodeID) (freeClients []sdk.AccAddress) {
 	store := ctx.KVStore(k.nodeKey)
 	
-	key := types.FreeClientOfNodeKey(nodeID, client)
+	iter := sdk.KVStorePrefixIterator(store, types.FreeClientsOfNodeKey(nodeID))
+	defer iter.Close()
 	
-	value := store.Get(key)
-	if value == nil {
-		return nil, false
+	for ; iter.Valid(); iter.Next() {
+		var freeClient types.FreeClient
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &freeClient)
+		freeClients = append(freeClients, freeClient.Client)
 	}
 	
-	var freeClient sdk.AccAddress
-	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &freeClient)
-	
-	return freeClient, true
+	return freeClients
 }
 
 func (k Keeper) GetFreeNodesOfClient(ctx sdk.Context,
 	client sdk.AccAddress) (freeNodes []hub.NodeID) {
 	store := ctx.KVStore(k.nodeKey)
 	
-	key := types.FreeNodesOfClientKey(client, nil)
-	iter := sdk.KVStorePrefixIterator(store, key)
+	iter := sdk.KVStorePrefixIterator(store, types.FreeNodesOfClientKey(client))
 	defer iter.Close()
 	
 	for ; iter.Valid(); iter.Next() {
@@ -73,58 +67,91 @@ func (k Keeper) GetFreeNodesOfClient(ctx sdk.Context,
 	return freeNodes
 }
 
-func (k Keeper) GetFreeNodeOfClient(ctx sdk.Context, client sdk.AccAddress,
-	nodeID hub.NodeID) (id hub.NodeID, found bool) {
+func (k Keeper) GetFreeClients(ctx sdk.Context) (freeClients []types.FreeClient) {
 	store := ctx.KVStore(k.nodeKey)
 	
-	key := types.FreeNodesOfClientKey(client, nodeID)
+	iter := sdk.KVStorePrefixIterator(store, types.FreeClientKeyPrefix)
+	defer iter.Close()
 	
-	value := store.Get(key)
-	if value == nil {
-		return nil, false
+	for ; iter.Valid(); iter.Next() {
+		var freeClient types.FreeClient
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &freeClient)
+		freeClients = append(freeClients, freeClient)
 	}
 	
-	var freeNode hub.NodeID
-	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &freeNode)
-	
-	return freeNode, true
+	return freeClients
 }
 
-func (k Keeper) RemoveFreeClientOfNode(ctx sdk.Context, nodeID hub.NodeID, client sdk.AccAddress) {
-	clientKey := types.FreeNodesOfClientKey(client, nodeID)
-	nodeKey := types.FreeClientOfNodeKey(nodeID, client)
-	
+func (k Keeper) RemoveFreeClient(ctx sdk.Context, nodeID hub.NodeID, client sdk.AccAddress) {
 	store := ctx.KVStore(k.nodeKey)
-	store.Delete(clientKey)
-	store.Delete(nodeKey)
+	store.Delete(types.FreeClientKey(nodeID, client))
+	store.Delete(types.FreeNodeOfClientKey(client, nodeID))
 }
 
-func (k Keeper) SetFreeClient(ctx sdk.Context, freeClient types.FreeClient) {
-	key := types.GetFreeClientKey(freeClient.NodeID)
-	value := k.cdc.MustMarshalBinaryLengthPrefixed(freeClient)
+// GetActiveFreeClient returns the grant of the client on the node if it can still be used. An exhausted or
+// expired grant is not returned, so that the client falls back to normal billing.
+func (k Keeper) GetActiveFreeClient(ctx sdk.Context, nodeID hub.NodeID,
+	client sdk.AccAddress) (types.FreeClient, bool) {
+	freeClient, found := k.GetFreeClient(ctx, nodeID, client)
+	if !found || freeClient.IsExpired(ctx.BlockHeight()) || freeClient.IsExhausted() {
+		return freeClient, false
+	}
 	
-	store := ctx.KVStore(k.nodeKey)
-	store.Set(key, value)
+	return freeClient, true
 }
 
-func (k Keeper) GetFreeClients(ctx sdk.Context) (freeClients []types.FreeClient) {
-	store := ctx.KVStore(k.nodeKey)
-	
-	iter := sdk.KVStorePrefixIterator(store, types.FreeClientKey)
-	defer iter.Close()
+// RevokeInactiveFreeClient removes the grant of the client on the node if it is expired or exhausted,
+// and reports whether it did
+func (k Keeper) RevokeInactiveFreeClient(ctx sdk.Context, nodeID hub.NodeID, client sdk.AccAddress) bool {
+	freeClient, found := k.GetFreeClient(ctx, nodeID, client)
+	if !found {
+		return false
+	}
 	
-	for ; iter.Valid(); iter.Next() {
-		var freeClient types.FreeClient
-		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &freeClient)
-		freeClients = append(freeClients, freeClient)
+	switch {
+	case freeClient.IsExpired(ctx.BlockHeight()):
+		k.revokeFreeClient(ctx, freeClient, types.EventTypeFreeClientExpired)
+	case freeClient.IsExhausted():
+		k.revokeFreeClient(ctx, freeClient, types.EventTypeFreeClientExhausted)
+	default:
+		return false
 	}
 	
-	return freeClients
+	return true
 }
 
-func (k Keeper) RemoveFreeClient(ctx sdk.Context, nodeID hub.NodeID) {
-	freeClientKey := types.GetFreeClientKey(nodeID)
+// ConsumeFreeClientBandwidth draws the bandwidth down from the quota of the grant and returns the number of
+// bytes of it which were covered by the quota. A grant without a quota covers all of the bandwidth.
+func (k Keeper) ConsumeFreeClientBandwidth(ctx sdk.Context, freeClient types.FreeClient,
+	bandwidth hub.Bandwidth) sdk.Int {
+	if !freeClient.HasQuota() {
+		return bandwidth.Sum()
+	}
 	
-	store := ctx.KVStore(k.nodeKey)
-	store.Delete(freeClientKey)
+	covered := bandwidth.Sum()
+	if remaining := freeClient.RemainingBandwidth(); covered.GT(remaining) {
+		covered = remaining
+	}
+	
+	freeClient = freeClient.Consume(bandwidth)
+	if freeClient.IsExhausted() {
+		k.revokeFreeClient(ctx, freeClient, types.EventTypeFreeClientExhausted)
+	} else {
+		k.SetFreeClient(ctx, freeClient)
+	}
+	
+	return covered
+}
+
+func (k Keeper) revokeFreeClient(ctx sdk.Context, freeClient types.FreeClient, eventType string) {
+	k.RemoveFreeClient(ctx, freeClient.NodeID, freeClient.Client)
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyNodeID, freeClient.NodeID.String()),
+			sdk.NewAttribute(types.AttributeKeyClientAddress, freeClient.Client.String()),
+			sdk.NewAttribute(types.AttributeKeyBandwidth, freeClient.ConsumedBandwidth.String()),
+		),
+	)
 }
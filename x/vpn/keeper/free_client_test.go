@@ -3,6 +3,7 @@ package keeper
 import (
 	"testing"
 	
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	
 	hub "github.com/sentinel-official/hub/types"
@@ -18,39 +19,79 @@ func TestKeeper_FreeClients(t *testing.T) {
 	nodes := k.GetFreeNodesOfClient(ctx, types.TestAddress2)
 	require.Equal(t, 0, len(nodes))
 	
-	address, found := k.GetFreeClientOfNode(ctx, hub.NewNodeID(0), types.TestAddress2)
+	freeClient, found := k.GetFreeClient(ctx, hub.NewNodeID(0), types.TestAddress2)
 	require.False(t, found)
-	require.Nil(t, address)
+	require.Equal(t, types.FreeClient{}, freeClient)
 	
-	node, found := k.GetFreeNodeOfClient(ctx, types.TestAddress2, hub.NewNodeID(0))
-	require.False(t, found)
-	require.Nil(t, node)
-	
-	k.SetFreeNodeOfClient(ctx, types.TestAddress2, hub.NewNodeID(0))
-	k.SetFreeClientOfNode(ctx, hub.NewNodeID(0), types.TestAddress2)
+	freeClient2 := types.NewFreeClient(hub.NewNodeID(0), types.TestAddress2, types.TestBandwidthPos1, 10, 1)
+	k.SetFreeClient(ctx, freeClient2)
 	
-	address, found = k.GetFreeClientOfNode(ctx, hub.NewNodeID(0), types.TestAddress2)
+	freeClient, found = k.GetFreeClient(ctx, hub.NewNodeID(0), types.TestAddress2)
 	require.True(t, found)
-	require.Equal(t, types.TestAddress2, address)
+	require.Equal(t, freeClient2, freeClient)
 	
-	node, found = k.GetFreeNodeOfClient(ctx, types.TestAddress2, hub.NewNodeID(0))
-	require.True(t, found)
-	require.Equal(t, hub.NewNodeID(0), node)
+	nodes = k.GetFreeNodesOfClient(ctx, types.TestAddress2)
+	require.Equal(t, []hub.NodeID{hub.NewNodeID(0)}, nodes)
 	
-	k.SetFreeNodeOfClient(ctx, types.TestAddress3, hub.NewNodeID(0))
-	k.SetFreeClientOfNode(ctx, hub.NewNodeID(0), types.TestAddress3)
+	freeClient3 := types.NewFreeClient(hub.NewNodeID(0), types.TestAddress3, types.TestBandwidthZero, 0, 0)
+	k.SetFreeClient(ctx, freeClient3)
+	k.SetFreeClient(ctx, types.NewFreeClient(hub.NewNodeID(1), types.TestAddress2, types.TestBandwidthZero, 0, 0))
 	
-	address, found = k.GetFreeClientOfNode(ctx, hub.NewNodeID(0), types.TestAddress3)
+	freeClient, found = k.GetFreeClient(ctx, hub.NewNodeID(0), types.TestAddress2)
 	require.True(t, found)
-	require.Equal(t, types.TestAddress3, address)
+	require.Equal(t, freeClient2, freeClient)
 	
 	freeclients = k.GetFreeClientsOfNode(ctx, hub.NewNodeID(0))
 	require.Equal(t, 2, len(freeclients))
+	require.Len(t, k.GetFreeClients(ctx), 3)
+	
+	k.RemoveFreeClient(ctx, hub.NewNodeID(0), types.TestAddress2)
+	_, found = k.GetFreeClient(ctx, hub.NewNodeID(0), types.TestAddress2)
+	require.False(t, found)
 	
-	k.RemoveFreeClientOfNode(ctx, hub.NewNodeID(0), types.TestAddress2)
 	nodes = k.GetFreeNodesOfClient(ctx, types.TestAddress2)
-	require.Equal(t, 0, len(nodes))
+	require.Equal(t, []hub.NodeID{hub.NewNodeID(1)}, nodes)
 	
 	nodes = k.GetFreeNodesOfClient(ctx, types.TestAddress3)
 	require.Equal(t, 1, len(nodes))
+	require.Equal(t, []types.FreeClient{freeClient3,
+		types.NewFreeClient(hub.NewNodeID(1), types.TestAddress2, types.TestBandwidthZero, 0, 0)}, k.GetFreeClients(ctx))
+}
+
+func TestKeeper_GetActiveFreeClient(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+	
+	_, found := k.GetActiveFreeClient(ctx, hub.NewNodeID(0), types.TestAddress2)
+	require.Equal(t, false, found)
+	
+	k.SetFreeClient(ctx, types.NewFreeClient(hub.NewNodeID(0), types.TestAddress2, types.TestBandwidthZero, 0, 0))
+	freeClient, found := k.GetActiveFreeClient(ctx, hub.NewNodeID(0), types.TestAddress2)
+	require.Equal(t, true, found)
+	require.Equal(t, true, freeClient.IsUnlimited())
+	
+	freeClient = types.NewFreeClient(hub.NewNodeID(0), types.TestAddress2, types.TestBandwidthPos1, 10, 1)
+	k.SetFreeClient(ctx, freeClient)
+	result, found := k.GetActiveFreeClient(ctx, hub.NewNodeID(0), types.TestAddress2)
+	require.Equal(t, true, found)
+	require.Equal(t, freeClient, result)
+	
+	covered := k.ConsumeFreeClientBandwidth(ctx, result, hub.NewBandwidthFromInt64(100, 100))
+	require.Equal(t, sdk.NewInt(200), covered)
+	result, _ = k.GetFreeClient(ctx, hub.NewNodeID(0), types.TestAddress2)
+	require.Equal(t, hub.NewBandwidthFromInt64(100, 100), result.ConsumedBandwidth)
+	
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	_, found = k.GetActiveFreeClient(ctx, hub.NewNodeID(0), types.TestAddress2)
+	require.Equal(t, false, found)
+	require.Len(t, ctx.EventManager().Events(), 0)
+	_, found = k.GetFreeClient(ctx, hub.NewNodeID(0), types.TestAddress2)
+	require.Equal(t, true, found)
+	
+	require.Equal(t, true, k.RevokeInactiveFreeClient(ctx, hub.NewNodeID(0), types.TestAddress2))
+	require.Equal(t, types.EventTypeFreeClientExpired, ctx.EventManager().Events()[0].Type)
+	
+	_, found = k.GetFreeClient(ctx, hub.NewNodeID(0), types.TestAddress2)
+	require.Equal(t, false, found)
+	require.Len(t, k.GetFreeNodesOfClient(ctx, types.TestAddress2), 0)
+	require.Equal(t, false, k.RevokeInactiveFreeClient(ctx, hub.NewNodeID(0), types.TestAddress2))
 }
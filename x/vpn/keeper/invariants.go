@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "subscription-deposits", SubscriptionDepositsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "nodes-count", NodesCountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "active-sessions", ActiveSessionsInvariant(k))
+}
+
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := SubscriptionDepositsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = NodesCountInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return ActiveSessionsInvariant(k)(ctx)
+	}
+}
+
+// SubscriptionDepositsInvariant checks that the deposit coins of every active subscription and
+// the unredeemed deposit of every active channel are backed by their locks in the deposit of the client
+func SubscriptionDepositsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+		
+		check := func(client sdk.AccAddress, holder hub.ID, coins sdk.Coins) {
+			deposit, _ := k.GetDeposit(ctx, client)
+			lock, _ := deposit.GetLock(holder.String())
+			if !lock.Coins.IsAllGTE(coins) {
+				count++
+				msg += fmt.Sprintf("\t%s has locked %s of %s but requires %s\n",
+					holder, lock.Coins, client, coins)
+			}
+		}
+		
+		k.IterateSubscriptions(ctx, func(_ int64, subscription types.Subscription) bool {
+			if subscription.Status != types.StatusActive || !subscription.DepositLocked ||
+				subscription.Deposits.Empty() {
+				return false
+			}
+			
+			check(subscription.Client, subscription.ID, subscription.Deposits)
+			return false
+		})
+		
+		k.IterateChannels(ctx, func(_ int64, channel types.Channel) bool {
+			if channel.Status != types.StatusActive || channel.Unredeemed().IsZero() {
+				return false
+			}
+			
+			check(channel.Client, channel.ID, sdk.Coins{channel.Unredeemed()})
+			return false
+		})
+		
+		broken := count != 0
+		return sdk.FormatInvariant(types.ModuleName, "subscription deposits",
+			fmt.Sprintf("found %d under-backed subscriptions and channels\n%s", count, msg)), broken
+	}
+}
+
+// NodesCountInvariant checks that the stored nodes count equals the number of stored nodes
+func NodesCountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		count := k.GetNodesCount(ctx)
+		nodes := uint64(len(k.GetAllNodes(ctx)))
+
+		broken := count != nodes
+		return sdk.FormatInvariant(types.ModuleName, "nodes count",
+			fmt.Sprintf("\tnodes count: %d\n"+
+				"\tstored nodes: %d\n", count, nodes)), broken
+	}
+}
+
+// ActiveSessionsInvariant checks that every session in the active session IDs of each height is active
+func ActiveSessionsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		k.IterateActiveSessionIDs(ctx, func(height int64, ids hub.IDs) bool {
+			for _, id := range ids {
+				session, found := k.GetSession(ctx, id.(hub.SessionID))
+				if !found || session.Status != types.StatusActive {
+					count++
+					msg += fmt.Sprintf("\t%s at height %d is not an active session\n", id, height)
+				}
+			}
+
+			return false
+		})
+
+		broken := count != 0
+		return sdk.FormatInvariant(types.ModuleName, "active sessions",
+			fmt.Sprintf("found %d inactive sessions in the active list\n%s", count, msg)), broken
+	}
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func TestSubscriptionDepositsInvariant(t *testing.T) {
+	ctx, k, _, bk := CreateTestInput(t, false)
+
+	_, broken := SubscriptionDepositsInvariant(k)(ctx)
+	require.False(t, broken)
+
+	subscription := types.TestSubscription
+	k.SetSubscription(ctx, subscription)
+
+	_, broken = SubscriptionDepositsInvariant(k)(ctx)
+	require.True(t, broken)
+
+	_, err := bk.AddCoins(ctx, subscription.Client, sdk.Coins{subscription.RemainingDeposit})
+	require.Nil(t, err)
+	err = k.LockDeposit(ctx, subscription.Client, subscription.ID, subscription.RemainingDeposit)
+	require.Nil(t, err)
+
+	_, broken = SubscriptionDepositsInvariant(k)(ctx)
+	require.False(t, broken)
+
+	subscription.ID = hub.NewSubscriptionID(1)
+	k.SetSubscription(ctx, subscription)
+
+	_, broken = SubscriptionDepositsInvariant(k)(ctx)
+	require.True(t, broken)
+
+	subscription.Status = types.StatusInactive
+	k.SetSubscription(ctx, subscription)
+
+	_, broken = SubscriptionDepositsInvariant(k)(ctx)
+	require.False(t, broken)
+}
+
+func TestNodesCountInvariant(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+
+	_, broken := NodesCountInvariant(k)(ctx)
+	require.False(t, broken)
+
+	k.SetNode(ctx, types.TestNode)
+
+	_, broken = NodesCountInvariant(k)(ctx)
+	require.True(t, broken)
+
+	k.SetNodesCount(ctx, 1)
+
+	_, broken = NodesCountInvariant(k)(ctx)
+	require.False(t, broken)
+}
+
+func TestActiveSessionsInvariant(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+
+	_, broken := ActiveSessionsInvariant(k)(ctx)
+	require.False(t, broken)
+
+	session := types.TestSession
+	k.SetSession(ctx, session)
+	k.SetSessionsCount(ctx, 1)
+	k.AddSessionIDToActiveList(ctx, 1, session.ID)
+
+	_, broken = ActiveSessionsInvariant(k)(ctx)
+	require.False(t, broken)
+
+	k.AddSessionIDToActiveList(ctx, 2, hub.NewSessionID(1))
+
+	_, broken = ActiveSessionsInvariant(k)(ctx)
+	require.True(t, broken)
+
+	k.DeleteActiveSessionIDs(ctx, 2)
+
+	_, broken = ActiveSessionsInvariant(k)(ctx)
+	require.False(t, broken)
+
+	session.Status = types.StatusInactive
+	k.SetSession(ctx, session)
+
+	_, broken = ActiveSessionsInvariant(k)(ctx)
+	require.True(t, broken)
+
+	_, broken = AllInvariants(k)(ctx)
+	require.True(t, broken)
+}
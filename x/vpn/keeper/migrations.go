@@ -0,0 +1,452 @@
+package keeper
+
+import (
+	"fmt"
+	"reflect"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+// Migration rewrites the keys and values of the stores of the module from the previous consensus version
+// to its version
+type Migration struct {
+	Version uint64
+	Migrate func(ctx sdk.Context, k Keeper) error
+}
+
+// Migrations are the registered migrations, in the order of their versions
+var Migrations = []Migration{
+	{Version: 2, Migrate: MigrateFreeClients},
+	{Version: 3, Migrate: MigrateLegacyRecords},
+	{Version: 4, Migrate: MigrateDepositLocks},
+	{Version: 5, Migrate: MigrateSessionsOfSubscriptions},
+	{Version: 6, Migrate: MigrateSubscriptionDeposits},
+}
+
+func (k Keeper) SetConsensusVersion(ctx sdk.Context, version uint64) {
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(version)
+	
+	store := ctx.KVStore(k.nodeKey)
+	store.Set(types.ConsensusVersionKey, value)
+}
+
+func (k Keeper) GetConsensusVersion(ctx sdk.Context) (version uint64) {
+	store := ctx.KVStore(k.nodeKey)
+	
+	value := store.Get(types.ConsensusVersionKey)
+	if value == nil {
+		return 1
+	}
+	
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &version)
+	return version
+}
+
+// RunMigrations runs the migrations above the stored consensus version in order and stores the version of each
+// one after it succeeds. Nothing is written for a migration which returns an error, and the ones after it
+// are not run.
+func RunMigrations(ctx sdk.Context, k Keeper, migrations []Migration) error {
+	for _, migration := range migrations {
+		current := k.GetConsensusVersion(ctx)
+		if migration.Version <= current {
+			continue
+		}
+		if migration.Version != current+1 {
+			return fmt.Errorf("missing migration from version %d to %d", current, current+1)
+		}
+		
+		cacheCtx, write := ctx.CacheContext()
+		if err := migration.Migrate(cacheCtx, k); err != nil {
+			return fmt.Errorf("failed to migrate to version %d: %s", migration.Version, err)
+		}
+		
+		k.SetConsensusVersion(cacheCtx, migration.Version)
+		write()
+		
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMigrateStore,
+				sdk.NewAttribute(types.AttributeKeyVersion, fmt.Sprintf("%d", migration.Version)),
+			),
+		)
+	}
+	
+	return nil
+}
+
+// MigrateFreeClients moves the free clients to the store layout keyed by (node, client). The old layout shared
+// its prefixes with the nodes, kept the addresses of the free clients under the (node, client) key and kept one
+// record per node, which every new free client of the node overwrote. The grant of a client is kept if that
+// record still belongs to it, and every other client gets an unlimited grant, as it had before grants were
+// limited. The old keys are told apart from the keys of the nodes by their length.
+func MigrateFreeClients(ctx sdk.Context, k Keeper) error {
+	store := ctx.KVStore(k.nodeKey)
+	nodeIDLen := len(hub.NewNodeID(0))
+	
+	var (
+		keys        [][]byte
+		records     []types.FreeClient
+		freeClients []types.FreeClient
+	)
+	
+	iter := sdk.KVStorePrefixIterator(store, types.LegacyFreeClientKeyPrefix)
+	for ; iter.Valid(); iter.Next() {
+		if len(iter.Key()) != 1+nodeIDLen {
+			continue
+		}
+		
+		var freeClient types.FreeClient
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &freeClient)
+		
+		keys = append(keys, iter.Key())
+		records = append(records, freeClient)
+	}
+	iter.Close()
+	
+	iter = sdk.KVStorePrefixIterator(store, types.LegacyFreeNodesOfClientKeyPrefix)
+	for ; iter.Valid(); iter.Next() {
+		if len(iter.Key()) == 1+sdk.AddrLen+nodeIDLen {
+			keys = append(keys, iter.Key())
+		}
+	}
+	iter.Close()
+	
+	iter = sdk.KVStorePrefixIterator(store, types.LegacyFreeClientsOfNodeKeyPrefix)
+	for ; iter.Valid(); iter.Next() {
+		if len(iter.Key()) != 1+nodeIDLen+sdk.AddrLen {
+			continue
+		}
+		
+		nodeID := hub.NodeID(iter.Key()[1 : 1+nodeIDLen])
+		client := sdk.AccAddress(iter.Key()[1+nodeIDLen:])
+		
+		freeClient := types.NewFreeClient(nodeID, client, hub.NewBandwidthFromInt64(0, 0), 0, 0)
+		for i := 0; i < len(records); i++ {
+			if records[i].NodeID.IsEqual(nodeID) && records[i].Client.Equals(client) {
+				freeClient = records[i]
+				records = append(records[:i], records[i+1:]...)
+				break
+			}
+		}
+		
+		keys = append(keys, iter.Key())
+		freeClients = append(freeClients, freeClient)
+	}
+	iter.Close()
+	
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	
+	freeClients = append(freeClients, records...)
+	
+	for _, freeClient := range freeClients {
+		if freeClient.Bandwidth.AnyNil() {
+			freeClient.Bandwidth = hub.NewBandwidthFromInt64(0, 0)
+		}
+		if freeClient.ConsumedBandwidth.AnyNil() {
+			freeClient.ConsumedBandwidth = hub.NewBandwidthFromInt64(0, 0)
+		}
+		
+		k.SetFreeClient(ctx, freeClient)
+	}
+	
+	return nil
+}
+
+// legacyNode, legacySubscription and legacyResolver are the layouts of the records at version 1. The fields
+// added since then were inserted between the old ones, so the old records can not be decoded as the new ones.
+type legacyNode struct {
+	ID      hub.NodeID
+	Owner   sdk.AccAddress
+	Deposit sdk.Coin
+	
+	Type          string
+	Version       string
+	Moniker       string
+	PricesPerGB   sdk.Coins
+	InternetSpeed hub.Bandwidth
+	Encryption    string
+	
+	Status           string
+	StatusModifiedAt int64
+}
+
+type legacySubscription struct {
+	ID                 hub.SubscriptionID
+	ResolverID         hub.ResolverID
+	NodeID             hub.NodeID
+	Client             sdk.AccAddress
+	PricePerGB         sdk.Coin
+	TotalDeposit       sdk.Coin
+	RemainingDeposit   sdk.Coin
+	RemainingBandwidth hub.Bandwidth
+	Status             string
+	StatusModifiedAt   int64
+}
+
+type legacyResolver struct {
+	ID               hub.ResolverID
+	Owner            sdk.AccAddress
+	Commission       sdk.Dec
+	Status           string
+	StatusModifiedAt int64
+}
+
+// LegacyResolverEndpoint is given to the resolvers which registered before the resolvers had an endpoint,
+// until their owners update it. The .invalid top level domain never resolves.
+const LegacyResolverEndpoint = "https://resolver.invalid"
+
+// LegacyResolverRegion is given to the resolvers which registered before the resolvers had regions
+const LegacyResolverRegion = "global"
+
+// MigrateLegacyRecords brings the state of chains which started at version 1 up to the fields and parameters
+// added since then:
+//  - the parameters which are missing are set to their defaults;
+//  - the nodes, subscriptions and resolvers are rewritten in their new layout. The subscriptions get the per-GB
+//    plan of their price and lock in the commission of their resolver. The resolvers had no deposit, limits on
+//    the commission, moniker, endpoint or regions, so they get a zero deposit, limits which allow any
+//    commission as before, their ID as the moniker, and LegacyResolverEndpoint and LegacyResolverRegion;
+//  - the registered nodes are put into the active nodes of the current height, so that they become inactive
+//    after the node inactive interval unless their owners update them.
+func MigrateLegacyRecords(ctx sdk.Context, k Keeper) error {
+	defaults := types.DefaultParams()
+	for _, pair := range defaults.ParamSetPairs() {
+		if !k.paramStore.Has(ctx, pair.Key) {
+			k.paramStore.Set(ctx, pair.Key, reflect.ValueOf(pair.Value).Elem().Interface())
+		}
+	}
+	
+	var (
+		resolvers     []legacyResolver
+		subscriptions []legacySubscription
+		nodes         []legacyNode
+	)
+	
+	decode := func(storeKey sdk.StoreKey, prefix []byte, keyLen int, decode func(value []byte) error) error {
+		iter := sdk.KVStorePrefixIterator(ctx.KVStore(storeKey), prefix)
+		defer iter.Close()
+		
+		for ; iter.Valid(); iter.Next() {
+			if len(iter.Key()) != keyLen {
+				continue
+			}
+			if err := decode(iter.Value()); err != nil {
+				return fmt.Errorf("failed to decode the record %X: %s", iter.Key(), err)
+			}
+		}
+		
+		return nil
+	}
+	
+	err := decode(k.resolverKey, types.ResolverKeyPrefix, len(types.ResolverKey(hub.NewResolverID(0))),
+		func(value []byte) error {
+			var resolver legacyResolver
+			resolvers = append(resolvers, resolver)
+			return k.cdc.UnmarshalBinaryLengthPrefixed(value, &resolvers[len(resolvers)-1])
+		})
+	if err != nil {
+		return err
+	}
+	
+	err = decode(k.subscriptionKey, types.SubscriptionKeyPrefix, len(types.SubscriptionKey(hub.NewSubscriptionID(0))),
+		func(value []byte) error {
+			var subscription legacySubscription
+			subscriptions = append(subscriptions, subscription)
+			return k.cdc.UnmarshalBinaryLengthPrefixed(value, &subscriptions[len(subscriptions)-1])
+		})
+	if err != nil {
+		return err
+	}
+	
+	err = decode(k.nodeKey, types.NodeKeyPrefix, len(types.NodeKey(hub.NewNodeID(0))),
+		func(value []byte) error {
+			var node legacyNode
+			nodes = append(nodes, node)
+			return k.cdc.UnmarshalBinaryLengthPrefixed(value, &nodes[len(nodes)-1])
+		})
+	if err != nil {
+		return err
+	}
+	
+	commissions := make(map[string]sdk.Dec, len(resolvers))
+	for _, legacy := range resolvers {
+		commissions[legacy.ID.String()] = legacy.Commission
+		
+		k.SetResolver(ctx, types.Resolver{
+			ID:                      legacy.ID,
+			Owner:                   legacy.Owner,
+			Moniker:                 legacy.ID.String(),
+			Endpoint:                LegacyResolverEndpoint,
+			Regions:                 []string{LegacyResolverRegion},
+			Deposit:                 sdk.NewInt64Coin(k.ResolverMinDeposit(ctx).Denom, 0),
+			Commission:              legacy.Commission,
+			MaxCommissionRate:       sdk.OneDec(),
+			MaxCommissionChangeRate: sdk.OneDec(),
+			CommissionUpdatedAt:     ctx.BlockHeight(),
+			Status:                  legacy.Status,
+			StatusModifiedAt:        legacy.StatusModifiedAt,
+		})
+		k.SetResolverCommission(ctx, types.ResolverCommission{
+			ResolverID: legacy.ID,
+			Commission: legacy.Commission,
+			Height:     ctx.BlockHeight(),
+		})
+	}
+	
+	for _, legacy := range subscriptions {
+		commission, found := commissions[legacy.ResolverID.String()]
+		if !found {
+			commission = sdk.ZeroDec()
+		}
+		
+		k.SetSubscription(ctx, types.Subscription{
+			ID:                 legacy.ID,
+			ResolverID:         legacy.ResolverID,
+			NodeID:             legacy.NodeID,
+			Client:             legacy.Client,
+			PricePerGB:         legacy.PricePerGB,
+			Commission:         commission,
+			Plan:               types.NewPerGBPlan(legacy.PricePerGB),
+			TotalDeposit:       legacy.TotalDeposit,
+			RemainingDeposit:   legacy.RemainingDeposit,
+			RemainingBandwidth: legacy.RemainingBandwidth,
+			Status:             legacy.Status,
+			StatusModifiedAt:   legacy.StatusModifiedAt,
+			QuotedPrice:        legacy.PricePerGB,
+		})
+	}
+	
+	activeNodeIDs := k.GetActiveNodeIDs(ctx, ctx.BlockHeight())
+	for _, legacy := range nodes {
+		k.SetNode(ctx, types.Node{
+			ID:               legacy.ID,
+			Owner:            legacy.Owner,
+			Deposit:          legacy.Deposit,
+			Type:             legacy.Type,
+			Version:          legacy.Version,
+			Moniker:          legacy.Moniker,
+			PricesPerGB:      legacy.PricesPerGB,
+			InternetSpeed:    legacy.InternetSpeed,
+			Encryption:       legacy.Encryption,
+			Status:           legacy.Status,
+			StatusModifiedAt: legacy.StatusModifiedAt,
+		})
+		
+		if legacy.Status == types.StatusRegistered {
+			activeNodeIDs = activeNodeIDs.Append(legacy.ID)
+		}
+	}
+	k.SetActiveNodeIDs(ctx, ctx.BlockHeight(), activeNodeIDs)
+	
+	return nil
+}
+
+// MigrateDepositLocks splits the deposits into locks held by the nodes, resolvers, subscriptions and channels.
+// Before the locks every deposited coin was held by one of them, so the deposit of each address must cover
+// the deposits of its registered or unbonding nodes and resolvers, the remaining deposits of its active
+// subscriptions, except the ones of unlimited free clients, and the unredeemed deposits of its active channels.
+// The subscriptions whose deposits are locked are marked so.
+func MigrateDepositLocks(ctx sdk.Context, k Keeper) error {
+	lock := func(address sdk.AccAddress, holder hub.ID, coin sdk.Coin) error {
+		if coin.IsZero() {
+			return nil
+		}
+		
+		deposit, _ := k.deposit.GetDeposit(ctx, address)
+		if deposit.Address == nil {
+			deposit.Address = address
+		}
+		
+		l, _ := deposit.GetLock(holder.String())
+		l.Coins = l.Coins.Add(sdk.Coins{coin})
+		deposit.SetLock(l)
+		
+		if err := deposit.IsValid(); err != nil {
+			return fmt.Errorf("deposit of %s can not be locked by %s: %s", address, holder, err)
+		}
+		
+		k.deposit.SetDeposit(ctx, deposit)
+		return nil
+	}
+	
+	for _, node := range k.GetAllNodes(ctx) {
+		if node.Status == types.StatusDeRegistered {
+			continue
+		}
+		if err := lock(node.Owner, node.ID, node.Deposit); err != nil {
+			return err
+		}
+	}
+	
+	for _, resolver := range k.GetAllResolvers(ctx) {
+		if resolver.Status == types.StatusDeRegistered {
+			continue
+		}
+		if err := lock(resolver.Owner, resolver.ID, resolver.Deposit); err != nil {
+			return err
+		}
+	}
+	
+	for _, subscription := range k.GetAllSubscriptions(ctx) {
+		if subscription.Status != types.StatusActive {
+			continue
+		}
+		
+		freeClient, found := k.GetFreeClient(ctx, subscription.NodeID, subscription.Client)
+		if found && freeClient.IsUnlimited() {
+			continue
+		}
+		if err := lock(subscription.Client, subscription.ID, subscription.RemainingDeposit); err != nil {
+			return err
+		}
+		
+		subscription.DepositLocked = true
+		k.SetSubscription(ctx, subscription)
+	}
+	
+	for _, channel := range k.GetAllChannels(ctx) {
+		if channel.Status != types.StatusActive {
+			continue
+		}
+		if err := lock(channel.Client, channel.ID, channel.Unredeemed()); err != nil {
+			return err
+		}
+	}
+	
+	return nil
+}
+
+// MigrateSessionsOfSubscriptions counts the active sessions in the sessions count of their subscriptions. The count
+// used to be the number of ended sessions, and the active session of a subscription was stored at the index equal
+// to it, so that session is out of the count and would be overwritten by the next one.
+func MigrateSessionsOfSubscriptions(ctx sdk.Context, k Keeper) error {
+	for _, subscription := range k.GetAllSubscriptions(ctx) {
+		count := k.GetSessionsCountOfSubscription(ctx, subscription.ID)
+		if _, found := k.GetSessionIDBySubscriptionID(ctx, subscription.ID, count); found {
+			k.SetSessionsCountOfSubscription(ctx, subscription.ID, count+1)
+		}
+	}
+	
+	return nil
+}
+
+// MigrateSubscriptionDeposits records the coins locked by the active subscriptions. A subscription used to be
+// funded in a single denomination, so the coins locked by it are its remaining deposit.
+func MigrateSubscriptionDeposits(ctx sdk.Context, k Keeper) error {
+	for _, subscription := range k.GetAllSubscriptions(ctx) {
+		if subscription.Status != types.StatusActive || !subscription.DepositLocked ||
+			subscription.RemainingDeposit.IsZero() || !subscription.Deposits.Empty() {
+			continue
+		}
+		
+		subscription.Deposits = sdk.Coins{subscription.RemainingDeposit}
+		k.SetSubscription(ctx, subscription)
+	}
+	
+	return nil
+}
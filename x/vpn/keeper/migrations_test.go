@@ -0,0 +1,348 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/deposit"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func TestMigrateFreeClients(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+	store := ctx.KVStore(k.nodeKey)
+	
+	legacy := func(nodeID hub.NodeID, client []byte) {
+		key := append(types.LegacyFreeClientsOfNodeKeyPrefix, append(nodeID.Bytes(), client...)...)
+		store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(client))
+		
+		key = append(types.LegacyFreeNodesOfClientKeyPrefix, append(client, nodeID.Bytes()...)...)
+		store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(nodeID))
+	}
+	
+	k.SetNode(ctx, types.TestNode)
+	k.SetNodesCount(ctx, 1)
+	k.SetNodesCountOfAddress(ctx, types.TestNode.Owner, 1)
+	
+	legacy(hub.NewNodeID(0), types.TestAddress2)
+	legacy(hub.NewNodeID(0), types.TestAddress3)
+	legacy(hub.NewNodeID(1), types.TestAddress2)
+	
+	grant := types.NewFreeClient(hub.NewNodeID(0), types.TestAddress3, types.TestBandwidthPos1, 10, 1)
+	store.Set(types.LegacyFreeClientKey(hub.NewNodeID(0)), k.cdc.MustMarshalBinaryLengthPrefixed(grant))
+	
+	orphan := types.NewFreeClient(hub.NewNodeID(2), types.TestAddress1, types.TestBandwidthZero, 0, 0)
+	store.Set(types.LegacyFreeClientKey(hub.NewNodeID(2)), k.cdc.MustMarshalBinaryLengthPrefixed(orphan))
+	
+	require.Nil(t, MigrateFreeClients(ctx, k))
+	
+	unlimited := func(nodeID hub.NodeID, client []byte) types.FreeClient {
+		return types.NewFreeClient(nodeID, client, types.TestBandwidthZero, 0, 0)
+	}
+	
+	require.ElementsMatch(t, []types.FreeClient{
+		unlimited(hub.NewNodeID(0), types.TestAddress2),
+		grant,
+		unlimited(hub.NewNodeID(1), types.TestAddress2),
+		orphan,
+	}, k.GetFreeClients(ctx))
+	require.Nil(t, store.Get(types.LegacyFreeClientKey(hub.NewNodeID(0))))
+	require.Nil(t, store.Get(types.LegacyFreeClientKey(hub.NewNodeID(2))))
+	
+	require.Equal(t, []types.Node{types.TestNode}, k.GetAllNodes(ctx))
+	require.Equal(t, uint64(1), k.GetNodesCount(ctx))
+	require.Equal(t, uint64(1), k.GetNodesCountOfAddress(ctx, types.TestNode.Owner))
+	
+	require.Len(t, k.GetFreeClientsOfNode(ctx, hub.NewNodeID(0)), 2)
+	require.Equal(t, []hub.NodeID{hub.NewNodeID(0), hub.NewNodeID(1)}, k.GetFreeNodesOfClient(ctx, types.TestAddress2))
+	require.Equal(t, []hub.NodeID{hub.NewNodeID(2)}, k.GetFreeNodesOfClient(ctx, types.TestAddress1))
+}
+
+func TestMigrateLegacyRecords(t *testing.T) {
+	ctx, k, _, _ := createTestInput(t, false, false)
+	ctx = ctx.WithBlockHeight(10)
+	
+	k.paramStore.Set(ctx, types.KeyFreeNodesCount, uint64(1))
+	k.paramStore.Set(ctx, types.KeyDeposit, sdk.NewInt64Coin("stake", 10))
+	k.paramStore.Set(ctx, types.KeySessionInactiveInterval, int64(5))
+	
+	require.Equal(t, types.DefaultNodeInactiveInterval, k.NodeInactiveInterval(ctx))
+	require.Equal(t, types.DefaultSlashFraction, k.SlashFraction(ctx))
+	require.Equal(t, types.DefaultCommissionChangeInterval, k.CommissionChangeInterval(ctx))
+	require.Equal(t, sdk.Coin{}, k.ResolverMinDeposit(ctx))
+	
+	set := func(storeKey sdk.StoreKey, key []byte, value interface{}) {
+		ctx.KVStore(storeKey).Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(value))
+	}
+	
+	node := types.TestNode
+	set(k.nodeKey, types.NodeKey(node.ID), legacyNode{
+		ID: node.ID, Owner: node.Owner, Deposit: node.Deposit, Type: node.Type, Version: node.Version,
+		Moniker: node.Moniker, PricesPerGB: node.PricesPerGB, InternetSpeed: node.InternetSpeed,
+		Encryption: node.Encryption, Status: types.StatusRegistered, StatusModifiedAt: 1,
+	})
+	set(k.nodeKey, types.NodeKey(hub.NewNodeID(1)), legacyNode{
+		ID: hub.NewNodeID(1), Owner: node.Owner, Deposit: node.Deposit, Type: node.Type, Version: node.Version,
+		Moniker: node.Moniker, PricesPerGB: node.PricesPerGB, InternetSpeed: node.InternetSpeed,
+		Encryption: node.Encryption, Status: types.StatusDeRegistered, StatusModifiedAt: 1,
+	})
+	
+	subscription := types.TestSubscription
+	set(k.subscriptionKey, types.SubscriptionKey(subscription.ID), legacySubscription{
+		ID: subscription.ID, ResolverID: subscription.ResolverID, NodeID: subscription.NodeID,
+		Client: subscription.Client, PricePerGB: subscription.PricePerGB, TotalDeposit: subscription.TotalDeposit,
+		RemainingDeposit: subscription.RemainingDeposit, RemainingBandwidth: subscription.RemainingBandwidth,
+		Status: subscription.Status, StatusModifiedAt: subscription.StatusModifiedAt,
+	})
+	
+	commission := sdk.NewDecWithPrec(5, 2)
+	set(k.resolverKey, types.ResolverKey(hub.NewResolverID(0)), legacyResolver{
+		ID: hub.NewResolverID(0), Owner: types.TestAddress3, Commission: commission,
+		Status: types.StatusRegistered, StatusModifiedAt: 1,
+	})
+	
+	require.Nil(t, MigrateLegacyRecords(ctx, k))
+	
+	params := k.GetParams(ctx)
+	require.Equal(t, uint64(1), params.FreeNodesCount)
+	require.Equal(t, int64(5), params.SessionInactiveInterval)
+	require.Equal(t, types.DefaultResolverMinDeposit, params.ResolverMinDeposit)
+	require.Equal(t, types.DefaultNodeUnbondingPeriod, params.NodeUnbondingPeriod)
+	require.True(t, k.paramStore.Has(ctx, types.KeyExchangeRates))
+	
+	nodes := k.GetAllNodes(ctx)
+	require.Len(t, nodes, 2)
+	require.Equal(t, types.StatusRegistered, nodes[0].Status)
+	require.Equal(t, hub.IDs{nodes[0].ID}, k.GetActiveNodeIDs(ctx, 10))
+	
+	subscriptions := k.GetAllSubscriptions(ctx)
+	require.Len(t, subscriptions, 1)
+	require.Nil(t, subscriptions[0].IsValid())
+	require.Equal(t, types.NewPerGBPlan(subscription.PricePerGB), subscriptions[0].Plan)
+	require.Equal(t, commission, subscriptions[0].Commission)
+	
+	resolver, found := k.GetResolver(ctx, hub.NewResolverID(0))
+	require.True(t, found)
+	require.Nil(t, resolver.IsValid())
+	require.Equal(t, commission, resolver.Commission)
+	require.Nil(t, resolver.ValidateCommissionChange(sdk.NewDecWithPrec(1, 1), 10+k.CommissionChangeInterval(ctx), k.CommissionChangeInterval(ctx)))
+	require.Len(t, k.GetCommissionsOfResolver(ctx, resolver.ID), 1)
+}
+
+func TestMigrateSessionsOfSubscriptions(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+	
+	ended := types.TestSession
+	ended.Status = types.StatusInactive
+	active := types.TestSession
+	active.ID = hub.NewSessionID(1)
+	
+	k.SetSubscription(ctx, types.TestSubscription)
+	k.SetSession(ctx, ended)
+	k.SetSession(ctx, active)
+	k.SetSessionIDBySubscriptionID(ctx, types.TestSubscription.ID, 0, ended.ID)
+	k.SetSessionIDBySubscriptionID(ctx, types.TestSubscription.ID, 1, active.ID)
+	k.SetSessionsCountOfSubscription(ctx, types.TestSubscription.ID, 1)
+	
+	other := types.TestSubscription
+	other.ID = hub.NewSubscriptionID(1)
+	k.SetSubscription(ctx, other)
+	
+	require.Equal(t, []types.Session{ended}, k.GetSessionsOfSubscription(ctx, types.TestSubscription.ID))
+	
+	require.Nil(t, MigrateSessionsOfSubscriptions(ctx, k))
+	require.Equal(t, uint64(2), k.GetSessionsCountOfSubscription(ctx, types.TestSubscription.ID))
+	require.Equal(t, []types.Session{ended, active}, k.GetSessionsOfSubscription(ctx, types.TestSubscription.ID))
+	require.Equal(t, uint64(0), k.GetSessionsCountOfSubscription(ctx, other.ID))
+	
+	require.Nil(t, MigrateSessionsOfSubscriptions(ctx, k))
+	require.Equal(t, uint64(2), k.GetSessionsCountOfSubscription(ctx, types.TestSubscription.ID))
+}
+
+func TestMigrateSubscriptionDeposits(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+	
+	locked := types.TestSubscription
+	locked.Deposits = nil
+	inactive := locked
+	inactive.ID = hub.NewSubscriptionID(1)
+	inactive.Status = types.StatusInactive
+	unlocked := locked
+	unlocked.ID = hub.NewSubscriptionID(2)
+	unlocked.DepositLocked = false
+	
+	for _, subscription := range []types.Subscription{locked, inactive, unlocked} {
+		k.SetSubscription(ctx, subscription)
+	}
+	
+	require.Nil(t, MigrateSubscriptionDeposits(ctx, k))
+	
+	result, _ := k.GetSubscription(ctx, locked.ID)
+	require.Equal(t, sdk.Coins{locked.RemainingDeposit}, result.Deposits)
+	result, _ = k.GetSubscription(ctx, inactive.ID)
+	require.True(t, result.Deposits.Empty())
+	result, _ = k.GetSubscription(ctx, unlocked.ID)
+	require.True(t, result.Deposits.Empty())
+}
+
+func TestMigrateDepositLocks(t *testing.T) {
+	ctx, k, dk, _ := CreateTestInput(t, false)
+	
+	node := types.TestNode
+	node.Status = types.StatusRegistered
+	k.SetNode(ctx, node)
+	k.SetResolver(ctx, types.TestResolver)
+	k.SetSubscription(ctx, types.TestSubscription)
+	k.SetChannel(ctx, types.TestChannel)
+	
+	deregistered := types.TestNode
+	deregistered.ID = hub.NewNodeID(1)
+	k.SetNode(ctx, deregistered)
+	
+	dk.SetDeposit(ctx, deposit.Deposit{Address: types.TestAddress1, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 150)}})
+	dk.SetDeposit(ctx, deposit.Deposit{Address: types.TestAddress2, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 100)}})
+	dk.SetDeposit(ctx, deposit.Deposit{Address: types.TestAddress3, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 100)}})
+	
+	cacheCtx, _ := ctx.CacheContext()
+	require.NotNil(t, MigrateDepositLocks(cacheCtx, k))
+	
+	dk.SetDeposit(ctx, deposit.Deposit{Address: types.TestAddress2, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 200)}})
+	require.Nil(t, MigrateDepositLocks(ctx, k))
+	
+	result, _ := dk.GetDeposit(ctx, types.TestAddress1)
+	require.Equal(t, deposit.Locks{{Holder: node.ID.String(), Coins: sdk.Coins{node.Deposit}}}, result.Locks)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 50)}, result.Free())
+	
+	result, _ = dk.GetDeposit(ctx, types.TestAddress2)
+	require.Equal(t, deposit.Locks{
+		{Holder: types.TestSubscription.ID.String(), Coins: sdk.Coins{types.TestSubscription.RemainingDeposit}},
+		{Holder: types.TestChannel.ID.String(), Coins: sdk.Coins{types.TestChannel.Unredeemed()}},
+	}, result.Locks)
+	require.True(t, result.Free().IsZero())
+	
+	result, _ = dk.GetDeposit(ctx, types.TestAddress3)
+	require.Equal(t, deposit.Locks{{Holder: types.TestResolver.ID.String(), Coins: sdk.Coins{types.TestResolver.Deposit}}},
+		result.Locks)
+	
+	msg, broken := SubscriptionDepositsInvariant(k)(ctx)
+	require.False(t, broken, msg)
+}
+
+// loadFixture writes the hex encoded keys and values of a fixture file in testdata to the stores of the keeper
+func loadFixture(t *testing.T, ctx sdk.Context, k Keeper, name string) {
+	bz, err := ioutil.ReadFile(filepath.Join("testdata", name))
+	require.Nil(t, err)
+	
+	var fixture map[string][]struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	}
+	require.Nil(t, json.Unmarshal(bz, &fixture))
+	
+	keys := map[string]sdk.StoreKey{
+		"node":         k.nodeKey,
+		"subscription": k.subscriptionKey,
+		"session":      k.sessionKey,
+		"resolver":     k.resolverKey,
+	}
+	
+	for name, entries := range fixture {
+		storeKey, ok := keys[name]
+		require.True(t, ok, name)
+		
+		store := ctx.KVStore(storeKey)
+		for _, entry := range entries {
+			key, err := hex.DecodeString(entry.Key)
+			require.Nil(t, err)
+			value, err := hex.DecodeString(entry.Value)
+			require.Nil(t, err)
+			
+			store.Set(key, value)
+		}
+	}
+}
+
+func TestRunMigrations_Fixture(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+	loadFixture(t, ctx, k, "v1.json")
+	require.Equal(t, uint64(1), k.GetConsensusVersion(ctx))
+	
+	require.Nil(t, RunMigrations(ctx, k, Migrations))
+	require.Equal(t, types.ConsensusVersion, k.GetConsensusVersion(ctx))
+	
+	msg, broken := AllInvariants(k)(ctx)
+	require.False(t, broken, msg)
+	
+	nodes := k.GetAllNodes(ctx)
+	require.Len(t, nodes, 1)
+	require.Equal(t, uint64(1), k.GetNodesCountOfAddress(ctx, nodes[0].Owner))
+	
+	subscriptions := k.GetAllSubscriptions(ctx)
+	require.Len(t, subscriptions, 1)
+	require.Equal(t, nodes[0].ID, subscriptions[0].NodeID)
+	require.Equal(t, types.PlanTypePerGB, subscriptions[0].Plan.Type)
+	
+	resolvers := k.GetAllResolvers(ctx)
+	require.Len(t, resolvers, 1)
+	require.Nil(t, resolvers[0].IsValid())
+	
+	freeClients := k.GetFreeClients(ctx)
+	require.Len(t, freeClients, 2)
+	for _, freeClient := range freeClients {
+		require.Nil(t, freeClient.IsValid())
+		require.True(t, freeClient.IsUnlimited())
+		require.Equal(t, nodes[0].ID, freeClient.NodeID)
+	}
+	
+	_, found := k.GetActiveFreeClient(ctx, subscriptions[0].NodeID, subscriptions[0].Client)
+	require.True(t, found)
+	
+	require.Nil(t, RunMigrations(ctx, k, Migrations))
+	require.Equal(t, types.ConsensusVersion, k.GetConsensusVersion(ctx))
+}
+
+func TestRunMigrations(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+	
+	var runs []uint64
+	migration := func(version uint64, err error) Migration {
+		return Migration{
+			Version: version,
+			Migrate: func(ctx sdk.Context, k Keeper) error {
+				runs = append(runs, version)
+				k.SetNodesCount(ctx, version)
+				return err
+			},
+		}
+	}
+	
+	require.Equal(t, uint64(1), k.GetConsensusVersion(ctx))
+	
+	require.NotNil(t, RunMigrations(ctx, k, []Migration{migration(3, nil)}))
+	require.Equal(t, uint64(1), k.GetConsensusVersion(ctx))
+	require.Nil(t, runs)
+	
+	require.Nil(t, RunMigrations(ctx, k, []Migration{migration(1, nil), migration(2, nil)}))
+	require.Equal(t, uint64(2), k.GetConsensusVersion(ctx))
+	require.Equal(t, []uint64{2}, runs)
+	require.Equal(t, uint64(2), k.GetNodesCount(ctx))
+	
+	events := ctx.EventManager().Events()
+	require.Equal(t, types.EventTypeMigrateStore, events[len(events)-1].Type)
+	
+	runs = nil
+	require.NotNil(t, RunMigrations(ctx, k, []Migration{
+		migration(2, nil), migration(3, fmt.Errorf("failed")), migration(4, nil),
+	}))
+	require.Equal(t, []uint64{3}, runs)
+	require.Equal(t, uint64(2), k.GetConsensusVersion(ctx))
+	require.Equal(t, uint64(2), k.GetNodesCount(ctx))
+}
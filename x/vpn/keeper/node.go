@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"encoding/binary"
+	"time"
+	
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	
 	hub "github.com/sentinel-official/hub/types"
@@ -120,6 +123,27 @@ func (k Keeper) DeleteActiveNodeIDs(ctx sdk.Context, height int64) {
 	store.Delete(key)
 }
 
+func (k Keeper) IterateActiveNodeIDs(ctx sdk.Context, fn func(height int64, ids hub.IDs) (stop bool)) {
+	store := ctx.KVStore(k.nodeKey)
+	
+	// active node IDs are stored under the bare big endian height, so scan the zero prefix and skip other keys
+	iterator := sdk.KVStorePrefixIterator(store, types.NodesCountKey)
+	defer iterator.Close()
+	
+	for ; iterator.Valid(); iterator.Next() {
+		if len(iterator.Key()) != 8 {
+			continue
+		}
+		
+		var ids hub.IDs
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &ids)
+		
+		if stop := fn(int64(binary.BigEndian.Uint64(iterator.Key())), ids); stop {
+			break
+		}
+	}
+}
+
 func (k Keeper) GetNodesOfAddress(ctx sdk.Context, address sdk.AccAddress) (nodes []types.Node) {
 	count := k.GetNodesCountOfAddress(ctx, address)
 	
@@ -172,3 +196,30 @@ func (k Keeper) RemoveNodeIDFromActiveList(ctx sdk.Context, height int64, id hub
 	ids = ids.Delete(index)
 	k.SetActiveNodeIDs(ctx, height, ids)
 }
+
+func (k Keeper) AddNodeIDToUnbondingQueue(ctx sdk.Context, t time.Time, id hub.NodeID) {
+	store := ctx.KVStore(k.nodeKey)
+	store.Set(types.NodeUnbondingQueueKey(t, id), id.Bytes())
+}
+
+func (k Keeper) RemoveNodeIDFromUnbondingQueue(ctx sdk.Context, t time.Time, id hub.NodeID) {
+	store := ctx.KVStore(k.nodeKey)
+	store.Delete(types.NodeUnbondingQueueKey(t, id))
+}
+
+// IterateUnbondedNodeIDs iterates over the IDs of the nodes completing the unbonding at or before the given time
+func (k Keeper) IterateUnbondedNodeIDs(ctx sdk.Context, t time.Time,
+	fn func(index int64, id hub.NodeID) (stop bool)) {
+	store := ctx.KVStore(k.nodeKey)
+	
+	end := sdk.PrefixEndBytes(types.NodeUnbondingTimeKey(t))
+	iterator := store.Iterator(types.NodeUnbondingQueueKeyPrefix, end)
+	defer iterator.Close()
+	
+	for i := int64(0); iterator.Valid(); iterator.Next() {
+		if stop := fn(i, iterator.Value()); stop {
+			break
+		}
+		i++
+	}
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"testing"
+	"time"
 	
 	"github.com/stretchr/testify/require"
 	
@@ -298,3 +299,27 @@ func TestKeeper_RemoveNodeIDFromActiveList(t *testing.T) {
 	ids = k.GetActiveNodeIDs(ctx, 2)
 	require.Equal(t, hub.IDs(nil), ids)
 }
+
+func TestKeeper_IterateUnbondedNodeIDs(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+	
+	now := time.Now().UTC()
+	unbonded := func(t time.Time) (ids []hub.NodeID) {
+		k.IterateUnbondedNodeIDs(ctx, t, func(_ int64, id hub.NodeID) bool {
+			ids = append(ids, id)
+			return false
+		})
+		return ids
+	}
+	
+	require.Equal(t, []hub.NodeID(nil), unbonded(now))
+	
+	k.AddNodeIDToUnbondingQueue(ctx, now, hub.NewNodeID(0))
+	k.AddNodeIDToUnbondingQueue(ctx, now.Add(time.Hour), hub.NewNodeID(1))
+	require.Equal(t, []hub.NodeID(nil), unbonded(now.Add(-time.Second)))
+	require.Equal(t, []hub.NodeID{hub.NewNodeID(0)}, unbonded(now))
+	require.Equal(t, []hub.NodeID{hub.NewNodeID(0), hub.NewNodeID(1)}, unbonded(now.Add(time.Hour)))
+	
+	k.RemoveNodeIDFromUnbondingQueue(ctx, now, hub.NewNodeID(0))
+	require.Equal(t, []hub.NodeID{hub.NewNodeID(1)}, unbonded(now.Add(time.Hour)))
+}
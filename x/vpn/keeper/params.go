@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+	
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	
@@ -30,11 +32,72 @@ func (k Keeper) SessionInactiveInterval(ctx sdk.Context) (res int64) {
 	return
 }
 
+// The parameters below were added after the launch and are missing on chains which started before them
+// until MigrateLegacyRecords stores their defaults, so they are read with GetIfExists and fall back to
+// the defaults as well.
+
+func (k Keeper) NodeInactiveInterval(ctx sdk.Context) (res int64) {
+	res = types.DefaultNodeInactiveInterval
+	k.paramStore.GetIfExists(ctx, types.KeyNodeInactiveInterval, &res)
+	return
+}
+
+func (k Keeper) SlashFraction(ctx sdk.Context) (res sdk.Dec) {
+	res = types.DefaultSlashFraction
+	k.paramStore.GetIfExists(ctx, types.KeySlashFraction, &res)
+	return
+}
+
+func (k Keeper) NodeUnbondingPeriod(ctx sdk.Context) (res time.Duration) {
+	res = types.DefaultNodeUnbondingPeriod
+	k.paramStore.GetIfExists(ctx, types.KeyNodeUnbondingPeriod, &res)
+	return
+}
+
+func (k Keeper) ResolverUnbondingPeriod(ctx sdk.Context) (res time.Duration) {
+	res = types.DefaultResolverUnbondingPeriod
+	k.paramStore.GetIfExists(ctx, types.KeyResolverUnbondingPeriod, &res)
+	return
+}
+
+func (k Keeper) MaxPricesPerGB(ctx sdk.Context) (res sdk.Coins) {
+	k.paramStore.GetIfExists(ctx, types.KeyMaxPricesPerGB, &res)
+	return
+}
+
+// ResolverMinDeposit is not preset to the default, because decoding into the default coin would overwrite
+// its amount. Without the parameter no resolver can register until the migration runs.
+func (k Keeper) ResolverMinDeposit(ctx sdk.Context) (res sdk.Coin) {
+	k.paramStore.GetIfExists(ctx, types.KeyResolverMinDeposit, &res)
+	return
+}
+
+func (k Keeper) CommissionChangeInterval(ctx sdk.Context) (res int64) {
+	res = types.DefaultCommissionChangeInterval
+	k.paramStore.GetIfExists(ctx, types.KeyCommissionChangeInterval, &res)
+	return
+}
+
+// ExchangeRates returns the table the prices of the nodes are converted with. It is read with GetIfExists
+// because chains which started before the parameter existed do not have it until it is migrated or set.
+func (k Keeper) ExchangeRates(ctx sdk.Context) (res types.ExchangeRates) {
+	k.paramStore.GetIfExists(ctx, types.KeyExchangeRates, &res)
+	return
+}
+
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
 		k.FreeNodesCount(ctx),
 		k.Deposit(ctx),
 		k.SessionInactiveInterval(ctx),
+		k.NodeInactiveInterval(ctx),
+		k.SlashFraction(ctx),
+		k.NodeUnbondingPeriod(ctx),
+		k.ResolverUnbondingPeriod(ctx),
+		k.MaxPricesPerGB(ctx),
+		k.ResolverMinDeposit(ctx),
+		k.CommissionChangeInterval(ctx),
+		k.ExchangeRates(ctx),
 	)
 }
 
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+	
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	
 	hub "github.com/sentinel-official/hub/types"
@@ -120,6 +122,45 @@ func (k Keeper) GetAllResolvers(ctx sdk.Context) (resolvers types.Resolvers) {
 	return resolvers
 }
 
+func (k Keeper) SetResolverCommission(ctx sdk.Context, commission types.ResolverCommission) {
+	key := types.ResolverCommissionKey(commission.ResolverID, commission.Height)
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(commission)
+	
+	store := ctx.KVStore(k.resolverKey)
+	store.Set(key, value)
+}
+
+// GetCommissionsOfResolver returns the commission history of the resolver in the ascending order of height
+func (k Keeper) GetCommissionsOfResolver(ctx sdk.Context, id hub.ResolverID) (commissions types.ResolverCommissions) {
+	store := ctx.KVStore(k.resolverKey)
+	
+	iter := sdk.KVStorePrefixIterator(store, types.ResolverCommissionsKey(id))
+	defer iter.Close()
+	
+	for ; iter.Valid(); iter.Next() {
+		var commission types.ResolverCommission
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &commission)
+		commissions = append(commissions, commission)
+	}
+	
+	return commissions
+}
+
+func (k Keeper) GetAllResolverCommissions(ctx sdk.Context) (commissions types.ResolverCommissions) {
+	store := ctx.KVStore(k.resolverKey)
+	
+	iter := sdk.KVStorePrefixIterator(store, types.ResolverCommissionKeyPrefix)
+	defer iter.Close()
+	
+	for ; iter.Valid(); iter.Next() {
+		var commission types.ResolverCommission
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &commission)
+		commissions = append(commissions, commission)
+	}
+	
+	return commissions
+}
+
 func (k Keeper) SetResolverOfNode(ctx sdk.Context, nodeID hub.NodeID, resolver hub.ResolverID) {
 	key := types.ResolverOfNodeKey(nodeID, resolver)
 	value := k.cdc.MustMarshalBinaryLengthPrefixed(resolver)
@@ -206,3 +247,76 @@ func (k Keeper) RemoveVPNNodeOnResolver(ctx sdk.Context, nodeID hub.NodeID, reso
 	store.Delete(nodeKey)
 	store.Delete(resolverKey)
 }
+
+func (k Keeper) SetPendingNodeOfResolver(ctx sdk.Context, resolverID hub.ResolverID, nodeID hub.NodeID) {
+	key := types.PendingNodeOfResolverKey(resolverID, nodeID)
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(nodeID)
+	
+	store := ctx.KVStore(k.resolverKey)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetPendingNodeOfResolver(ctx sdk.Context, resolverID hub.ResolverID,
+	nodeID hub.NodeID) (id hub.NodeID, found bool) {
+	key := types.PendingNodeOfResolverKey(resolverID, nodeID)
+	
+	store := ctx.KVStore(k.resolverKey)
+	value := store.Get(key)
+	if value == nil {
+		return nil, false
+	}
+	
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &id)
+	return id, true
+}
+
+// GetPendingNodesOfResolver returns the nodes which requested a listing on the resolver
+func (k Keeper) GetPendingNodesOfResolver(ctx sdk.Context, resolverID hub.ResolverID) (nodeIDs []hub.NodeID) {
+	store := ctx.KVStore(k.resolverKey)
+	key := types.PendingNodeOfResolverKey(resolverID, nil)
+	
+	iter := sdk.KVStorePrefixIterator(store, key)
+	defer iter.Close()
+	
+	for ; iter.Valid(); iter.Next() {
+		var nodeID hub.NodeID
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &nodeID)
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	
+	return nodeIDs
+}
+
+func (k Keeper) RemovePendingNodeOfResolver(ctx sdk.Context, resolverID hub.ResolverID, nodeID hub.NodeID) {
+	key := types.PendingNodeOfResolverKey(resolverID, nodeID)
+	
+	store := ctx.KVStore(k.resolverKey)
+	store.Delete(key)
+}
+
+func (k Keeper) AddResolverIDToUnbondingQueue(ctx sdk.Context, t time.Time, id hub.ResolverID) {
+	store := ctx.KVStore(k.resolverKey)
+	store.Set(types.ResolverUnbondingQueueKey(t, id), id.Bytes())
+}
+
+func (k Keeper) RemoveResolverIDFromUnbondingQueue(ctx sdk.Context, t time.Time, id hub.ResolverID) {
+	store := ctx.KVStore(k.resolverKey)
+	store.Delete(types.ResolverUnbondingQueueKey(t, id))
+}
+
+// IterateUnbondedResolverIDs iterates over the IDs of the resolvers completing the unbonding at or before the given time
+func (k Keeper) IterateUnbondedResolverIDs(ctx sdk.Context, t time.Time,
+	fn func(index int64, id hub.ResolverID) (stop bool)) {
+	store := ctx.KVStore(k.resolverKey)
+	
+	end := sdk.PrefixEndBytes(types.ResolverUnbondingTimeKey(t))
+	iterator := store.Iterator(types.ResolverUnbondingQueueKeyPrefix, end)
+	defer iterator.Close()
+	
+	for i := int64(0); iterator.Valid(); iterator.Next() {
+		if stop := fn(i, iterator.Value()); stop {
+			break
+		}
+		i++
+	}
+}
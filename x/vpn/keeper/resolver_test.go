@@ -3,6 +3,7 @@ package keeper
 import (
 	"testing"
 	
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	
 	hub "github.com/sentinel-official/hub/types"
@@ -69,3 +70,47 @@ func TestKeeper_Resolver(t *testing.T) {
 	require.False(t, found)
 	require.Nil(t, id)
 }
+
+func TestKeeper_ResolverCommission(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+	
+	commissions := k.GetCommissionsOfResolver(ctx, types.TestResolver.ID)
+	require.Equal(t, 0, len(commissions))
+	
+	commission1 := types.ResolverCommission{ResolverID: hub.NewResolverID(0), Commission: sdk.NewDecWithPrec(1, 1), Height: 256}
+	commission2 := types.ResolverCommission{ResolverID: hub.NewResolverID(0), Commission: sdk.NewDecWithPrec(2, 1), Height: 1}
+	commission3 := types.ResolverCommission{ResolverID: hub.NewResolverID(1), Commission: sdk.NewDecWithPrec(3, 1), Height: 2}
+	
+	k.SetResolverCommission(ctx, commission1)
+	k.SetResolverCommission(ctx, commission2)
+	k.SetResolverCommission(ctx, commission3)
+	
+	commissions = k.GetCommissionsOfResolver(ctx, hub.NewResolverID(0))
+	require.Equal(t, types.ResolverCommissions{commission2, commission1}, commissions)
+	
+	commissions = k.GetCommissionsOfResolver(ctx, hub.NewResolverID(1))
+	require.Equal(t, types.ResolverCommissions{commission3}, commissions)
+	
+	commissions = k.GetAllResolverCommissions(ctx)
+	require.Equal(t, 3, len(commissions))
+}
+
+func TestKeeper_PendingNodeOfResolver(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+	
+	_, found := k.GetPendingNodeOfResolver(ctx, types.TestResolver.ID, hub.NewNodeID(0))
+	require.False(t, found)
+	
+	k.SetPendingNodeOfResolver(ctx, types.TestResolver.ID, hub.NewNodeID(0))
+	k.SetPendingNodeOfResolver(ctx, types.TestResolver.ID, hub.NewNodeID(1))
+	k.SetPendingNodeOfResolver(ctx, hub.NewResolverID(1), hub.NewNodeID(0))
+	
+	id, found := k.GetPendingNodeOfResolver(ctx, types.TestResolver.ID, hub.NewNodeID(1))
+	require.True(t, found)
+	require.Equal(t, hub.NewNodeID(1), id)
+	require.Equal(t, []hub.NodeID{hub.NewNodeID(0), hub.NewNodeID(1)}, k.GetPendingNodesOfResolver(ctx, types.TestResolver.ID))
+	
+	k.RemovePendingNodeOfResolver(ctx, types.TestResolver.ID, hub.NewNodeID(0))
+	require.Equal(t, []hub.NodeID{hub.NewNodeID(1)}, k.GetPendingNodesOfResolver(ctx, types.TestResolver.ID))
+	require.Equal(t, 0, len(k.GetNodesOfResolver(ctx, types.TestResolver.ID)))
+}
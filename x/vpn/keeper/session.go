@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"encoding/binary"
+	
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	
 	hub "github.com/sentinel-official/hub/types"
@@ -121,12 +123,36 @@ func (k Keeper) DeleteActiveSessionIDs(ctx sdk.Context, height int64) {
 	store.Delete(key)
 }
 
+func (k Keeper) IterateActiveSessionIDs(ctx sdk.Context, fn func(height int64, ids hub.IDs) (stop bool)) {
+	store := ctx.KVStore(k.sessionKey)
+	
+	// active session IDs are stored under the bare big endian height, so scan the zero prefix and skip other keys
+	iterator := sdk.KVStorePrefixIterator(store, types.SessionsCountKey)
+	defer iterator.Close()
+	
+	for ; iterator.Valid(); iterator.Next() {
+		if len(iterator.Key()) != 8 {
+			continue
+		}
+		
+		var ids hub.IDs
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &ids)
+		
+		if stop := fn(int64(binary.BigEndian.Uint64(iterator.Key())), ids); stop {
+			break
+		}
+	}
+}
+
 func (k Keeper) GetSessionsOfSubscription(ctx sdk.Context, id hub.SubscriptionID) (sessions []types.Session) {
 	count := k.GetSessionsCountOfSubscription(ctx, id)
 	
 	sessions = make([]types.Session, 0, count)
 	for i := uint64(0); i < count; i++ {
-		_id, _ := k.GetSessionIDBySubscriptionID(ctx, id, i)
+		_id, found := k.GetSessionIDBySubscriptionID(ctx, id, i)
+		if !found {
+			continue
+		}
 		
 		session, _ := k.GetSession(ctx, _id)
 		sessions = append(sessions, session)
@@ -135,6 +161,17 @@ func (k Keeper) GetSessionsOfSubscription(ctx sdk.Context, id hub.SubscriptionID
 	return sessions
 }
 
+// GetActiveSessionsOfSubscription returns the sessions of the subscription which are not settled yet
+func (k Keeper) GetActiveSessionsOfSubscription(ctx sdk.Context, id hub.SubscriptionID) (sessions []types.Session) {
+	for _, session := range k.GetSessionsOfSubscription(ctx, id) {
+		if session.Status == types.StatusActive {
+			sessions = append(sessions, session)
+		}
+	}
+	
+	return sessions
+}
+
 func (k Keeper) GetAllSessions(ctx sdk.Context) (sessions []types.Session) {
 	store := ctx.KVStore(k.sessionKey)
 	
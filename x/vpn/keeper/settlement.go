@@ -0,0 +1,339 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/deposit"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func (k Keeper) SetSettlement(ctx sdk.Context, settlement types.Settlement) {
+	key := types.SettlementKey(settlement.SessionID)
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(settlement)
+	
+	store := ctx.KVStore(k.sessionKey)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetSettlement(ctx sdk.Context, id hub.SessionID) (settlement types.Settlement, found bool) {
+	store := ctx.KVStore(k.sessionKey)
+	
+	key := types.SettlementKey(id)
+	value := store.Get(key)
+	if value == nil {
+		return settlement, false
+	}
+	
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &settlement)
+	return settlement, true
+}
+
+func (k Keeper) GetAllSettlements(ctx sdk.Context) (settlements []types.Settlement) {
+	store := ctx.KVStore(k.sessionKey)
+	
+	iter := sdk.KVStorePrefixIterator(store, types.SettlementKeyPrefix)
+	defer iter.Close()
+	
+	for ; iter.Valid(); iter.Next() {
+		var settlement types.Settlement
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &settlement)
+		settlements = append(settlements, settlement)
+	}
+	
+	return settlements
+}
+
+// SettleSession bills the bandwidth of an active session against its subscription, pays the node owner and
+// the resolver, closes the session and stores the settlement. The bandwidth of a free client is drawn down
+// from the quota of its grant and only the part exceeding the quota is billed. A subscription whose deposit was
+// not locked is settled as well once the unlimited grant which covered it is gone. Nothing is written when an
+// error is returned as long as the caller passes a cached context.
+func (k Keeper) SettleSession(ctx sdk.Context, session types.Session) (types.Settlement, sdk.Error) {
+	if session.Status != types.StatusActive {
+		return types.Settlement{}, types.ErrorInvalidSessionStatus()
+	}
+	
+	subscription, found := k.GetSubscription(ctx, session.SubscriptionID)
+	if !found {
+		return types.Settlement{}, types.ErrorSubscriptionDoesNotExist()
+	}
+	
+	node, found := k.GetNode(ctx, subscription.NodeID)
+	if !found {
+		return types.Settlement{}, types.ErrorNodeDoesNotExist()
+	}
+	
+	resolver, found := k.GetResolver(ctx, subscription.ResolverID)
+	if !found {
+		return types.Settlement{}, types.ErrorResolverDoesNotExist()
+	}
+	
+	settlement := types.Settlement{
+		SessionID:      session.ID,
+		SubscriptionID: subscription.ID,
+		Payer:          subscription.Client,
+		NodePayee:      node.Owner,
+		ResolverPayee:  resolver.Owner,
+		Amount:         sdk.NewInt64Coin(subscription.PricePerGB.Denom, 0),
+		Commission:     sdk.NewInt64Coin(subscription.PricePerGB.Denom, 0),
+		Bandwidth:      billableBandwidth(session.Bandwidth, subscription),
+		Height:         ctx.BlockHeight(),
+	}
+	
+	k.RevokeInactiveFreeClient(ctx, subscription.NodeID, subscription.Client)
+	revoked := k.IsFreeServiceRevoked(ctx, subscription)
+	
+	billable := sdk.ZeroInt()
+	if subscription.DepositLocked {
+		billable = settlement.Bandwidth.Sum()
+		if freeClient, found := k.GetActiveFreeClient(ctx, subscription.NodeID, subscription.Client); found {
+			billable = billable.Sub(k.ConsumeFreeClientBandwidth(ctx, freeClient, settlement.Bandwidth))
+		}
+	}
+	
+	if isBilledPerGB(subscription) && billable.IsPositive() {
+		amount := billable.Mul(subscription.PricePerGB.Amount).Quo(hub.GB)
+		if amount.GT(subscription.RemainingDeposit.Amount) {
+			amount = subscription.RemainingDeposit.Amount
+		}
+		
+		settlement.Amount = sdk.NewCoin(subscription.PricePerGB.Denom, amount)
+		
+		var err sdk.Error
+		if subscription, err = k.pay(ctx, subscription, node, resolver, settlement.Amount); err != nil {
+			return types.Settlement{}, err
+		}
+		
+		settlement.Commission = subscription.GetCommission(settlement.Amount)
+	}
+	
+	subscription.RemainingDeposit = subscription.RemainingDeposit.Sub(settlement.Amount)
+	if subscription.DepositLocked && subscription.Deposits.Empty() {
+		subscription.RemainingDeposit = sdk.NewInt64Coin(subscription.RemainingDeposit.Denom, 0)
+	}
+	if !subscription.Plan.IsTimeBased() {
+		subscription.RemainingBandwidth = subscription.RemainingBandwidth.Sub(settlement.Bandwidth)
+	}
+	k.SetSubscription(ctx, subscription)
+	
+	k.RemoveSessionIDFromActiveList(ctx, session.StatusModifiedAt, session.ID)
+	session.Status = types.StatusInactive
+	session.StatusModifiedAt = ctx.BlockHeight()
+	k.SetSession(ctx, session)
+	
+	k.SetSettlement(ctx, settlement)
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSettleSession,
+			sdk.NewAttribute(types.AttributeSessionID, settlement.SessionID.String()),
+			sdk.NewAttribute(types.AttributeSubscriptionID, settlement.SubscriptionID.String()),
+			sdk.NewAttribute(types.AttributeKeyPayer, settlement.Payer.String()),
+			sdk.NewAttribute(types.AttributeKeyNodePayee, settlement.NodePayee.String()),
+			sdk.NewAttribute(types.AttributeKeyResolverPayee, settlement.ResolverPayee.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, settlement.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCommission, settlement.Commission.String()),
+			sdk.NewAttribute(types.AttributeKeyBandwidth, settlement.Bandwidth.String()),
+		),
+	)
+	
+	if revoked && subscription.Status == types.StatusActive {
+		if err := k.SettleSubscription(ctx, subscription); err != nil {
+			return types.Settlement{}, err
+		}
+	}
+	
+	return settlement, nil
+}
+
+// IsFreeServiceRevoked returns true if the deposit of the subscription was not locked at the start, and the
+// unlimited grant of the client on the node which covered it has been removed or replaced since. Such a
+// subscription can not be billed, so it must not be used any more.
+func (k Keeper) IsFreeServiceRevoked(ctx sdk.Context, subscription types.Subscription) bool {
+	if subscription.DepositLocked {
+		return false
+	}
+	
+	freeClient, found := k.GetActiveFreeClient(ctx, subscription.NodeID, subscription.Client)
+	return !found || !freeClient.IsUnlimited()
+}
+
+// AbortSession closes an active session which can not be settled, without billing its bandwidth. The session is
+// not retried, and the deposit of its subscription stays locked until the subscription is settled.
+func (k Keeper) AbortSession(ctx sdk.Context, session types.Session) {
+	k.RemoveSessionIDFromActiveList(ctx, session.StatusModifiedAt, session.ID)
+	session.Status = types.StatusInactive
+	session.StatusModifiedAt = ctx.BlockHeight()
+	k.SetSession(ctx, session)
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAbortSession,
+			sdk.NewAttribute(types.AttributeSessionID, session.ID.String()),
+			sdk.NewAttribute(types.AttributeSubscriptionID, session.SubscriptionID.String()),
+			sdk.NewAttribute(types.AttributeKeyBandwidth, session.Bandwidth.String()),
+			sdk.NewAttribute(types.AttributeKeyStatus, session.Status),
+		),
+	)
+}
+
+// SettleSubscription pays the node owner and the resolver for the consumed part of the deposit of a subscription,
+// refunds the coins left of the deposit to the client and marks the subscription inactive. The deposit of
+// a per-GB subscription is consumed by its sessions only, so all of the remaining deposit is refunded. The deposit
+// of a free client is refunded in full while its grant is active, and is left alone if it was not locked at
+// the start.
+func (k Keeper) SettleSubscription(ctx sdk.Context, subscription types.Subscription) sdk.Error {
+	if subscription.Status != types.StatusActive {
+		return types.ErrorInvalidSubscriptionStatus()
+	}
+	
+	used := sdk.NewInt64Coin(subscription.RemainingDeposit.Denom, 0)
+	refund := sdk.Coins{}
+	
+	if subscription.DepositLocked && !subscription.Deposits.Empty() {
+		if _, isFreeClient := k.GetActiveFreeClient(ctx, subscription.NodeID, subscription.Client); !isFreeClient {
+			used = subscription.RemainingDeposit.Sub(subscription.UnusedDeposit(ctx.BlockTime()))
+		}
+		
+		if used.IsPositive() {
+			node, found := k.GetNode(ctx, subscription.NodeID)
+			if !found {
+				return types.ErrorNodeDoesNotExist()
+			}
+			
+			resolver, found := k.GetResolver(ctx, subscription.ResolverID)
+			if !found {
+				return types.ErrorResolverDoesNotExist()
+			}
+			
+			var err sdk.Error
+			if subscription, err = k.pay(ctx, subscription, node, resolver, used); err != nil {
+				return err
+			}
+		}
+		
+		refund = subscription.Deposits
+		if !refund.Empty() {
+			if err := k.UnlockDepositCoins(ctx, subscription.Client, subscription.ID, refund); err != nil {
+				return err
+			}
+		}
+		
+		subscription.Deposits = sdk.Coins{}
+		subscription.RemainingDeposit = sdk.NewInt64Coin(subscription.RemainingDeposit.Denom, 0)
+	}
+	
+	if !subscription.ExpiresAt.IsZero() {
+		k.RemoveSubscriptionIDFromExpiryQueue(ctx, subscription.ExpiresAt, subscription.ID)
+	}
+	
+	subscription.Status = types.StatusInactive
+	subscription.StatusModifiedAt = ctx.BlockHeight()
+	k.SetSubscription(ctx, subscription)
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSettleSubscription,
+			sdk.NewAttribute(types.AttributeSubscriptionID, subscription.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyPayer, subscription.Client.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, used.String()),
+			sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+		),
+	)
+	
+	return nil
+}
+
+// AbortSubscription closes an active subscription which can not be settled. Its sessions in progress are aborted,
+// nothing is paid and all of the deposit still locked by the subscription is refunded to the client.
+func (k Keeper) AbortSubscription(ctx sdk.Context, subscription types.Subscription) {
+	if !subscription.ExpiresAt.IsZero() {
+		k.RemoveSubscriptionIDFromExpiryQueue(ctx, subscription.ExpiresAt, subscription.ID)
+	}
+	if subscription.Status != types.StatusActive {
+		return
+	}
+	
+	for _, session := range k.GetActiveSessionsOfSubscription(ctx, subscription.ID) {
+		k.AbortSession(ctx, session)
+	}
+	
+	refund := k.ReleaseDeposit(ctx, subscription.Client, subscription.ID)
+	
+	subscription.Deposits = sdk.Coins{}
+	subscription.RemainingDeposit = sdk.NewInt64Coin(subscription.RemainingDeposit.Denom, 0)
+	subscription.Status = types.StatusInactive
+	subscription.StatusModifiedAt = ctx.BlockHeight()
+	k.SetSubscription(ctx, subscription)
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAbortSubscription,
+			sdk.NewAttribute(types.AttributeSubscriptionID, subscription.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyPayer, subscription.Client.String()),
+			sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+			sdk.NewAttribute(types.AttributeKeyStatus, subscription.Status),
+		),
+	)
+}
+
+// pay draws the coins worth the amount from the deposit locked by the subscription and sends them to the node
+// owner, less the commission locked in by the subscription which is sent to the resolver owner. The subscription
+// is returned with the coins drawn from its deposit.
+func (k Keeper) pay(ctx sdk.Context, subscription types.Subscription,
+	node types.Node, resolver types.Resolver, amount sdk.Coin) (types.Subscription, sdk.Error) {
+	payer := subscription.Client
+	coins := subscription.Draw(amount)
+	
+	for _, coin := range coins {
+		commission := subscription.GetCommission(coin)
+		if commission.IsGTE(coin) {
+			commission = coin
+		}
+		
+		if commission.IsPositive() {
+			if err := k.SendLockedDeposit(ctx, payer, subscription.ID, resolver.Owner, commission,
+				deposit.ReasonSettlement); err != nil {
+				return subscription, err
+			}
+		}
+		if coin.Sub(commission).IsPositive() {
+			if err := k.SendLockedDeposit(ctx, payer, subscription.ID, node.Owner, coin.Sub(commission),
+				deposit.ReasonSettlement); err != nil {
+				return subscription, err
+			}
+		}
+	}
+	
+	subscription.Deposits = subscription.Deposits.Sub(coins)
+	return subscription, nil
+}
+
+func isBilledPerGB(subscription types.Subscription) bool {
+	return subscription.Plan.IsPerGB()
+}
+
+// billableBandwidth rounds the bandwidth up to the smallest billable unit of a per-GB subscription
+// without exceeding the remaining bandwidth of the subscription. Time based plans are not metered.
+func billableBandwidth(bandwidth hub.Bandwidth, subscription types.Subscription) hub.Bandwidth {
+	if subscription.Plan.IsTimeBased() {
+		return bandwidth
+	}
+	
+	if isBilledPerGB(subscription) {
+		precision := hub.GB.Quo(subscription.PricePerGB.Amount)
+		if precision.IsPositive() {
+			bandwidth = bandwidth.CeilTo(precision)
+		}
+	}
+	
+	if bandwidth.Upload.GT(subscription.RemainingBandwidth.Upload) {
+		bandwidth.Upload = subscription.RemainingBandwidth.Upload
+	}
+	if bandwidth.Download.GT(subscription.RemainingBandwidth.Download) {
+		bandwidth.Download = subscription.RemainingBandwidth.Download
+	}
+	
+	return bandwidth
+}
@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	"testing"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func TestKeeper_SetSettlement(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+	
+	_, found := k.GetSettlement(ctx, hub.NewSessionID(0))
+	require.Equal(t, false, found)
+	
+	settlement := types.Settlement{
+		SessionID:      hub.NewSessionID(0),
+		SubscriptionID: hub.NewSubscriptionID(0),
+		Payer:          types.TestAddress2,
+		NodePayee:      types.TestAddress1,
+		ResolverPayee:  types.TestAddress3,
+		Amount:         sdk.NewInt64Coin("stake", 100),
+		Commission:     sdk.NewInt64Coin("stake", 12),
+		Bandwidth:      types.TestBandwidthPos1,
+		Height:         1,
+	}
+	k.SetSettlement(ctx, settlement)
+	result, found := k.GetSettlement(ctx, settlement.SessionID)
+	require.Equal(t, true, found)
+	require.Equal(t, settlement, result)
+	require.Equal(t, sdk.NewInt64Coin("stake", 88), result.NodeAmount())
+	
+	settlements := k.GetAllSettlements(ctx)
+	require.Equal(t, []types.Settlement{settlement}, settlements)
+}
+
+func TestKeeper_GetSettlement(t *testing.T) {
+	TestKeeper_SetSettlement(t)
+}
+
+func TestKeeper_SettleSession(t *testing.T) {
+	ctx, k, _, bk := CreateTestInput(t, false)
+	
+	session := types.TestSession
+	_, err := k.SettleSession(ctx, session)
+	require.NotNil(t, err)
+	
+	resolver := types.TestResolver
+	resolver.Commission = sdk.NewDecWithPrec(2, 1)
+	
+	k.SetNode(ctx, types.TestNode)
+	k.SetResolver(ctx, resolver)
+	k.SetSubscription(ctx, types.TestSubscription)
+	k.SetSession(ctx, session)
+	k.AddSessionIDToActiveList(ctx, session.StatusModifiedAt, session.ID)
+	
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = k.SettleSession(cacheCtx, session)
+	require.NotNil(t, err)
+	
+	result, _ := k.GetSession(ctx, session.ID)
+	require.Equal(t, types.StatusActive, result.Status)
+	_, found := k.GetSettlement(ctx, session.ID)
+	require.Equal(t, false, found)
+	
+	_, err = bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	err = k.LockDeposit(ctx, types.TestAddress2, types.TestSubscription.ID, sdk.NewInt64Coin("stake", 100))
+	require.Nil(t, err)
+	
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	settlement, err := k.SettleSession(ctx, session)
+	require.Nil(t, err)
+	require.Equal(t, types.TestAddress2, settlement.Payer)
+	require.Equal(t, types.TestAddress1, settlement.NodePayee)
+	require.Equal(t, types.TestAddress3, settlement.ResolverPayee)
+	require.Equal(t, sdk.NewInt64Coin("stake", 100), settlement.Amount)
+	require.Equal(t, sdk.NewInt64Coin("stake", 12), settlement.Commission)
+	require.Equal(t, types.TestBandwidthPos1, settlement.Bandwidth)
+	require.Equal(t, int64(10), settlement.Height)
+	
+	count := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeSettleSession {
+			count++
+		}
+	}
+	require.Equal(t, 1, count)
+	
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 88)}, bk.GetCoins(ctx, types.TestAddress1))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 12)}, bk.GetCoins(ctx, types.TestAddress3))
+	
+	result, _ = k.GetSession(ctx, session.ID)
+	require.Equal(t, types.StatusInactive, result.Status)
+	require.Equal(t, int64(10), result.StatusModifiedAt)
+	require.Len(t, k.GetActiveSessionIDs(ctx, session.StatusModifiedAt), 0)
+	
+	subscription, _ := k.GetSubscription(ctx, session.SubscriptionID)
+	require.Equal(t, sdk.NewInt64Coin("stake", 0), subscription.RemainingDeposit)
+	require.Equal(t, types.TestBandwidthZero, subscription.RemainingBandwidth)
+	require.Len(t, k.GetActiveSessionsOfSubscription(ctx, subscription.ID), 0)
+	
+	stored, found := k.GetSettlement(ctx, session.ID)
+	require.Equal(t, true, found)
+	require.Equal(t, settlement, stored)
+	
+	_, err = k.SettleSession(ctx, result)
+	require.NotNil(t, err)
+}
+
+func TestKeeper_SettleSession_FreeClient(t *testing.T) {
+	ctx, k, _, bk := CreateTestInput(t, false)
+	
+	session := types.TestSession
+	k.SetNode(ctx, types.TestNode)
+	k.SetResolver(ctx, types.TestResolver)
+	k.SetSubscription(ctx, types.TestSubscription)
+	k.SetSession(ctx, session)
+	k.AddSessionIDToActiveList(ctx, session.StatusModifiedAt, session.ID)
+	
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	err = k.LockDeposit(ctx, types.TestAddress2, types.TestSubscription.ID, sdk.NewInt64Coin("stake", 100))
+	require.Nil(t, err)
+	
+	quota := hub.NewBandwidthFromInt64(250000000, 250000000)
+	k.SetFreeClient(ctx, types.NewFreeClient(hub.NewNodeID(0), types.TestAddress2, quota, 0, 0))
+	
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	settlement, err := k.SettleSession(ctx, session)
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt64Coin("stake", 50), settlement.Amount)
+	require.Equal(t, sdk.NewInt64Coin("stake", 6), settlement.Commission)
+	require.Equal(t, types.TestBandwidthPos1, settlement.Bandwidth)
+	
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 44)}, bk.GetCoins(ctx, types.TestAddress1))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 6)}, bk.GetCoins(ctx, types.TestAddress3))
+	
+	subscription, _ := k.GetSubscription(ctx, session.SubscriptionID)
+	require.Equal(t, sdk.NewInt64Coin("stake", 50), subscription.RemainingDeposit)
+	
+	count := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeFreeClientExhausted {
+			count++
+		}
+	}
+	require.Equal(t, 1, count)
+	
+	_, found := k.GetFreeClient(ctx, hub.NewNodeID(0), types.TestAddress2)
+	require.Equal(t, false, found)
+	require.Len(t, k.GetFreeClients(ctx), 0)
+}
+
+func TestKeeper_SettleSubscription_DepositLocked(t *testing.T) {
+	ctx, k, dk, bk := CreateTestInput(t, false)
+	
+	k.SetNode(ctx, types.TestNode)
+	k.SetResolver(ctx, types.TestResolver)
+	
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	err = k.LockDeposit(ctx, types.TestAddress2, types.TestSubscription.ID, sdk.NewInt64Coin("stake", 100))
+	require.Nil(t, err)
+	
+	k.SetFreeClient(ctx, types.NewFreeClient(hub.NewNodeID(0), types.TestAddress2, types.TestBandwidthZero, 0, 0))
+	require.Nil(t, k.SettleSubscription(ctx, types.TestSubscription))
+	
+	deposit, _ := dk.GetDeposit(ctx, types.TestAddress2)
+	require.Len(t, deposit.Locks, 0)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, deposit.Free())
+	
+	k.RemoveFreeClient(ctx, hub.NewNodeID(0), types.TestAddress2)
+	
+	session := types.TestSession
+	subscription := types.TestSubscription
+	subscription.ID = hub.NewSubscriptionID(1)
+	subscription.DepositLocked = false
+	session.SubscriptionID = subscription.ID
+	k.SetSubscription(ctx, subscription)
+	k.SetSession(ctx, session)
+	
+	settlement, err := k.SettleSession(ctx, session)
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt64Coin("stake", 0), settlement.Amount)
+	
+	subscription, _ = k.GetSubscription(ctx, subscription.ID)
+	require.Equal(t, types.TestSubscription.RemainingDeposit, subscription.RemainingDeposit)
+	require.Equal(t, types.StatusInactive, subscription.Status)
+	
+	deposit, _ = dk.GetDeposit(ctx, types.TestAddress2)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, deposit.Free())
+}
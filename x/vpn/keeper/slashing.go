@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/deposit"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func (k Keeper) SetEvidence(ctx sdk.Context, evidence types.Evidence) {
+	key := types.EvidenceKey(evidence.SessionID)
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(evidence)
+	
+	store := ctx.KVStore(k.nodeKey)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetEvidence(ctx sdk.Context, id hub.SessionID) (evidence types.Evidence, found bool) {
+	store := ctx.KVStore(k.nodeKey)
+	
+	key := types.EvidenceKey(id)
+	value := store.Get(key)
+	if value == nil {
+		return evidence, false
+	}
+	
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &evidence)
+	return evidence, true
+}
+
+func (k Keeper) GetAllEvidences(ctx sdk.Context) (evidences []types.Evidence) {
+	store := ctx.KVStore(k.nodeKey)
+	
+	iter := sdk.KVStorePrefixIterator(store, types.EvidenceKeyPrefix)
+	defer iter.Close()
+	
+	for ; iter.Valid(); iter.Next() {
+		var evidence types.Evidence
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &evidence)
+		evidences = append(evidences, evidence)
+	}
+	
+	return evidences
+}
+
+// SlashNode sends the slash fraction of the deposit of the node to the victim and jails the node.
+// A jailed node does not accept new subscriptions until the slashed amount is topped up.
+func (k Keeper) SlashNode(ctx sdk.Context, node types.Node, victim sdk.AccAddress) (sdk.Coin, sdk.Error) {
+	amount := sdk.NewCoin(node.Deposit.Denom,
+		node.Deposit.Amount.ToDec().Mul(k.SlashFraction(ctx)).TruncateInt())
+	
+	if amount.IsPositive() {
+		if err := k.SendLockedDeposit(ctx, node.Owner, node.ID, victim, amount, deposit.ReasonSlash); err != nil {
+			return amount, err
+		}
+		
+		node.Deposit = node.Deposit.Sub(amount)
+	}
+	
+	slashed := amount
+	if node.Jailed && node.Slashed != nil {
+		slashed = node.Slashed.Add(amount)
+	}
+	
+	node.Jailed = true
+	node.Slashed = &slashed
+	k.SetNode(ctx, node)
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlashNode,
+			sdk.NewAttribute(types.AttributeKeyNodeID, node.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyVictim, victim.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+	
+	return amount, nil
+}
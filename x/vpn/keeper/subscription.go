@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+	
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	
 	hub "github.com/sentinel-official/hub/types"
@@ -161,6 +163,18 @@ func (k Keeper) GetSubscriptionsOfAddress(ctx sdk.Context,
 	return subscriptions
 }
 
+// GetActiveSubscriptionsCountOfClientOnNode returns the number of active subscriptions of the address on the node
+func (k Keeper) GetActiveSubscriptionsCountOfClientOnNode(ctx sdk.Context,
+	address sdk.AccAddress, id hub.NodeID) (count uint64) {
+	for _, subscription := range k.GetSubscriptionsOfAddress(ctx, address) {
+		if subscription.Status == types.StatusActive && subscription.NodeID.IsEqual(id) {
+			count++
+		}
+	}
+	
+	return count
+}
+
 func (k Keeper) GetAllSubscriptions(ctx sdk.Context) (subscriptions []types.Subscription) {
 	store := ctx.KVStore(k.subscriptionKey)
 	
@@ -193,3 +207,30 @@ func (k Keeper) IterateSubscriptions(ctx sdk.Context,
 		i++
 	}
 }
+
+func (k Keeper) AddSubscriptionIDToExpiryQueue(ctx sdk.Context, t time.Time, id hub.SubscriptionID) {
+	store := ctx.KVStore(k.subscriptionKey)
+	store.Set(types.SubscriptionExpiryQueueKey(t, id), id.Bytes())
+}
+
+func (k Keeper) RemoveSubscriptionIDFromExpiryQueue(ctx sdk.Context, t time.Time, id hub.SubscriptionID) {
+	store := ctx.KVStore(k.subscriptionKey)
+	store.Delete(types.SubscriptionExpiryQueueKey(t, id))
+}
+
+// IterateExpiredSubscriptionIDs iterates over the IDs of the subscriptions expiring at or before the given time
+func (k Keeper) IterateExpiredSubscriptionIDs(ctx sdk.Context, t time.Time,
+	fn func(index int64, id hub.SubscriptionID) (stop bool)) {
+	store := ctx.KVStore(k.subscriptionKey)
+	
+	end := sdk.PrefixEndBytes(types.SubscriptionExpiryTimeKey(t))
+	iterator := store.Iterator(types.SubscriptionExpiryQueueKeyPrefix, end)
+	defer iterator.Close()
+	
+	for i := int64(0); iterator.Valid(); iterator.Next() {
+		if stop := fn(i, iterator.Value()); stop {
+			break
+		}
+		i++
+	}
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"testing"
+	"time"
 	
 	"github.com/stretchr/testify/require"
 	
@@ -243,3 +244,28 @@ func TestKeeper_GetAllSubscriptions(t *testing.T) {
 	subscriptions = k.GetAllSubscriptions(ctx)
 	require.Equal(t, append([]types.Subscription{types.TestSubscription}, subscription), subscriptions)
 }
+
+func TestKeeper_IterateExpiredSubscriptionIDs(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	
+	k.AddSubscriptionIDToExpiryQueue(ctx, now, hub.NewSubscriptionID(1))
+	k.AddSubscriptionIDToExpiryQueue(ctx, now.Add(-time.Hour), hub.NewSubscriptionID(0))
+	k.AddSubscriptionIDToExpiryQueue(ctx, now.Add(time.Second), hub.NewSubscriptionID(2))
+	
+	var ids []hub.SubscriptionID
+	k.IterateExpiredSubscriptionIDs(ctx, now, func(_ int64, id hub.SubscriptionID) bool {
+		ids = append(ids, id)
+		return false
+	})
+	require.Equal(t, []hub.SubscriptionID{hub.NewSubscriptionID(0), hub.NewSubscriptionID(1)}, ids)
+	
+	k.RemoveSubscriptionIDFromExpiryQueue(ctx, now, hub.NewSubscriptionID(1))
+	
+	ids = nil
+	k.IterateExpiredSubscriptionIDs(ctx, now.Add(time.Second), func(_ int64, id hub.SubscriptionID) bool {
+		ids = append(ids, id)
+		return false
+	})
+	require.Equal(t, []hub.SubscriptionID{hub.NewSubscriptionID(0), hub.NewSubscriptionID(2)}, ids)
+}
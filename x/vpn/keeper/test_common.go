@@ -22,6 +22,12 @@ import (
 )
 
 func CreateTestInput(t *testing.T, isCheckTx bool) (sdk.Context, Keeper, deposit.Keeper, bank.Keeper) {
+	return createTestInput(t, isCheckTx, true)
+}
+
+// createTestInput leaves the parameters of the module unset unless withParams is true, as they are on chains
+// which started at version 1
+func createTestInput(t *testing.T, isCheckTx, withParams bool) (sdk.Context, Keeper, deposit.Keeper, bank.Keeper) {
 	keyParams := sdk.NewKVStoreKey(params.StoreKey)
 	keyAccount := sdk.NewKVStoreKey(auth.StoreKey)
 	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
@@ -63,7 +69,9 @@ func CreateTestInput(t *testing.T, isCheckTx bool) (sdk.Context, Keeper, deposit
 	vk := NewKeeper(cdc, keyNode, keySubscription, keySession, keyResolver, pk.Subspace(DefaultParamspace), dk)
 	
 	sk.SetModuleAccount(ctx, depositAccount)
-	vk.SetParams(ctx, types.DefaultParams())
+	if withParams {
+		vk.SetParams(ctx, types.DefaultParams())
+	}
 	
 	return ctx, vk, dk, bk
 }
@@ -0,0 +1,126 @@
+package vpn
+
+import (
+	"reflect"
+	"strconv"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	
+	"github.com/sentinel-official/hub/x/vpn/keeper"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) sdk.Error {
+		switch content := content.(type) {
+		case types.ChangeParamsProposal:
+			return handleChangeParamsProposal(ctx, k, content)
+		case types.BlacklistProposal:
+			return handleBlacklistProposal(ctx, k, content)
+		case types.MaxPricesPerGBProposal:
+			return handleMaxPricesPerGBProposal(ctx, k, content)
+		case types.ExchangeRatesProposal:
+			return handleExchangeRatesProposal(ctx, k, content)
+		
+		default:
+			return types.ErrorUnknownProposalType(reflect.TypeOf(content).Name())
+		}
+	}
+}
+
+func handleChangeParamsProposal(ctx sdk.Context, k keeper.Keeper, proposal types.ChangeParamsProposal) sdk.Error {
+	if err := proposal.Params.Validate(); err != nil {
+		return types.ErrorInvalidField("params: " + err.Error())
+	}
+	
+	k.SetParams(ctx, proposal.Params)
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChangeParams,
+			sdk.NewAttribute(types.AttributeKeyDeposit, proposal.Params.Deposit.String()),
+			sdk.NewAttribute(types.AttributeKeyPricesPerGB, proposal.Params.MaxPricesPerGB.String()),
+		),
+	)
+	
+	return nil
+}
+
+func handleBlacklistProposal(ctx sdk.Context, k keeper.Keeper, proposal types.BlacklistProposal) sdk.Error {
+	for _, id := range proposal.NodeIDs {
+		node, found := k.GetNode(ctx, id)
+		if !found {
+			return types.ErrorNodeDoesNotExist()
+		}
+		
+		node.Blacklisted = proposal.Blacklisted
+		k.SetNode(ctx, node)
+		
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeBlacklistNode,
+				sdk.NewAttribute(types.AttributeKeyNodeID, node.ID.String()),
+				sdk.NewAttribute(types.AttributeKeyBlacklisted, strconv.FormatBool(node.Blacklisted)),
+			),
+		)
+	}
+	
+	for _, id := range proposal.ResolverIDs {
+		resolver, found := k.GetResolver(ctx, id)
+		if !found {
+			return types.ErrorResolverDoesNotExist()
+		}
+		
+		resolver.Blacklisted = proposal.Blacklisted
+		k.SetResolver(ctx, resolver)
+		
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeBlacklistResolver,
+				sdk.NewAttribute(types.AttributeKeyResolverID, resolver.ID.String()),
+				sdk.NewAttribute(types.AttributeKeyBlacklisted, strconv.FormatBool(resolver.Blacklisted)),
+			),
+		)
+	}
+	
+	return nil
+}
+
+func handleMaxPricesPerGBProposal(ctx sdk.Context, k keeper.Keeper, proposal types.MaxPricesPerGBProposal) sdk.Error {
+	params := k.GetParams(ctx)
+	params.MaxPricesPerGB = proposal.MaxPricesPerGB
+	if err := params.Validate(); err != nil {
+		return types.ErrorInvalidField("max_prices_per_gb: " + err.Error())
+	}
+	
+	k.SetParams(ctx, params)
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChangeMaxPricesPerGB,
+			sdk.NewAttribute(types.AttributeKeyPricesPerGB, params.MaxPricesPerGB.String()),
+		),
+	)
+	
+	return nil
+}
+
+func handleExchangeRatesProposal(ctx sdk.Context, k keeper.Keeper, proposal types.ExchangeRatesProposal) sdk.Error {
+	params := k.GetParams(ctx)
+	params.ExchangeRates = proposal.ExchangeRates
+	if err := params.Validate(); err != nil {
+		return types.ErrorInvalidField("exchange_rates: " + err.Error())
+	}
+	
+	k.SetParams(ctx, params)
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChangeExchangeRates,
+			sdk.NewAttribute(types.AttributeKeyExchangeRates, params.ExchangeRates.String()),
+		),
+	)
+	
+	return nil
+}
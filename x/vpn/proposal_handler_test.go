@@ -0,0 +1,159 @@
+package vpn
+
+import (
+	"testing"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/keeper"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func Test_handleChangeParamsProposal(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	handler := NewProposalHandler(k)
+	
+	params := DefaultParams()
+	params.NodeInactiveInterval = 0
+	err := handler(ctx, NewChangeParamsProposal("title", "description", params))
+	require.NotNil(t, err)
+	require.Equal(t, DefaultParams(), k.GetParams(ctx))
+	
+	params.NodeInactiveInterval = 10
+	params.FreeNodesCount = 1
+	err = handler(ctx, NewChangeParamsProposal("title", "description", params))
+	require.Nil(t, err)
+	require.Equal(t, params, k.GetParams(ctx))
+}
+
+func Test_handleBlacklistProposal(t *testing.T) {
+	ctx, k, _, bk := keeper.CreateTestInput(t, false)
+	handler := NewHandler(k)
+	proposalHandler := NewProposalHandler(k)
+	
+	node := types.TestNode
+	node.Status = StatusRegistered
+	k.SetNode(ctx, node)
+	k.SetResolver(ctx, types.TestResolver)
+	k.SetResolverOfNode(ctx, node.ID, types.TestResolver.ID)
+	
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 300)})
+	require.Nil(t, err)
+	
+	err = proposalHandler(ctx, NewBlacklistProposal("title", "description", []hub.NodeID{hub.NewNodeID(1)}, nil, true))
+	require.NotNil(t, err)
+	
+	err = proposalHandler(ctx, NewBlacklistProposal("title", "description", []hub.NodeID{node.ID}, nil, true))
+	require.Nil(t, err)
+	
+	node, _ = k.GetNode(ctx, node.ID)
+	require.Equal(t, true, node.Blacklisted)
+	require.Equal(t, false, NewQueryNodesParams(0, 0, "", "", "", "", hub.NewBandwidthFromInt64(0, 0), nil, nil, "").Matches(node))
+	
+	msg := NewMsgStartSubscription(types.TestAddress2, types.TestResolver.ID, node.ID, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, 0)
+	res := handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	
+	err = proposalHandler(ctx, NewBlacklistProposal("title", "description", []hub.NodeID{node.ID}, []hub.ResolverID{types.TestResolver.ID}, false))
+	require.Nil(t, err)
+	
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
+	
+	err = proposalHandler(ctx, NewBlacklistProposal("title", "description", nil, []hub.ResolverID{types.TestResolver.ID}, true))
+	require.Nil(t, err)
+	
+	resolver, _ := k.GetResolver(ctx, types.TestResolver.ID)
+	require.Equal(t, true, resolver.Blacklisted)
+	
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+}
+
+func Test_handleMaxPricesPerGBProposal(t *testing.T) {
+	ctx, k, _, bk := keeper.CreateTestInput(t, false)
+	handler := NewHandler(k)
+	proposalHandler := NewProposalHandler(k)
+	
+	node := types.TestNode
+	node.Status = StatusRegistered
+	k.SetNode(ctx, node)
+	k.SetNodesCount(ctx, 1)
+	k.SetResolver(ctx, types.TestResolver)
+	k.SetResolverOfNode(ctx, node.ID, types.TestResolver.ID)
+	
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	
+	err = proposalHandler(ctx, NewMaxPricesPerGBProposal("title", "description", sdk.Coins{sdk.NewInt64Coin("stake", 50)}))
+	require.Nil(t, err)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 50)}, k.MaxPricesPerGB(ctx))
+	
+	res := handler(ctx, *NewMsgRegisterNode(types.TestAddress1, "node_type", "version", "moniker",
+		sdk.Coins{sdk.NewInt64Coin("stake", 100)}, types.TestBandwidthPos1, "encryption"))
+	require.False(t, res.IsOK())
+	res = handler(ctx, *NewMsgRegisterNode(types.TestAddress1, "node_type", "version", "moniker",
+		sdk.Coins{sdk.NewInt64Coin("stake", 50)}, types.TestBandwidthPos1, "encryption"))
+	require.True(t, res.IsOK())
+	
+	msg := NewMsgStartSubscription(types.TestAddress2, types.TestResolver.ID, node.ID, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, 0)
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	
+	err = proposalHandler(ctx, NewMaxPricesPerGBProposal("title", "description", nil))
+	require.Nil(t, err)
+	
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
+}
+
+func Test_handleExchangeRatesProposal(t *testing.T) {
+	ctx, k, _, bk := keeper.CreateTestInput(t, false)
+	handler := NewHandler(k)
+	proposalHandler := NewProposalHandler(k)
+	
+	node := types.TestNode
+	node.Status = StatusRegistered
+	node.Plans = []Plan{{Type: PlanTypePerHour, Price: sdk.NewInt64Coin("stake", 30)}}
+	k.SetNode(ctx, node)
+	k.SetResolver(ctx, types.TestResolver)
+	k.SetResolverOfNode(ctx, node.ID, types.TestResolver.ID)
+	
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("atom", 400)})
+	require.Nil(t, err)
+	
+	msg := NewMsgStartSubscription(types.TestAddress2, types.TestResolver.ID, node.ID, sdk.Coins{sdk.NewInt64Coin("atom", 400)}, 0)
+	res := handler(ctx, *msg)
+	require.Equal(t, types.ErrorPlanDoesNotExist().Code(), res.Code)
+	
+	res = handler(ctx, *NewMsgStartSubscription(types.TestAddress2, types.TestResolver.ID, node.ID,
+		sdk.Coins{sdk.NewInt64Coin("atom", 400)}, 1))
+	require.Equal(t, types.ErrorPlanDoesNotExist().Code(), res.Code)
+	
+	rates := ExchangeRates{NewExchangeRate("atom", sdk.NewDecWithPrec(5, 1)), NewExchangeRate("stake", sdk.NewDec(2))}
+	err = proposalHandler(ctx, NewExchangeRatesProposal("title", "description", rates))
+	require.Nil(t, err)
+	require.Equal(t, rates, k.ExchangeRates(ctx))
+	
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
+	
+	subscription, found := k.GetSubscription(ctx, hub.NewSubscriptionID(0))
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt64Coin("atom", 400), subscription.PricePerGB)
+	require.Equal(t, sdk.NewInt64Coin("stake", 100), subscription.QuotedPrice)
+	require.Equal(t, hub.NewBandwidth(hub.MB500, hub.MB500), subscription.RemainingBandwidth)
+	require.Nil(t, subscription.IsValid())
+	
+	rates = ExchangeRates{NewExchangeRate("atom", sdk.NewDec(1)), NewExchangeRate("stake", sdk.NewDec(1))}
+	err = proposalHandler(ctx, NewExchangeRatesProposal("title", "description", rates))
+	require.Nil(t, err)
+	
+	subscription, _ = k.GetSubscription(ctx, hub.NewSubscriptionID(0))
+	require.Equal(t, sdk.NewInt64Coin("atom", 400), subscription.PricePerGB)
+	
+	err = proposalHandler(ctx, NewExchangeRatesProposal("title", "description", ExchangeRates{rates[1], rates[0]}))
+	require.NotNil(t, err)
+}
@@ -0,0 +1,60 @@
+package querier
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	
+	"github.com/sentinel-official/hub/x/vpn/keeper"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func queryChannel(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryChannelParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, types.ErrorUnmarshal()
+	}
+	
+	channel, found := k.GetChannel(ctx, params.ID)
+	if !found {
+		return nil, nil
+	}
+	
+	res, err := types.ModuleCdc.MarshalJSON(channel)
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+	
+	return res, nil
+}
+
+func queryChannelsOfNode(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryChannelsOfNodeParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, types.ErrorUnmarshal()
+	}
+	
+	channels := k.GetChannelsOfNode(ctx, params.ID)
+	
+	res, err := types.ModuleCdc.MarshalJSON(channels)
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+	
+	return res, nil
+}
+
+func queryChannelsOfAddress(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryChannelsOfAddressParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, types.ErrorUnmarshal()
+	}
+	
+	channels := k.GetChannelsOfAddress(ctx, params.Address)
+	
+	res, err := types.ModuleCdc.MarshalJSON(channels)
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+	
+	return res, nil
+}
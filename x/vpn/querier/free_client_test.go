@@ -32,8 +32,7 @@ func Test_queryFreeClients(t *testing.T) {
 	require.NotNil(t, err)
 	require.Equal(t, types.ErrorUnmarshal(), err)
 	
-	k.SetFreeClientOfNode(ctx, hub.NewNodeID(0), types.TestAddress2)
-	k.SetFreeNodeOfClient(ctx, types.TestAddress2, hub.NewNodeID(0))
+	k.SetFreeClient(ctx, types.NewFreeClient(hub.NewNodeID(0), types.TestAddress2, types.TestBandwidthZero, 0, 0))
 	
 	req.Path = fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryFreeClientsOfNode)
 	req.Data = cdc.MustMarshalJSON(types.NewQueryFreeClientsOfNodeParams(hub.NewNodeID(0)))
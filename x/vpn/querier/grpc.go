@@ -0,0 +1,331 @@
+package querier
+
+import (
+	"context"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/types/hubpb"
+	"github.com/sentinel-official/hub/x/vpn/keeper"
+	"github.com/sentinel-official/hub/x/vpn/types"
+	"github.com/sentinel-official/hub/x/vpn/vpnpb"
+)
+
+var (
+	_ vpnpb.QueryServiceServer = GRPCServer{}
+)
+
+// GRPCServer serves the queries of the module over gRPC. Every request is served against a new context
+// returned by ctxFn, which is usually a read-only view of the latest committed state.
+type GRPCServer struct {
+	k     keeper.Keeper
+	ctxFn func() (sdk.Context, error)
+}
+
+func NewGRPCServer(k keeper.Keeper, ctxFn func() (sdk.Context, error)) GRPCServer {
+	return GRPCServer{
+		k:     k,
+		ctxFn: ctxFn,
+	}
+}
+
+func (s GRPCServer) context() (sdk.Context, error) {
+	ctx, err := s.ctxFn()
+	if err != nil {
+		return ctx, status.Error(codes.Unavailable, err.Error())
+	}
+	
+	return ctx, nil
+}
+
+func invalidArgument(field string) error {
+	return status.Errorf(codes.InvalidArgument, "invalid %s", field)
+}
+
+func (s GRPCServer) Node(_ context.Context, req *vpnpb.QueryNodeRequest) (*vpnpb.QueryNodeResponse, error) {
+	id, err := hub.NewNodeIDFromString(req.GetId())
+	if err != nil {
+		return nil, invalidArgument("id")
+	}
+	
+	ctx, err := s.context()
+	if err != nil {
+		return nil, err
+	}
+	
+	node, found := s.k.GetNode(ctx, id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "node %s does not exist", id)
+	}
+	
+	return &vpnpb.QueryNodeResponse{Node: vpnpb.NewNode(node)}, nil
+}
+
+func (s GRPCServer) Nodes(_ context.Context, req *vpnpb.QueryNodesRequest) (*vpnpb.QueryNodesResponse, error) {
+	ctx, err := s.context()
+	if err != nil {
+		return nil, err
+	}
+	
+	var nodes []types.Node
+	if req.GetOwner() != "" {
+		owner, err := sdk.AccAddressFromBech32(req.GetOwner())
+		if err != nil {
+			return nil, invalidArgument("owner")
+		}
+		
+		nodes = s.k.GetNodesOfAddress(ctx, owner)
+	} else {
+		nodes = s.k.GetAllNodes(ctx)
+	}
+	
+	start, end := hubpb.Paginate(len(nodes), req.GetPagination())
+	items := make([]*vpnpb.Node, 0, end-start)
+	for _, node := range nodes[start:end] {
+		items = append(items, vpnpb.NewNode(node))
+	}
+	
+	return &vpnpb.QueryNodesResponse{Nodes: items, Pagination: hubpb.NewPageResponse(len(nodes))}, nil
+}
+
+func (s GRPCServer) Subscription(_ context.Context,
+	req *vpnpb.QuerySubscriptionRequest) (*vpnpb.QuerySubscriptionResponse, error) {
+	id, err := hub.NewSubscriptionIDFromString(req.GetId())
+	if err != nil {
+		return nil, invalidArgument("id")
+	}
+	
+	ctx, err := s.context()
+	if err != nil {
+		return nil, err
+	}
+	
+	subscription, found := s.k.GetSubscription(ctx, id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "subscription %s does not exist", id)
+	}
+	
+	return &vpnpb.QuerySubscriptionResponse{Subscription: vpnpb.NewSubscription(subscription)}, nil
+}
+
+func (s GRPCServer) Subscriptions(_ context.Context,
+	req *vpnpb.QuerySubscriptionsRequest) (*vpnpb.QuerySubscriptionsResponse, error) {
+	if req.GetNodeId() != "" && req.GetClient() != "" {
+		return nil, invalidArgument("node_id and client")
+	}
+	
+	ctx, err := s.context()
+	if err != nil {
+		return nil, err
+	}
+	
+	var subscriptions []types.Subscription
+	switch {
+	case req.GetNodeId() != "":
+		id, err := hub.NewNodeIDFromString(req.GetNodeId())
+		if err != nil {
+			return nil, invalidArgument("node_id")
+		}
+		
+		subscriptions = s.k.GetSubscriptionsOfNode(ctx, id)
+	case req.GetClient() != "":
+		client, err := sdk.AccAddressFromBech32(req.GetClient())
+		if err != nil {
+			return nil, invalidArgument("client")
+		}
+		
+		subscriptions = s.k.GetSubscriptionsOfAddress(ctx, client)
+	default:
+		subscriptions = s.k.GetAllSubscriptions(ctx)
+	}
+	
+	start, end := hubpb.Paginate(len(subscriptions), req.GetPagination())
+	items := make([]*vpnpb.Subscription, 0, end-start)
+	for _, subscription := range subscriptions[start:end] {
+		items = append(items, vpnpb.NewSubscription(subscription))
+	}
+	
+	return &vpnpb.QuerySubscriptionsResponse{
+		Subscriptions: items,
+		Pagination:    hubpb.NewPageResponse(len(subscriptions)),
+	}, nil
+}
+
+func (s GRPCServer) Session(_ context.Context, req *vpnpb.QuerySessionRequest) (*vpnpb.QuerySessionResponse, error) {
+	id, err := hub.NewSessionIDFromString(req.GetId())
+	if err != nil {
+		return nil, invalidArgument("id")
+	}
+	
+	ctx, err := s.context()
+	if err != nil {
+		return nil, err
+	}
+	
+	session, found := s.k.GetSession(ctx, id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "session %s does not exist", id)
+	}
+	
+	return &vpnpb.QuerySessionResponse{Session: vpnpb.NewSession(session)}, nil
+}
+
+func (s GRPCServer) Sessions(_ context.Context, req *vpnpb.QuerySessionsRequest) (*vpnpb.QuerySessionsResponse, error) {
+	ctx, err := s.context()
+	if err != nil {
+		return nil, err
+	}
+	
+	var sessions []types.Session
+	if req.GetSubscriptionId() != "" {
+		id, err := hub.NewSubscriptionIDFromString(req.GetSubscriptionId())
+		if err != nil {
+			return nil, invalidArgument("subscription_id")
+		}
+		
+		sessions = s.k.GetSessionsOfSubscription(ctx, id)
+	} else {
+		sessions = s.k.GetAllSessions(ctx)
+	}
+	
+	start, end := hubpb.Paginate(len(sessions), req.GetPagination())
+	items := make([]*vpnpb.Session, 0, end-start)
+	for _, session := range sessions[start:end] {
+		items = append(items, vpnpb.NewSession(session))
+	}
+	
+	return &vpnpb.QuerySessionsResponse{Sessions: items, Pagination: hubpb.NewPageResponse(len(sessions))}, nil
+}
+
+func (s GRPCServer) Resolver(_ context.Context, req *vpnpb.QueryResolverRequest) (*vpnpb.QueryResolverResponse, error) {
+	id, err := hub.NewResolverIDFromString(req.GetId())
+	if err != nil {
+		return nil, invalidArgument("id")
+	}
+	
+	ctx, err := s.context()
+	if err != nil {
+		return nil, err
+	}
+	
+	resolver, found := s.k.GetResolver(ctx, id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "resolver %s does not exist", id)
+	}
+	
+	return &vpnpb.QueryResolverResponse{Resolver: vpnpb.NewResolver(resolver)}, nil
+}
+
+func (s GRPCServer) Resolvers(_ context.Context,
+	req *vpnpb.QueryResolversRequest) (*vpnpb.QueryResolversResponse, error) {
+	ctx, err := s.context()
+	if err != nil {
+		return nil, err
+	}
+	
+	var resolvers types.Resolvers
+	if req.GetOwner() != "" {
+		owner, err := sdk.AccAddressFromBech32(req.GetOwner())
+		if err != nil {
+			return nil, invalidArgument("owner")
+		}
+		
+		resolvers = s.k.GetResolversOfAddress(ctx, owner)
+	} else {
+		resolvers = s.k.GetAllResolvers(ctx)
+	}
+	
+	start, end := hubpb.Paginate(len(resolvers), req.GetPagination())
+	items := make([]*vpnpb.Resolver, 0, end-start)
+	for _, resolver := range resolvers[start:end] {
+		items = append(items, vpnpb.NewResolver(resolver))
+	}
+	
+	return &vpnpb.QueryResolversResponse{Resolvers: items, Pagination: hubpb.NewPageResponse(len(resolvers))}, nil
+}
+
+func (s GRPCServer) FreeClient(_ context.Context,
+	req *vpnpb.QueryFreeClientRequest) (*vpnpb.QueryFreeClientResponse, error) {
+	nodeID, err := hub.NewNodeIDFromString(req.GetNodeId())
+	if err != nil {
+		return nil, invalidArgument("node_id")
+	}
+	
+	client, err := sdk.AccAddressFromBech32(req.GetClient())
+	if err != nil {
+		return nil, invalidArgument("client")
+	}
+	
+	ctx, err := s.context()
+	if err != nil {
+		return nil, err
+	}
+	
+	freeClient, found := s.k.GetFreeClient(ctx, nodeID, client)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "free client %s of node %s does not exist", client, nodeID)
+	}
+	
+	return &vpnpb.QueryFreeClientResponse{FreeClient: vpnpb.NewFreeClient(freeClient)}, nil
+}
+
+func (s GRPCServer) FreeClients(_ context.Context,
+	req *vpnpb.QueryFreeClientsRequest) (*vpnpb.QueryFreeClientsResponse, error) {
+	if req.GetNodeId() != "" && req.GetClient() != "" {
+		return nil, invalidArgument("node_id and client")
+	}
+	
+	ctx, err := s.context()
+	if err != nil {
+		return nil, err
+	}
+	
+	var freeClients []types.FreeClient
+	switch {
+	case req.GetNodeId() != "":
+		nodeID, err := hub.NewNodeIDFromString(req.GetNodeId())
+		if err != nil {
+			return nil, invalidArgument("node_id")
+		}
+		
+		for _, client := range s.k.GetFreeClientsOfNode(ctx, nodeID) {
+			freeClient, _ := s.k.GetFreeClient(ctx, nodeID, client)
+			freeClients = append(freeClients, freeClient)
+		}
+	case req.GetClient() != "":
+		client, err := sdk.AccAddressFromBech32(req.GetClient())
+		if err != nil {
+			return nil, invalidArgument("client")
+		}
+		
+		for _, nodeID := range s.k.GetFreeNodesOfClient(ctx, client) {
+			freeClient, _ := s.k.GetFreeClient(ctx, nodeID, client)
+			freeClients = append(freeClients, freeClient)
+		}
+	default:
+		freeClients = s.k.GetFreeClients(ctx)
+	}
+	
+	start, end := hubpb.Paginate(len(freeClients), req.GetPagination())
+	items := make([]*vpnpb.FreeClient, 0, end-start)
+	for _, freeClient := range freeClients[start:end] {
+		items = append(items, vpnpb.NewFreeClient(freeClient))
+	}
+	
+	return &vpnpb.QueryFreeClientsResponse{
+		FreeClients: items,
+		Pagination:  hubpb.NewPageResponse(len(freeClients)),
+	}, nil
+}
+
+func (s GRPCServer) Params(_ context.Context, _ *vpnpb.QueryParamsRequest) (*vpnpb.QueryParamsResponse, error) {
+	ctx, err := s.context()
+	if err != nil {
+		return nil, err
+	}
+	
+	return &vpnpb.QueryParamsResponse{Params: vpnpb.NewParams(s.k.GetParams(ctx))}, nil
+}
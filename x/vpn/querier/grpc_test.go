@@ -0,0 +1,148 @@
+package querier
+
+import (
+	"context"
+	"net"
+	"testing"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/types/hubpb"
+	"github.com/sentinel-official/hub/x/vpn/keeper"
+	"github.com/sentinel-official/hub/x/vpn/types"
+	"github.com/sentinel-official/hub/x/vpn/vpnpb"
+)
+
+func newTestGRPCClient(t *testing.T, ctx sdk.Context, k keeper.Keeper) vpnpb.QueryServiceClient {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	vpnpb.RegisterQueryServiceServer(server, NewGRPCServer(k, func() (sdk.Context, error) { return ctx, nil }))
+	
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+	
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }))
+	require.Nil(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	
+	return vpnpb.NewQueryServiceClient(conn)
+}
+
+func TestGRPCServer_Node(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	client := newTestGRPCClient(t, ctx, k)
+	
+	_, err := client.Node(context.Background(), &vpnpb.QueryNodeRequest{Id: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	
+	_, err = client.Node(context.Background(), &vpnpb.QueryNodeRequest{Id: hub.NewNodeID(0).String()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	
+	k.SetNode(ctx, types.TestNode)
+	res, err := client.Node(context.Background(), &vpnpb.QueryNodeRequest{Id: hub.NewNodeID(0).String()})
+	require.Nil(t, err)
+	require.Equal(t, types.TestNode.ID.String(), res.GetNode().GetId())
+	require.Equal(t, types.TestNode.Owner.String(), res.GetNode().GetOwner())
+	require.Equal(t, types.TestNode.Deposit.Amount.String(), res.GetNode().GetDeposit().GetAmount())
+}
+
+func TestGRPCServer_Nodes(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	client := newTestGRPCClient(t, ctx, k)
+	
+	res, err := client.Nodes(context.Background(), &vpnpb.QueryNodesRequest{})
+	require.Nil(t, err)
+	require.Len(t, res.GetNodes(), 0)
+	require.Equal(t, uint64(0), res.GetPagination().GetTotal())
+	
+	for i := uint64(0); i < 5; i++ {
+		node := types.TestNode
+		node.ID = hub.NewNodeID(i)
+		k.SetNode(ctx, node)
+		k.SetNodeIDByAddress(ctx, node.Owner, i, node.ID)
+	}
+	k.SetNodesCount(ctx, 5)
+	k.SetNodesCountOfAddress(ctx, types.TestNode.Owner, 5)
+	
+	res, err = client.Nodes(context.Background(), &vpnpb.QueryNodesRequest{
+		Pagination: &hubpb.PageRequest{Offset: 1, Limit: 2},
+	})
+	require.Nil(t, err)
+	require.Len(t, res.GetNodes(), 2)
+	require.Equal(t, hub.NewNodeID(1).String(), res.GetNodes()[0].GetId())
+	require.Equal(t, uint64(5), res.GetPagination().GetTotal())
+	
+	res, err = client.Nodes(context.Background(), &vpnpb.QueryNodesRequest{Owner: types.TestNode.Owner.String()})
+	require.Nil(t, err)
+	require.Len(t, res.GetNodes(), 5)
+	
+	_, err = client.Nodes(context.Background(), &vpnpb.QueryNodesRequest{Owner: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGRPCServer_Subscriptions(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	client := newTestGRPCClient(t, ctx, k)
+	
+	_, err := client.Subscriptions(context.Background(), &vpnpb.QuerySubscriptionsRequest{
+		NodeId: hub.NewNodeID(0).String(),
+		Client: types.TestAddress2.String(),
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	
+	subscription := types.TestSubscription
+	k.SetSubscription(ctx, subscription)
+	k.SetSubscriptionsCount(ctx, 1)
+	k.SetSubscriptionIDByNodeID(ctx, subscription.NodeID, 0, subscription.ID)
+	k.SetSubscriptionsCountOfNode(ctx, subscription.NodeID, 1)
+	
+	res, err := client.Subscriptions(context.Background(), &vpnpb.QuerySubscriptionsRequest{
+		NodeId: subscription.NodeID.String(),
+	})
+	require.Nil(t, err)
+	require.Len(t, res.GetSubscriptions(), 1)
+	require.Equal(t, "0.120000000000000000", res.GetSubscriptions()[0].GetCommission())
+	
+	_, err = client.Subscription(context.Background(), &vpnpb.QuerySubscriptionRequest{
+		Id: hub.NewSubscriptionID(1).String(),
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGRPCServer_FreeClient(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	client := newTestGRPCClient(t, ctx, k)
+	
+	req := &vpnpb.QueryFreeClientRequest{NodeId: hub.NewNodeID(0).String(), Client: types.TestAddress2.String()}
+	_, err := client.FreeClient(context.Background(), req)
+	require.Equal(t, codes.NotFound, status.Code(err))
+	
+	k.SetFreeClient(ctx, types.NewFreeClient(hub.NewNodeID(0), types.TestAddress2, types.TestBandwidthPos1, 10, 1))
+	res, err := client.FreeClient(context.Background(), req)
+	require.Nil(t, err)
+	require.Equal(t, int64(10), res.GetFreeClient().GetExpiresAt())
+	require.Equal(t, types.TestBandwidthPos1.Upload.String(), res.GetFreeClient().GetBandwidth().GetUpload())
+	
+	list, err := client.FreeClients(context.Background(), &vpnpb.QueryFreeClientsRequest{
+		Client: types.TestAddress2.String(),
+	})
+	require.Nil(t, err)
+	require.Len(t, list.GetFreeClients(), 1)
+}
+
+func TestGRPCServer_Params(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	client := newTestGRPCClient(t, ctx, k)
+	
+	res, err := client.Params(context.Background(), &vpnpb.QueryParamsRequest{})
+	require.Nil(t, err)
+	require.Equal(t, types.DefaultParams().FreeNodesCount, res.GetParams().GetFreeNodesCount())
+	require.Equal(t, types.DefaultParams().Deposit.Denom, res.GetParams().GetDeposit().GetDenom())
+}
@@ -1,6 +1,9 @@
 package querier
 
 import (
+	"sort"
+	
+	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	
@@ -54,3 +57,58 @@ func queryAllNodes(ctx sdk.Context, k keeper.Keeper) ([]byte, sdk.Error) {
 	
 	return res, nil
 }
+
+func queryNodes(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryNodesParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, types.ErrorUnmarshal()
+	}
+	
+	nodes := make([]types.Node, 0)
+	for _, node := range k.GetAllNodes(ctx) {
+		if !params.Matches(node) {
+			continue
+		}
+		if params.ResolverID != nil {
+			if _, found := k.GetResolverOfNode(ctx, node.ID, params.ResolverID); !found {
+				continue
+			}
+		}
+		
+		nodes = append(nodes, node)
+	}
+	
+	switch params.SortBy {
+	case types.SortNodesByPrice:
+		sort.SliceStable(nodes, func(i, j int) bool {
+			x, foundX := params.LowestPrice(nodes[i])
+			y, foundY := params.LowestPrice(nodes[j])
+			if !foundX || !foundY {
+				return foundX
+			}
+			
+			return x.LT(y)
+		})
+	case types.SortNodesBySpeed:
+		sort.SliceStable(nodes, func(i, j int) bool {
+			return nodes[i].InternetSpeed.Sum().GT(nodes[j].InternetSpeed.Sum())
+		})
+	case "":
+	default:
+		return nil, types.ErrorInvalidField("sort_by")
+	}
+	
+	start, end := client.Paginate(len(nodes), params.Page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		nodes = []types.Node{}
+	} else {
+		nodes = nodes[start:end]
+	}
+	
+	res, err := types.ModuleCdc.MarshalJSON(nodes)
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+	
+	return res, nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 	
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	
@@ -153,3 +154,111 @@ func Test_queryAllNodes(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, append([]types.Node{types.TestNode}, node), nodes)
 }
+
+func Test_queryNodes(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	cdc := keeper.MakeTestCodec()
+	var err error
+	var nodes []types.Node
+	
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNodes),
+		Data: []byte{},
+	}
+	
+	res, _err := queryNodes(ctx, req, k)
+	require.NotNil(t, _err)
+	require.Equal(t, []byte(nil), res)
+	
+	node1 := types.TestNode
+	node1.Status = types.StatusRegistered
+	k.SetNode(ctx, node1)
+	
+	node2 := types.TestNode
+	node2.ID = hub.NewNodeID(1)
+	node2.PricesPerGB = sdk.Coins{sdk.NewInt64Coin("stake", 50)}
+	node2.InternetSpeed = types.TestBandwidthPos2
+	k.SetNode(ctx, node2)
+	
+	node3 := node1
+	node3.ID = hub.NewNodeID(2)
+	node3.PricesPerGB = sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	k.SetNode(ctx, node3)
+	k.SetResolverOfNode(ctx, node3.ID, hub.NewResolverID(0))
+	
+	req.Data, err = cdc.MarshalJSON(types.NewQueryNodesParams(1, 0, "", "", "", "",
+		hub.NewBandwidthFromInt64(0, 0), nil, nil, ""))
+	require.Nil(t, err)
+	
+	res, _err = queryNodes(ctx, req, k)
+	require.Nil(t, _err)
+	err = cdc.UnmarshalJSON(res, &nodes)
+	require.Nil(t, err)
+	require.Equal(t, []types.Node{node1, node2, node3}, nodes)
+	
+	req.Data, err = cdc.MarshalJSON(types.NewQueryNodesParams(1, 0, types.StatusRegistered, "", "", "",
+		hub.NewBandwidthFromInt64(0, 0), nil, nil, types.SortNodesByPrice))
+	require.Nil(t, err)
+	
+	res, _err = queryNodes(ctx, req, k)
+	require.Nil(t, _err)
+	err = cdc.UnmarshalJSON(res, &nodes)
+	require.Nil(t, err)
+	require.Equal(t, []types.Node{node3, node1}, nodes)
+	
+	req.Data, err = cdc.MarshalJSON(types.NewQueryNodesParams(1, 0, "", "", "", "",
+		hub.NewBandwidthFromInt64(0, 0), sdk.Coins{sdk.NewInt64Coin("stake", 50)}, nil, types.SortNodesByPrice))
+	require.Nil(t, err)
+	
+	res, _err = queryNodes(ctx, req, k)
+	require.Nil(t, _err)
+	err = cdc.UnmarshalJSON(res, &nodes)
+	require.Nil(t, err)
+	require.Equal(t, []types.Node{node3, node2}, nodes)
+	
+	req.Data, err = cdc.MarshalJSON(types.NewQueryNodesParams(1, 0, "", "", "", "",
+		types.TestBandwidthPos2, nil, nil, ""))
+	require.Nil(t, err)
+	
+	res, _err = queryNodes(ctx, req, k)
+	require.Nil(t, _err)
+	err = cdc.UnmarshalJSON(res, &nodes)
+	require.Nil(t, err)
+	require.Equal(t, []types.Node{node2}, nodes)
+	
+	req.Data, err = cdc.MarshalJSON(types.NewQueryNodesParams(1, 0, "", "", "", "",
+		hub.NewBandwidthFromInt64(0, 0), nil, hub.NewResolverID(0), ""))
+	require.Nil(t, err)
+	
+	res, _err = queryNodes(ctx, req, k)
+	require.Nil(t, _err)
+	err = cdc.UnmarshalJSON(res, &nodes)
+	require.Nil(t, err)
+	require.Equal(t, []types.Node{node3}, nodes)
+	
+	req.Data, err = cdc.MarshalJSON(types.NewQueryNodesParams(2, 2, "", "", "", "",
+		hub.NewBandwidthFromInt64(0, 0), nil, nil, ""))
+	require.Nil(t, err)
+	
+	res, _err = queryNodes(ctx, req, k)
+	require.Nil(t, _err)
+	err = cdc.UnmarshalJSON(res, &nodes)
+	require.Nil(t, err)
+	require.Equal(t, []types.Node{node3}, nodes)
+	
+	req.Data, err = cdc.MarshalJSON(types.NewQueryNodesParams(3, 2, "", "", "", "",
+		hub.NewBandwidthFromInt64(0, 0), nil, nil, ""))
+	require.Nil(t, err)
+	
+	res, _err = queryNodes(ctx, req, k)
+	require.Nil(t, _err)
+	require.Equal(t, []byte("[]"), res)
+	
+	req.Data, err = cdc.MarshalJSON(types.NewQueryNodesParams(1, 0, "", "", "", "",
+		hub.NewBandwidthFromInt64(0, 0), nil, nil, "moniker"))
+	require.Nil(t, err)
+	
+	res, _err = queryNodes(ctx, req, k)
+	require.NotNil(t, _err)
+	require.Equal(t, []byte(nil), res)
+}
@@ -15,6 +15,8 @@ func NewQuerier(k keeper.Keeper) sdk.Querier {
 			return queryNode(ctx, req, k)
 		case types.QueryNodesOfAddress:
 			return queryNodesOfAddress(ctx, req, k)
+		case types.QueryNodes:
+			return queryNodes(ctx, req, k)
 		case types.QueryAllNodes:
 			return queryAllNodes(ctx, k)
 		case types.QueryFreeNodesOfClient:
@@ -25,6 +27,10 @@ func NewQuerier(k keeper.Keeper) sdk.Querier {
 			return queryNodesOfResolver(ctx, req, k)
 		case types.QueryResolversOfNode:
 			return queryResolversOfNode(ctx, req, k)
+		case types.QueryPendingNodesOfResolver:
+			return queryPendingNodesOfResolver(ctx, req, k)
+		case types.QueryCommissionsOfResolver:
+			return queryCommissionsOfResolver(ctx, req, k)
 		case types.QuerySubscription:
 			return querySubscription(ctx, req, k)
 		case types.QuerySubscriptionsOfNode:
@@ -43,6 +49,14 @@ func NewQuerier(k keeper.Keeper) sdk.Querier {
 			return querySessionsOfSubscription(ctx, req, k)
 		case types.QueryAllSessions:
 			return queryAllSessions(ctx, k)
+		case types.QuerySettlement:
+			return querySettlement(ctx, req, k)
+		case types.QueryChannel:
+			return queryChannel(ctx, req, k)
+		case types.QueryChannelsOfNode:
+			return queryChannelsOfNode(ctx, req, k)
+		case types.QueryChannelsOfAddress:
+			return queryChannelsOfAddress(ctx, req, k)
 		case types.QueryParams:
 			return queryParameters(ctx, k)
 		case types.QueryResolvers:
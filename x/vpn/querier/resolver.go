@@ -84,3 +84,37 @@ func queryResolversOfNode(ctx sdk.Context, req abci.RequestQuery, k keeper.Keepe
 	
 	return res, nil
 }
+
+func queryCommissionsOfResolver(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCommissionsOfResolverParams
+	
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, types.ErrorUnmarshal()
+	}
+	
+	commissions := k.GetCommissionsOfResolver(ctx, params.ID)
+	
+	res, err := types.ModuleCdc.MarshalJSON(commissions)
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+	
+	return res, nil
+}
+
+func queryPendingNodesOfResolver(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryNodesOfResolverPrams
+	
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, types.ErrorUnmarshal()
+	}
+	
+	nodes := k.GetPendingNodesOfResolver(ctx, params.ID)
+	
+	res, err := types.ModuleCdc.MarshalJSON(nodes)
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+	
+	return res, nil
+}
@@ -27,6 +27,25 @@ func querySession(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]by
 	return res, nil
 }
 
+func querySettlement(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QuerySettlementParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, types.ErrorUnmarshal()
+	}
+	
+	settlement, found := k.GetSettlement(ctx, params.ID)
+	if !found {
+		return nil, nil
+	}
+	
+	res, err := types.ModuleCdc.MarshalJSON(settlement)
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+	
+	return res, nil
+}
+
 func querySessionOfSubscription(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
 	var params types.QuerySessionOfSubscriptionPrams
 	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 	
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	
@@ -141,7 +142,7 @@ func Test_querySessionsOfSubscription(t *testing.T) {
 	session := types.TestSession
 	session.ID = hub.NewSessionID(1)
 	k.SetSession(ctx, session)
-	k.SetSessionIDBySubscriptionID(ctx, types.TestSubscription.ID, 0, session.ID)
+	k.SetSessionIDBySubscriptionID(ctx, types.TestSubscription.ID, 1, session.ID)
 	k.SetSessionsCountOfSubscription(ctx, types.TestSubscription.ID, 2)
 	
 	res, _err = querySessionsOfSubscription(ctx, req, k)
@@ -197,3 +198,50 @@ func Test_queryAllSessions(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, append([]types.Session{types.TestSession}, session), sessions)
 }
+
+func Test_querySettlement(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	cdc := keeper.MakeTestCodec()
+	var err error
+	var settlement types.Settlement
+	
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySettlement),
+		Data: []byte{},
+	}
+	
+	res, _err := querySettlement(ctx, req, k)
+	require.NotNil(t, _err)
+	require.Equal(t, []byte(nil), res)
+	require.Len(t, res, 0)
+	
+	expected := types.Settlement{
+		SessionID:      hub.NewSessionID(0),
+		SubscriptionID: hub.NewSubscriptionID(0),
+		Payer:          types.TestAddress2,
+		NodePayee:      types.TestAddress1,
+		ResolverPayee:  types.TestAddress3,
+		Amount:         sdk.NewInt64Coin("stake", 100),
+		Commission:     sdk.NewInt64Coin("stake", 12),
+		Bandwidth:      types.TestBandwidthPos1,
+		Height:         1,
+	}
+	k.SetSettlement(ctx, expected)
+	req.Data, err = cdc.MarshalJSON(types.NewQuerySettlementParams(hub.NewSessionID(0)))
+	require.Nil(t, err)
+	
+	res, _err = querySettlement(ctx, req, k)
+	require.Nil(t, _err)
+	require.NotNil(t, res)
+	
+	err = cdc.UnmarshalJSON(res, &settlement)
+	require.Nil(t, err)
+	require.Equal(t, expected, settlement)
+	
+	req.Data, err = cdc.MarshalJSON(types.NewQuerySettlementParams(hub.NewSessionID(1)))
+	require.Nil(t, err)
+	
+	res, _err = querySettlement(ctx, req, k)
+	require.Nil(t, res)
+	require.Len(t, res, 0)
+}
@@ -44,7 +44,7 @@ func SimulateMsgUpdateNodeInfo(keeper vpn.Keeper) simulation.Operation {
 		node := vpn.RandomNode(r, ctx, keeper)
 		msg := vpn.NewMsgUpdateNodeInfo(node.Owner, node.ID,
 			getRandomType(r), getRandomVersion(r), getRandomMoniker(r),
-			getRandomCoins(r), getRandomBandwidth(r), getRandomEncryption(r))
+			getRandomCoins(r), getRandomBandwidth(r), getRandomEncryption(r), uint64(r.Intn(4)))
 		
 		if msg.ValidateBasic() != nil {
 			return simulation.NoOpMsg(vpn.ModuleName), nil,
@@ -93,7 +93,7 @@ func SimulateMsgStartSubscription(keeper vpn.Keeper) simulation.Operation {
 		keeper.SetNode(ctx, node)
 		
 		randomAcc := simulation.RandomAcc(r, accounts)
-		msg := vpn.NewMsgStartSubscription(randomAcc.Address, resolver.ID, node.ID, getRandomCoin(r))
+		msg := vpn.NewMsgStartSubscription(randomAcc.Address, resolver.ID, node.ID, sdk.Coins{getRandomCoin(r)}, 0)
 		
 		if msg.ValidateBasic() != nil {
 			return simulation.NoOpMsg(vpn.ModuleName), nil,
@@ -146,14 +146,13 @@ func SimulateMsgUpdateSessionInfo(keeper vpn.Keeper) simulation.Operation {
 		subscription.Client = clientAccount.Address
 		keeper.SetSubscription(ctx, subscription)
 		
-		scs := keeper.GetSessionsCountOfSubscription(ctx, subscription.ID)
 		node, _ := keeper.GetNode(ctx, subscription.NodeID)
 		node.Owner = nodeOwnerAccount.Address
 		keeper.SetNode(ctx, node)
 		
 		bandwidth := getRandomBandwidth(r)
 		
-		bandWidthSignData := hub.NewBandwidthSignatureData(subscription.ID, scs, bandwidth)
+		bandWidthSignData := hub.NewBandwidthSignatureData(subscription.ID, session.ID, bandwidth)
 		clientAccountSignedData, _ := clientAccount.PrivKey.Sign(bandWidthSignData.Bytes())
 		nodeOwnerAccountSignedData, _ := nodeOwnerAccount.PrivKey.Sign(bandWidthSignData.Bytes())
 		
@@ -166,7 +165,7 @@ func SimulateMsgUpdateSessionInfo(keeper vpn.Keeper) simulation.Operation {
 			Signature: nodeOwnerAccountSignedData,
 		}
 		
-		msg := vpn.NewMsgUpdateSessionInfo(clientAccount.Address, session.SubscriptionID,
+		msg := vpn.NewMsgUpdateSessionInfo(clientAccount.Address, session.ID,
 			bandwidth, nodeOwnerStdSig, clienStdSig)
 		
 		if msg.ValidateBasic() != nil {
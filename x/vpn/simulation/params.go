@@ -1,7 +1,15 @@
 package simulation
 
 const (
-	FreeNodesCount          = "free_node_count"
-	Deposit                 = "deposit"
-	SessionInactiveInterval = "session_inactive_interval"
+	FreeNodesCount           = "free_node_count"
+	Deposit                  = "deposit"
+	SessionInactiveInterval  = "session_inactive_interval"
+	NodeInactiveInterval     = "node_inactive_interval"
+	SlashFraction            = "slash_fraction"
+	NodeUnbondingPeriod      = "node_unbonding_period"
+	ResolverUnbondingPeriod  = "resolver_unbonding_period"
+	MaxPricesPerGB           = "max_prices_per_gb"
+	ResolverMinDeposit       = "resolver_min_deposit"
+	CommissionChangeInterval = "commission_change_interval"
+	ExchangeRates            = "exchange_rates"
 )
@@ -108,6 +108,7 @@ func GenerateRandomSubscription(r *rand.Rand, node types.Node) types.Subscriptio
 		NodeID:             node.ID,
 		Client:             nil,
 		PricePerGB:         getRandomCoin(r),
+		Commission:         sdk.ZeroDec(),
 		TotalDeposit:       getRandomCoin(r),
 		RemainingDeposit:   getRandomCoin(r),
 		RemainingBandwidth: getRandomBandwidth(r),
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+	"time"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	hub "github.com/sentinel-official/hub/types"
+)
+
+// Channel is a unidirectional payment channel from a client to the owner of a node. The deposit is locked
+// when the channel is opened, the node owner redeems the cumulative vouchers signed by the client and
+// the client reclaims the unredeemed deposit once the channel times out.
+type Channel struct {
+	ID               hub.ChannelID  `json:"id"`
+	NodeID           hub.NodeID     `json:"node_id"`
+	Client           sdk.AccAddress `json:"client"`
+	Deposit          sdk.Coin       `json:"deposit"`
+	Redeemed         sdk.Coin       `json:"redeemed"`
+	ExpiresAt        time.Time      `json:"expires_at"`
+	Status           string         `json:"status"`
+	StatusModifiedAt int64          `json:"status_modified_at"`
+}
+
+func (c Channel) String() string {
+	return fmt.Sprintf(`Channel
+  ID:                  %s
+  Node ID:             %s
+  Client Address:      %s
+  Deposit:             %s
+  Redeemed:            %s
+  Expires At:          %s
+  Status:              %s
+  Status Modified At:  %d`, c.ID, c.NodeID, c.Client, c.Deposit, c.Redeemed,
+		c.ExpiresAt, c.Status, c.StatusModifiedAt)
+}
+
+// Unredeemed returns the part of the deposit which is still locked in the channel
+func (c Channel) Unredeemed() sdk.Coin {
+	return c.Deposit.Sub(c.Redeemed)
+}
+
+func (c Channel) IsExpired(now time.Time) bool {
+	return !now.Before(c.ExpiresAt)
+}
+
+func (c Channel) IsValid() error {
+	if c.ID == nil {
+		return fmt.Errorf("invalid id")
+	}
+	if c.NodeID == nil {
+		return fmt.Errorf("invalid node id")
+	}
+	if c.Client == nil || c.Client.Empty() {
+		return fmt.Errorf("invalid client")
+	}
+	if c.Deposit.Denom == "" || !c.Deposit.IsPositive() {
+		return fmt.Errorf("invalid deposit")
+	}
+	if c.Redeemed.Denom != c.Deposit.Denom || c.Redeemed.IsNegative() || c.Deposit.IsLT(c.Redeemed) {
+		return fmt.Errorf("invalid redeemed")
+	}
+	if c.ExpiresAt.IsZero() {
+		return fmt.Errorf("invalid expires at")
+	}
+	if c.Status != StatusActive && c.Status != StatusInactive {
+		return fmt.Errorf("invalid status")
+	}
+	
+	return nil
+}
@@ -0,0 +1,170 @@
+package types
+
+import (
+	"encoding/json"
+	"time"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	
+	hub "github.com/sentinel-official/hub/types"
+)
+
+var _ sdk.Msg = (*MsgOpenChannel)(nil)
+
+type MsgOpenChannel struct {
+	From    sdk.AccAddress `json:"from"`
+	NodeID  hub.NodeID     `json:"node_id"`
+	Deposit sdk.Coin       `json:"deposit"`
+	Timeout time.Duration  `json:"timeout"`
+}
+
+func (msg MsgOpenChannel) Type() string {
+	return "open_channel"
+}
+
+func (msg MsgOpenChannel) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if msg.NodeID == nil || len(msg.NodeID) == 0 {
+		return ErrorInvalidField("node_id")
+	}
+	if msg.Deposit.Denom == "" || !msg.Deposit.IsPositive() {
+		return ErrorInvalidField("deposit")
+	}
+	if msg.Timeout <= 0 {
+		return ErrorInvalidField("timeout")
+	}
+	
+	return nil
+}
+
+func (msg MsgOpenChannel) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	
+	return bz
+}
+
+func (msg MsgOpenChannel) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgOpenChannel) Route() string {
+	return RouterKey
+}
+
+func NewMsgOpenChannel(from sdk.AccAddress, nodeID hub.NodeID, deposit sdk.Coin, timeout time.Duration) *MsgOpenChannel {
+	return &MsgOpenChannel{
+		From:    from,
+		NodeID:  nodeID,
+		Deposit: deposit,
+		Timeout: timeout,
+	}
+}
+
+var _ sdk.Msg = (*MsgRedeemVoucher)(nil)
+
+type MsgRedeemVoucher struct {
+	From            sdk.AccAddress    `json:"from"`
+	ChannelID       hub.ChannelID     `json:"channel_id"`
+	Amount          sdk.Coin          `json:"amount"`
+	ClientSignature auth.StdSignature `json:"client_signature"`
+}
+
+func (msg MsgRedeemVoucher) Type() string {
+	return "redeem_voucher"
+}
+
+func (msg MsgRedeemVoucher) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if msg.ChannelID == nil || len(msg.ChannelID) == 0 {
+		return ErrorInvalidField("channel_id")
+	}
+	if msg.Amount.Denom == "" || !msg.Amount.IsPositive() {
+		return ErrorInvalidField("amount")
+	}
+	if msg.ClientSignature.Signature == nil || msg.ClientSignature.PubKey == nil {
+		return ErrorInvalidField("client_signature")
+	}
+	
+	return nil
+}
+
+func (msg MsgRedeemVoucher) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	
+	return bz
+}
+
+func (msg MsgRedeemVoucher) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgRedeemVoucher) Route() string {
+	return RouterKey
+}
+
+func NewMsgRedeemVoucher(from sdk.AccAddress, channelID hub.ChannelID,
+	amount sdk.Coin, clientSignature auth.StdSignature) *MsgRedeemVoucher {
+	return &MsgRedeemVoucher{
+		From:            from,
+		ChannelID:       channelID,
+		Amount:          amount,
+		ClientSignature: clientSignature,
+	}
+}
+
+var _ sdk.Msg = (*MsgCloseChannel)(nil)
+
+type MsgCloseChannel struct {
+	From      sdk.AccAddress `json:"from"`
+	ChannelID hub.ChannelID  `json:"channel_id"`
+}
+
+func (msg MsgCloseChannel) Type() string {
+	return "close_channel"
+}
+
+func (msg MsgCloseChannel) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if msg.ChannelID == nil || len(msg.ChannelID) == 0 {
+		return ErrorInvalidField("channel_id")
+	}
+	
+	return nil
+}
+
+func (msg MsgCloseChannel) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	
+	return bz
+}
+
+func (msg MsgCloseChannel) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgCloseChannel) Route() string {
+	return RouterKey
+}
+
+func NewMsgCloseChannel(from sdk.AccAddress, channelID hub.ChannelID) *MsgCloseChannel {
+	return &MsgCloseChannel{
+		From:      from,
+		ChannelID: channelID,
+	}
+}
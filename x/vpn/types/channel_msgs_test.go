@@ -0,0 +1,148 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+	"time"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	
+	hub "github.com/sentinel-official/hub/types"
+)
+
+func TestMsgOpenChannel_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgOpenChannel
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgOpenChannel(nil, hub.NewNodeID(0), sdk.NewInt64Coin("stake", 100), time.Hour),
+			ErrorInvalidField("from"),
+		}, {
+			"from is empty",
+			NewMsgOpenChannel([]byte(""), hub.NewNodeID(0), sdk.NewInt64Coin("stake", 100), time.Hour),
+			ErrorInvalidField("from"),
+		}, {
+			"node id is nil",
+			NewMsgOpenChannel(TestAddress2, nil, sdk.NewInt64Coin("stake", 100), time.Hour),
+			ErrorInvalidField("node_id"),
+		}, {
+			"node id is empty",
+			NewMsgOpenChannel(TestAddress2, []byte(""), sdk.NewInt64Coin("stake", 100), time.Hour),
+			ErrorInvalidField("node_id"),
+		}, {
+			"deposit is empty",
+			NewMsgOpenChannel(TestAddress2, hub.NewNodeID(0), sdk.Coin{}, time.Hour),
+			ErrorInvalidField("deposit"),
+		}, {
+			"deposit is zero",
+			NewMsgOpenChannel(TestAddress2, hub.NewNodeID(0), sdk.NewInt64Coin("stake", 0), time.Hour),
+			ErrorInvalidField("deposit"),
+		}, {
+			"timeout is zero",
+			NewMsgOpenChannel(TestAddress2, hub.NewNodeID(0), sdk.NewInt64Coin("stake", 100), 0),
+			ErrorInvalidField("timeout"),
+		}, {
+			"timeout is negative",
+			NewMsgOpenChannel(TestAddress2, hub.NewNodeID(0), sdk.NewInt64Coin("stake", 100), -time.Hour),
+			ErrorInvalidField("timeout"),
+		}, {
+			"valid",
+			NewMsgOpenChannel(TestAddress2, hub.NewNodeID(0), sdk.NewInt64Coin("stake", 100), time.Hour),
+			nil,
+		},
+	}
+	
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMsgRedeemVoucher_ValidateBasic(t *testing.T) {
+	signature := auth.StdSignature{PubKey: TestPubkey2, Signature: []byte("signature")}
+	
+	tests := []struct {
+		name string
+		msg  *MsgRedeemVoucher
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgRedeemVoucher(nil, hub.NewChannelID(0), sdk.NewInt64Coin("stake", 10), signature),
+			ErrorInvalidField("from"),
+		}, {
+			"from is empty",
+			NewMsgRedeemVoucher([]byte(""), hub.NewChannelID(0), sdk.NewInt64Coin("stake", 10), signature),
+			ErrorInvalidField("from"),
+		}, {
+			"channel id is nil",
+			NewMsgRedeemVoucher(TestAddress1, nil, sdk.NewInt64Coin("stake", 10), signature),
+			ErrorInvalidField("channel_id"),
+		}, {
+			"amount is empty",
+			NewMsgRedeemVoucher(TestAddress1, hub.NewChannelID(0), sdk.Coin{}, signature),
+			ErrorInvalidField("amount"),
+		}, {
+			"amount is zero",
+			NewMsgRedeemVoucher(TestAddress1, hub.NewChannelID(0), sdk.NewInt64Coin("stake", 0), signature),
+			ErrorInvalidField("amount"),
+		}, {
+			"client signature is empty",
+			NewMsgRedeemVoucher(TestAddress1, hub.NewChannelID(0), sdk.NewInt64Coin("stake", 10), auth.StdSignature{}),
+			ErrorInvalidField("client_signature"),
+		}, {
+			"valid",
+			NewMsgRedeemVoucher(TestAddress1, hub.NewChannelID(0), sdk.NewInt64Coin("stake", 10), signature),
+			nil,
+		},
+	}
+	
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMsgCloseChannel_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgCloseChannel
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgCloseChannel(nil, hub.NewChannelID(0)),
+			ErrorInvalidField("from"),
+		}, {
+			"from is empty",
+			NewMsgCloseChannel([]byte(""), hub.NewChannelID(0)),
+			ErrorInvalidField("from"),
+		}, {
+			"channel id is nil",
+			NewMsgCloseChannel(TestAddress2, nil),
+			ErrorInvalidField("channel_id"),
+		}, {
+			"valid",
+			NewMsgCloseChannel(TestAddress2, hub.NewChannelID(0)),
+			nil,
+		},
+	}
+	
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
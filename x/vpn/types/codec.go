@@ -11,18 +11,34 @@ var (
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgRegisterNode{}, "x/vpn/MsgRegisterNode", nil)
 	cdc.RegisterConcrete(MsgUpdateNodeInfo{}, "x/vpn/MsgUpdateNodeInfo", nil)
+	cdc.RegisterConcrete(MsgUpdateNodePlans{}, "x/vpn/MsgUpdateNodePlans", nil)
 	cdc.RegisterConcrete(MsgAddFreeClient{}, "x/vpn/MsgAddFreeClient", nil)
 	cdc.RegisterConcrete(MsgRemoveFreeClient{}, "x/vpn/MsgRemoveFreeClient", nil)
 	cdc.RegisterConcrete(MsgRegisterVPNOnResolver{}, "x/vpn/MsgRegisterVPNOnResolver", nil)
 	cdc.RegisterConcrete(MsgDeregisterVPNOnResolver{}, "x/vpn/MsgDeregisterVPNOnResolver", nil)
+	cdc.RegisterConcrete(MsgAcceptVPNOnResolver{}, "x/vpn/MsgAcceptVPNOnResolver", nil)
+	cdc.RegisterConcrete(MsgRejectVPNOnResolver{}, "x/vpn/MsgRejectVPNOnResolver", nil)
 	cdc.RegisterConcrete(MsgDeregisterNode{}, "x/vpn/MsgDeregisterNode", nil)
+	cdc.RegisterConcrete(MsgNodeHeartbeat{}, "x/vpn/MsgNodeHeartbeat", nil)
+	cdc.RegisterConcrete(MsgTopUpNodeDeposit{}, "x/vpn/MsgTopUpNodeDeposit", nil)
 	cdc.RegisterConcrete(MsgStartSubscription{}, "x/vpn/MsgStartSubscription", nil)
 	cdc.RegisterConcrete(MsgEndSubscription{}, "x/vpn/MsgEndSubscription", nil)
+	cdc.RegisterConcrete(MsgStartSession{}, "x/vpn/MsgStartSession", nil)
 	cdc.RegisterConcrete(MsgUpdateSessionInfo{}, "x/vpn/MsgUpdateSessionInfo", nil)
+	cdc.RegisterConcrete(MsgBatchUpdateSessions{}, "x/vpn/MsgBatchUpdateSessions", nil)
 	cdc.RegisterConcrete(MsgEndSession{}, "x/vpn/MsgEndSession", nil)
+	cdc.RegisterConcrete(MsgOpenChannel{}, "x/vpn/MsgOpenChannel", nil)
+	cdc.RegisterConcrete(MsgRedeemVoucher{}, "x/vpn/MsgRedeemVoucher", nil)
+	cdc.RegisterConcrete(MsgCloseChannel{}, "x/vpn/MsgCloseChannel", nil)
+	cdc.RegisterConcrete(MsgSubmitEvidence{}, "x/vpn/MsgSubmitEvidence", nil)
 	cdc.RegisterConcrete(MsgRegisterResolver{}, "x/vpn/MsgRegisterResolver", nil)
 	cdc.RegisterConcrete(MsgUpdateResolverInfo{}, "x/vpn/MsgUpdateResolverInfo", nil)
 	cdc.RegisterConcrete(MsgDeregisterResolver{}, "x/vpn/MsgDeregisterResolver", nil)
+	
+	cdc.RegisterConcrete(ChangeParamsProposal{}, "x/vpn/ChangeParamsProposal", nil)
+	cdc.RegisterConcrete(BlacklistProposal{}, "x/vpn/BlacklistProposal", nil)
+	cdc.RegisterConcrete(MaxPricesPerGBProposal{}, "x/vpn/MaxPricesPerGBProposal", nil)
+	cdc.RegisterConcrete(ExchangeRatesProposal{}, "x/vpn/ExchangeRatesProposal", nil)
 }
 
 func init() {
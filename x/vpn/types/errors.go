@@ -9,43 +9,87 @@ import (
 const (
 	Codespace = sdk.CodespaceType("vpn")
 	
-	errCodeUnknownMsgType            = 101
-	errCodeUnknownQueryType          = 102
-	errCodeInvalidField              = 103
-	errCodeUnauthorized              = 104
-	errCodeNodeDoesNotExist          = 105
-	errCodeInvalidNodeStatus         = 106
-	errCodeInvalidDeposit            = 107
-	errCodeSubscriptionDoesNotExist  = 108
-	errCodeSubscriptionAlreadyExists = 109
-	errCodeInvalidSubscriptionStatus = 110
-	errCodeInvalidBandwidth          = 111
-	errCodeInvalidBandwidthSignature = 112
-	errCodeSessionAlreadyExists      = 113
-	errCodeInvalidSessionStatus      = 114
-	errCodeResolverAlreadyExist      = 121
-	errCodeResolverDoesNotExist      = 122
-	errCodeInvalidResolverStatus     = 123
-	errCodeFreeClientDoesNotExist    = 115
+	errCodeUnknownMsgType             = 101
+	errCodeUnknownQueryType           = 102
+	errCodeInvalidField               = 103
+	errCodeUnauthorized               = 104
+	errCodeNodeDoesNotExist           = 105
+	errCodeInvalidNodeStatus          = 106
+	errCodeInvalidDeposit             = 107
+	errCodeSubscriptionDoesNotExist   = 108
+	errCodeSubscriptionAlreadyExists  = 109
+	errCodeInvalidSubscriptionStatus  = 110
+	errCodeInvalidBandwidth           = 111
+	errCodeInvalidBandwidthSignature  = 112
+	errCodeSessionAlreadyExists       = 113
+	errCodeInvalidSessionStatus       = 114
+	errCodeResolverAlreadyExist       = 121
+	errCodeResolverDoesNotExist       = 122
+	errCodeInvalidResolverStatus      = 123
+	errCodeFreeClientDoesNotExist     = 115
+	errCodePlanDoesNotExist           = 116
+	errCodeInvalidEvidence            = 117
+	errCodeDuplicateEvidence          = 118
+	errCodeNodeJailed                 = 119
+	errCodeUnknownProposalType        = 120
+	errCodeNodeBlacklisted            = 124
+	errCodeResolverBlacklisted        = 125
+	errCodePricesExceedMax            = 126
+	errCodeSessionDoesNotExist        = 127
+	errCodeSessionsLimitReached       = 128
+	errCodeChannelDoesNotExist        = 129
+	errCodeInvalidChannelStatus       = 130
+	errCodeInvalidVoucher             = 131
+	errCodeChannelNotExpired          = 132
+	errCodeCommissionRateTooHigh      = 133
+	errCodeCommissionChangeTooHigh    = 134
+	errCodeCommissionUpdatedTooSoon   = 135
+	errCodeListingRequestDoesNotExist = 136
+	errCodeListingAlreadyExists       = 137
+	errCodeListingDoesNotExist        = 138
+	errCodeFreeClientLimitReached     = 139
+	errCodeDurationExceedsMax         = 140
 	
-	errMsgUnknownMsgType            = "Unknown message type: "
-	errMsgUnknownQueryType          = "Invalid query type: "
-	errMsgInvalidField              = "Invalid field: "
-	errMsgUnauthorized              = "Unauthorized"
-	errMsgNodeDoesNotExist          = "Node does not exist"
-	errMsgInvalidNodeStatus         = "Invalid node status"
-	errMsgInvalidDeposit            = "Invalid deposit"
-	errMsgSubscriptionDoesNotExist  = "Subscription does not exist"
-	errMsgSubscriptionAlreadyExists = "Subscription already exists"
-	errMsgInvalidSubscriptionStatus = "Invalid subscription status"
-	errMsgInvalidBandwidth          = "Invalid bandwidth"
-	errMsgInvalidBandwidthSignature = "Invalid bandwidth signature"
-	errMsgSessionAlreadyExists      = "Session is active"
-	errMsgInvalidSessionStatus      = "Invalid session status"
-	errMsgResolverAlreadyExist      = "Resolver already exist"
-	errMsgResolverDoesNotExist      = "Resolver does not exist"
-	errMsgInvalidResolverStatus     = "Invalid resolver status"
-	errMsgFreeClientDoesNotExist    = "Free client does not exist"
+	errMsgUnknownMsgType             = "Unknown message type: "
+	errMsgUnknownQueryType           = "Invalid query type: "
+	errMsgInvalidField               = "Invalid field: "
+	errMsgUnauthorized               = "Unauthorized"
+	errMsgNodeDoesNotExist           = "Node does not exist"
+	errMsgInvalidNodeStatus          = "Invalid node status"
+	errMsgInvalidDeposit             = "Invalid deposit"
+	errMsgSubscriptionDoesNotExist   = "Subscription does not exist"
+	errMsgSubscriptionAlreadyExists  = "Subscription already exists"
+	errMsgInvalidSubscriptionStatus  = "Invalid subscription status"
+	errMsgInvalidBandwidth           = "Invalid bandwidth"
+	errMsgInvalidBandwidthSignature  = "Invalid bandwidth signature"
+	errMsgSessionAlreadyExists       = "Session is active"
+	errMsgInvalidSessionStatus       = "Invalid session status"
+	errMsgResolverAlreadyExist       = "Resolver already exist"
+	errMsgResolverDoesNotExist       = "Resolver does not exist"
+	errMsgInvalidResolverStatus      = "Invalid resolver status"
+	errMsgFreeClientDoesNotExist     = "Free client does not exist"
+	errMsgPlanDoesNotExist           = "Plan does not exist"
+	errMsgInvalidEvidence            = "Invalid evidence"
+	errMsgDuplicateEvidence          = "Evidence is already submitted"
+	errMsgNodeJailed                 = "Node is jailed"
+	errMsgUnknownProposalType        = "Unknown proposal type: "
+	errMsgNodeBlacklisted            = "Node is blacklisted"
+	errMsgResolverBlacklisted        = "Resolver is blacklisted"
+	errMsgPricesExceedMax            = "Prices per GB exceed the maximum"
+	errMsgSessionDoesNotExist        = "Session does not exist"
+	errMsgSessionsLimitReached       = "Maximum concurrent sessions of the plan reached"
+	errMsgChannelDoesNotExist        = "Channel does not exist"
+	errMsgInvalidChannelStatus       = "Invalid channel status"
+	errMsgInvalidVoucher             = "Invalid voucher"
+	errMsgChannelNotExpired          = "Channel is not expired"
+	errMsgCommissionRateTooHigh      = "Commission rate exceeds the maximum commission rate"
+	errMsgCommissionChangeTooHigh    = "Commission change exceeds the maximum commission change rate"
+	errMsgCommissionUpdatedTooSoon   = "Commission was updated too recently"
+	errMsgListingRequestDoesNotExist = "Listing request does not exist"
+	errMsgListingAlreadyExists       = "Node is already listed or requested on the resolver"
+	errMsgListingDoesNotExist        = "Node is not listed on the resolver"
+	errMsgFreeClientLimitReached     = "Maximum subscriptions of the free client reached"
+	errMsgDurationExceedsMax         = "Duration exceeds the maximum subscription duration"
 )
 
 func ErrorMarshal() sdk.Error {
@@ -127,3 +171,91 @@ func ErrorInvalidResolverStatus() sdk.Error {
 func ErrorFreeClientDoesNotExist() sdk.Error {
 	return sdk.NewError(Codespace, errCodeFreeClientDoesNotExist, errMsgFreeClientDoesNotExist)
 }
+
+func ErrorPlanDoesNotExist() sdk.Error {
+	return sdk.NewError(Codespace, errCodePlanDoesNotExist, errMsgPlanDoesNotExist)
+}
+
+func ErrorInvalidEvidence() sdk.Error {
+	return sdk.NewError(Codespace, errCodeInvalidEvidence, errMsgInvalidEvidence)
+}
+
+func ErrorDuplicateEvidence() sdk.Error {
+	return sdk.NewError(Codespace, errCodeDuplicateEvidence, errMsgDuplicateEvidence)
+}
+
+func ErrorNodeJailed() sdk.Error {
+	return sdk.NewError(Codespace, errCodeNodeJailed, errMsgNodeJailed)
+}
+
+func ErrorUnknownProposalType(proposalType string) sdk.Error {
+	return sdk.NewError(Codespace, errCodeUnknownProposalType, errMsgUnknownProposalType+proposalType)
+}
+
+func ErrorNodeBlacklisted() sdk.Error {
+	return sdk.NewError(Codespace, errCodeNodeBlacklisted, errMsgNodeBlacklisted)
+}
+
+func ErrorResolverBlacklisted() sdk.Error {
+	return sdk.NewError(Codespace, errCodeResolverBlacklisted, errMsgResolverBlacklisted)
+}
+
+func ErrorPricesExceedMax() sdk.Error {
+	return sdk.NewError(Codespace, errCodePricesExceedMax, errMsgPricesExceedMax)
+}
+
+func ErrorSessionDoesNotExist() sdk.Error {
+	return sdk.NewError(Codespace, errCodeSessionDoesNotExist, errMsgSessionDoesNotExist)
+}
+
+func ErrorSessionsLimitReached() sdk.Error {
+	return sdk.NewError(Codespace, errCodeSessionsLimitReached, errMsgSessionsLimitReached)
+}
+
+func ErrorChannelDoesNotExist() sdk.Error {
+	return sdk.NewError(Codespace, errCodeChannelDoesNotExist, errMsgChannelDoesNotExist)
+}
+
+func ErrorInvalidChannelStatus() sdk.Error {
+	return sdk.NewError(Codespace, errCodeInvalidChannelStatus, errMsgInvalidChannelStatus)
+}
+
+func ErrorInvalidVoucher() sdk.Error {
+	return sdk.NewError(Codespace, errCodeInvalidVoucher, errMsgInvalidVoucher)
+}
+
+func ErrorChannelNotExpired() sdk.Error {
+	return sdk.NewError(Codespace, errCodeChannelNotExpired, errMsgChannelNotExpired)
+}
+
+func ErrorCommissionRateTooHigh() sdk.Error {
+	return sdk.NewError(Codespace, errCodeCommissionRateTooHigh, errMsgCommissionRateTooHigh)
+}
+
+func ErrorCommissionChangeTooHigh() sdk.Error {
+	return sdk.NewError(Codespace, errCodeCommissionChangeTooHigh, errMsgCommissionChangeTooHigh)
+}
+
+func ErrorCommissionUpdatedTooSoon() sdk.Error {
+	return sdk.NewError(Codespace, errCodeCommissionUpdatedTooSoon, errMsgCommissionUpdatedTooSoon)
+}
+
+func ErrorListingRequestDoesNotExist() sdk.Error {
+	return sdk.NewError(Codespace, errCodeListingRequestDoesNotExist, errMsgListingRequestDoesNotExist)
+}
+
+func ErrorListingAlreadyExists() sdk.Error {
+	return sdk.NewError(Codespace, errCodeListingAlreadyExists, errMsgListingAlreadyExists)
+}
+
+func ErrorListingDoesNotExist() sdk.Error {
+	return sdk.NewError(Codespace, errCodeListingDoesNotExist, errMsgListingDoesNotExist)
+}
+
+func ErrorFreeClientLimitReached() sdk.Error {
+	return sdk.NewError(Codespace, errCodeFreeClientLimitReached, errMsgFreeClientLimitReached)
+}
+
+func ErrorDurationExceedsMax() sdk.Error {
+	return sdk.NewError(Codespace, errCodeDurationExceedsMax, errMsgDurationExceedsMax)
+}
@@ -1,32 +1,75 @@
 package types
 
 var (
-	EventTypeMsgRegisterNode   = "msg_register_node"
-	EventTypeMsgUpdateNodeInfo = "msg_update_node_info"
-	EventTypeMsgDeregisterNode = "msg_deregister_node"
+	EventTypeMsgRegisterNode       = "msg_register_node"
+	EventTypeMsgUpdateNodeInfo     = "msg_update_node_info"
+	EventTypeMsgUpdateNodePlans    = "msg_update_node_plans"
+	EventTypeMsgDeregisterNode     = "msg_deregister_node"
+	EventTypeMsgNodeHeartbeat      = "msg_node_heartbeat"
+	EventTypeInactivateNode        = "inactivate_node"
+	EventTypeMsgTopUpNodeDeposit   = "msg_top_up_node_deposit"
+	EventTypeCompleteNodeUnbonding = "complete_node_unbonding"
 	
 	EventTypeMsgAddFreeClient    = "msg_add_free_client"
 	EventTypeMsgRemoveFreeClient = "msg_remove_free_client"
+	EventTypeFreeClientExhausted = "free_client_exhausted"
+	EventTypeFreeClientExpired   = "free_client_expired"
 	
 	EventTypeMsgRegisterVPNOnResolver   = "msg_register_vpn_on_resolver"
 	EventTypeMsgDeregisterVPNOnResolver = "msg_deregister_vpn_on_resolver"
+	EventTypeMsgAcceptVPNOnResolver     = "msg_accept_vpn_on_resolver"
+	EventTypeMsgRejectVPNOnResolver     = "msg_reject_vpn_on_resolver"
 	
 	EventTypeMsgStartSubscription = "msg_start_subscription"
 	EventTypeMsgEndSubscription   = "msg_end_subscription"
 	
+	EventTypeMsgStartSession      = "msg_start_session"
 	EventTypeMsgUpdateSessionInfo = "msg_update_session_info"
+	EventTypeMsgEndSession        = "msg_end_session"
+	EventTypeMsgSubmitEvidence    = "msg_submit_evidence"
 	
-	EventTypeMsgRegisterResolver   = "msg_register_resolver"
-	EventTypeMsgUpdateResolverInfo = "msg_update_resolver_info"
-	EventTypeMsgDeregisterResolver = "msg_deregister_resolver"
+	EventTypeMsgOpenChannel   = "msg_open_channel"
+	EventTypeMsgRedeemVoucher = "msg_redeem_voucher"
+	EventTypeMsgCloseChannel  = "msg_close_channel"
+	
+	EventTypeSettleSession      = "settle_session"
+	EventTypeSettleSubscription = "settle_subscription"
+	EventTypeAbortSession       = "abort_session"
+	EventTypeAbortSubscription  = "abort_subscription"
+	EventTypeSlashNode          = "slash_node"
+	
+	EventTypeMsgRegisterResolver       = "msg_register_resolver"
+	EventTypeMsgUpdateResolverInfo     = "msg_update_resolver_info"
+	EventTypeMsgDeregisterResolver     = "msg_deregister_resolver"
+	EventTypeCompleteResolverUnbonding = "complete_resolver_unbonding"
+	
+	EventTypeChangeParams         = "change_params"
+	EventTypeBlacklistNode        = "blacklist_node"
+	EventTypeBlacklistResolver    = "blacklist_resolver"
+	EventTypeChangeMaxPricesPerGB = "change_max_prices_per_gb"
+	EventTypeChangeExchangeRates  = "change_exchange_rates"
+	EventTypeMigrateStore         = "migrate_store"
 	
 	AttributeKeyClientAddress = "client_address"
 	AttributeKeyFromAddress   = "from_address"
 	AttributeKeyNodeID        = "node_id"
 	AttributeSubscriptionID   = "subscription_id"
 	AttributeSessionID        = "session_id"
+	AttributeKeyChannelID     = "channel_id"
 	AttributeKeyResolverID    = "resolver_id"
 	AttributeKeyStatus        = "status"
 	AttributeKeyCommission    = "commission"
 	AttributeKeyDeposit       = "deposit"
+	AttributeKeyPayer         = "payer"
+	AttributeKeyNodePayee     = "node_payee"
+	AttributeKeyResolverPayee = "resolver_payee"
+	AttributeKeyAmount        = "amount"
+	AttributeKeyBandwidth     = "bandwidth"
+	AttributeKeyRefund        = "refund"
+	AttributeKeyVictim        = "victim"
+	AttributeKeyJailed        = "jailed"
+	AttributeKeyBlacklisted   = "blacklisted"
+	AttributeKeyPricesPerGB   = "prices_per_gb"
+	AttributeKeyVersion       = "version"
+	AttributeKeyExchangeRates = "exchange_rates"
 )
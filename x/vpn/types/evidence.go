@@ -0,0 +1,96 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	
+	hub "github.com/sentinel-official/hub/types"
+)
+
+// Evidence holds two bandwidths of the same session of a subscription, both signed by the node owner.
+// The bandwidths a node owner signs for a session are cumulative, so the honest ones are ordered and
+// two bandwidths of which neither is below the other prove misbehaviour.
+type Evidence struct {
+	SubscriptionID hub.SubscriptionID `json:"subscription_id"`
+	SessionID      hub.SessionID      `json:"session_id"`
+	Bandwidth1     hub.Bandwidth      `json:"bandwidth_1"`
+	Signature1     auth.StdSignature  `json:"signature_1"`
+	Bandwidth2     hub.Bandwidth      `json:"bandwidth_2"`
+	Signature2     auth.StdSignature  `json:"signature_2"`
+}
+
+func NewEvidence(id hub.SubscriptionID, sessionID hub.SessionID, bandwidth1 hub.Bandwidth, signature1 auth.StdSignature,
+	bandwidth2 hub.Bandwidth, signature2 auth.StdSignature) Evidence {
+	return Evidence{
+		SubscriptionID: id,
+		SessionID:      sessionID,
+		Bandwidth1:     bandwidth1,
+		Signature1:     signature1,
+		Bandwidth2:     bandwidth2,
+		Signature2:     signature2,
+	}
+}
+
+func (e Evidence) String() string {
+	return fmt.Sprintf(`Evidence
+  Subscription ID:     %s
+  Session ID:          %s
+  Bandwidth 1:         %s
+  Bandwidth 2:         %s`, e.SubscriptionID, e.SessionID, e.Bandwidth1, e.Bandwidth2)
+}
+
+func (e Evidence) IsValid() error {
+	if e.SubscriptionID == nil {
+		return fmt.Errorf("invalid subscription id")
+	}
+	if e.SessionID == nil {
+		return fmt.Errorf("invalid session id")
+	}
+	if e.Bandwidth1.AnyNil() || e.Bandwidth2.AnyNil() {
+		return fmt.Errorf("invalid bandwidth")
+	}
+	if e.Bandwidth1.AllEqual(e.Bandwidth2) {
+		return fmt.Errorf("bandwidths are not conflicting")
+	}
+	if e.Signature1.Signature == nil || e.Signature1.PubKey == nil ||
+		e.Signature2.Signature == nil || e.Signature2.PubKey == nil {
+		return fmt.Errorf("invalid signature")
+	}
+	
+	return nil
+}
+
+// IsConflicting reports whether the bandwidths contradict each other or the bandwidth of the session settled
+// on chain, which the node owner has signed as well. Ascending bandwidths of an honest session do not conflict.
+func (e Evidence) IsConflicting(settled hub.Bandwidth) bool {
+	if !isOrdered(e.Bandwidth1, e.Bandwidth2) {
+		return true
+	}
+	if settled.AnyNil() {
+		return false
+	}
+	
+	return !isOrdered(e.Bandwidth1, settled) || !isOrdered(e.Bandwidth2, settled)
+}
+
+func isOrdered(b1, b2 hub.Bandwidth) bool {
+	return b1.AllLTE(b2) || b2.AllLTE(b1)
+}
+
+// Verify checks that both of the bandwidths are signed by the given signer
+func (e Evidence) Verify(signer []byte) bool {
+	return verifyBandwidthSignature(e.SubscriptionID, e.SessionID, e.Bandwidth1, e.Signature1, signer) &&
+		verifyBandwidthSignature(e.SubscriptionID, e.SessionID, e.Bandwidth2, e.Signature2, signer)
+}
+
+func verifyBandwidthSignature(id hub.SubscriptionID, sessionID hub.SessionID, bandwidth hub.Bandwidth,
+	signature auth.StdSignature, signer []byte) bool {
+	if !bytes.Equal(signature.PubKey.Address(), signer) {
+		return false
+	}
+	
+	data := hub.NewBandwidthSignatureData(id, sessionID, bandwidth).Bytes()
+	return signature.VerifyBytes(data, signature.Signature)
+}
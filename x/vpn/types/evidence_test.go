@@ -0,0 +1,85 @@
+package types
+
+import (
+	"testing"
+	
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/stretchr/testify/require"
+	
+	hub "github.com/sentinel-official/hub/types"
+)
+
+func TestEvidence_IsValid(t *testing.T) {
+	tests := []struct {
+		name     string
+		evidence Evidence
+		want     bool
+	}{
+		{
+			"subscription id is nil",
+			NewEvidence(nil, hub.NewSessionID(1), TestBandwidthPos1, TestNodeOwnerStdSignaturePos1,
+				TestBandwidthPos2, TestNodeOwnerStdSignaturePos2),
+			false,
+		}, {
+			"session id is nil",
+			NewEvidence(hub.NewSubscriptionID(0), nil, TestBandwidthPos1, TestNodeOwnerStdSignaturePos1,
+				TestBandwidthPos2, TestNodeOwnerStdSignaturePos2),
+			false,
+		}, {
+			"bandwidth is nil",
+			NewEvidence(hub.NewSubscriptionID(0), hub.NewSessionID(0), hub.Bandwidth{}, TestNodeOwnerStdSignaturePos1,
+				TestBandwidthPos2, TestNodeOwnerStdSignaturePos2),
+			false,
+		}, {
+			"bandwidths are equal",
+			NewEvidence(hub.NewSubscriptionID(0), hub.NewSessionID(0), TestBandwidthPos1, TestNodeOwnerStdSignaturePos1,
+				TestBandwidthPos1, TestNodeOwnerStdSignaturePos1),
+			false,
+		}, {
+			"signature is empty",
+			NewEvidence(hub.NewSubscriptionID(0), hub.NewSessionID(0), TestBandwidthPos1, TestNodeOwnerStdSignaturePos1,
+				TestBandwidthPos2, auth.StdSignature{}),
+			false,
+		}, {
+			"valid",
+			NewEvidence(hub.NewSubscriptionID(0), hub.NewSessionID(0), TestBandwidthPos1, TestNodeOwnerStdSignaturePos1,
+				TestBandwidthSkew, TestNodeOwnerStdSignatureSkew),
+			true,
+		},
+	}
+	
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.evidence.IsValid() == nil; got != tc.want {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestEvidence_IsConflicting(t *testing.T) {
+	honest := NewEvidence(hub.NewSubscriptionID(0), hub.NewSessionID(0), TestBandwidthPos1, TestNodeOwnerStdSignaturePos1,
+		TestBandwidthPos2, TestNodeOwnerStdSignaturePos2)
+	require.False(t, honest.IsConflicting(TestBandwidthZero))
+	require.False(t, honest.IsConflicting(TestBandwidthPos1))
+	require.False(t, honest.IsConflicting(TestBandwidthPos2.Add(TestBandwidthPos1)))
+	require.True(t, honest.IsConflicting(TestBandwidthSkew))
+	
+	skewed := NewEvidence(hub.NewSubscriptionID(0), hub.NewSessionID(0), TestBandwidthPos1, TestNodeOwnerStdSignaturePos1,
+		TestBandwidthSkew, TestNodeOwnerStdSignatureSkew)
+	require.True(t, skewed.IsConflicting(TestBandwidthZero))
+}
+
+func TestEvidence_Verify(t *testing.T) {
+	evidence := NewEvidence(hub.NewSubscriptionID(0), hub.NewSessionID(0), TestBandwidthPos1, TestNodeOwnerStdSignaturePos1,
+		TestBandwidthPos2, TestNodeOwnerStdSignaturePos2)
+	require.True(t, evidence.Verify(TestAddress1))
+	require.False(t, evidence.Verify(TestAddress2))
+	
+	evidence.SessionID = hub.NewSessionID(1)
+	require.False(t, evidence.Verify(TestAddress1))
+	
+	evidence.SessionID = hub.NewSessionID(0)
+	evidence.Signature2 = TestClientStdSignaturePos2
+	require.False(t, evidence.Verify(TestAddress1))
+}
@@ -0,0 +1,140 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ExchangeRate is the value of one unit of the denomination in the common reference unit of the exchange rates
+type ExchangeRate struct {
+	Denom string  `json:"denom"`
+	Rate  sdk.Dec `json:"rate"`
+}
+
+func NewExchangeRate(denom string, rate sdk.Dec) ExchangeRate {
+	return ExchangeRate{
+		Denom: denom,
+		Rate:  rate,
+	}
+}
+
+func (r ExchangeRate) String() string {
+	return fmt.Sprintf("%s%s", r.Rate, r.Denom)
+}
+
+func (r ExchangeRate) IsValid() error {
+	if !(sdk.Coin{Denom: r.Denom, Amount: sdk.ZeroInt()}).IsValid() {
+		return fmt.Errorf("invalid denom")
+	}
+	if r.Rate.IsNil() || !r.Rate.IsPositive() {
+		return fmt.Errorf("invalid rate")
+	}
+	
+	return nil
+}
+
+// ExchangeRates is the table of the exchange rates, sorted by the denomination
+type ExchangeRates []ExchangeRate
+
+func (r ExchangeRates) String() string {
+	rates := make([]string, 0, len(r))
+	for _, rate := range r {
+		rates = append(rates, rate.String())
+	}
+	
+	return strings.Join(rates, ",")
+}
+
+func (r ExchangeRates) Sort() ExchangeRates {
+	sort.Slice(r, func(i, j int) bool {
+		return r[i].Denom < r[j].Denom
+	})
+	
+	return r
+}
+
+func (r ExchangeRates) Validate() error {
+	for i, rate := range r {
+		if err := rate.IsValid(); err != nil {
+			return fmt.Errorf("%s: %s", rate, err)
+		}
+		if i > 0 && r[i-1].Denom >= rate.Denom {
+			return fmt.Errorf("%s: denominations are not sorted or not unique", rate)
+		}
+	}
+	
+	return nil
+}
+
+func (r ExchangeRates) Find(denom string) (rate ExchangeRate, found bool) {
+	index := sort.Search(len(r), func(i int) bool {
+		return r[i].Denom >= denom
+	})
+	
+	if index == len(r) || r[index].Denom != denom {
+		return rate, false
+	}
+	
+	return r[index], true
+}
+
+// Convert returns the price in the denomination, rounded up so that the converted price is worth at least the
+// given one. A price in the denomination itself is returned as it is.
+func (r ExchangeRates) Convert(price sdk.Coin, denom string) (sdk.Coin, bool) {
+	if price.Denom == denom {
+		return price, true
+	}
+	
+	from, found := r.Find(price.Denom)
+	if !found {
+		return price, false
+	}
+	
+	to, found := r.Find(denom)
+	if !found {
+		return price, false
+	}
+	
+	amount := price.Amount.ToDec().Mul(from.Rate).Quo(to.Rate).Ceil().TruncateInt()
+	return sdk.NewCoin(denom, amount), true
+}
+
+// Value returns the worth of the coins in the denomination, each rounded down so that the value is not worth
+// more than the coins. The coins in the denomination itself count as they are.
+func (r ExchangeRates) Value(coins sdk.Coins, denom string) (sdk.Coin, bool) {
+	value := sdk.NewInt64Coin(denom, 0)
+	for _, coin := range coins {
+		if coin.Denom == denom {
+			value = value.Add(coin)
+			continue
+		}
+		
+		from, found := r.Find(coin.Denom)
+		if !found {
+			return value, false
+		}
+		
+		to, found := r.Find(denom)
+		if !found {
+			return value, false
+		}
+		
+		value = value.Add(sdk.NewCoin(denom, coin.Amount.ToDec().Mul(from.Rate).Quo(to.Rate).TruncateInt()))
+	}
+	
+	return value, true
+}
+
+// Filter returns the exchange rates of the denominations of the coins
+func (r ExchangeRates) Filter(coins sdk.Coins) (rates ExchangeRates) {
+	for _, coin := range coins {
+		if rate, found := r.Find(coin.Denom); found {
+			rates = append(rates, rate)
+		}
+	}
+	
+	return rates
+}
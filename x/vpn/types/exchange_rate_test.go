@@ -0,0 +1,103 @@
+package types
+
+import (
+	"testing"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExchangeRates_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		rates ExchangeRates
+		valid bool
+	}{
+		{"empty", nil, true},
+		{"invalid denom", ExchangeRates{NewExchangeRate("", sdk.OneDec())}, false},
+		{"nil rate", ExchangeRates{{Denom: "stake"}}, false},
+		{"zero rate", ExchangeRates{NewExchangeRate("stake", sdk.ZeroDec())}, false},
+		{"negative rate", ExchangeRates{NewExchangeRate("stake", sdk.NewDec(-1))}, false},
+		{"unsorted", ExchangeRates{NewExchangeRate("stake", sdk.OneDec()), NewExchangeRate("atom", sdk.OneDec())}, false},
+		{"duplicate", ExchangeRates{NewExchangeRate("stake", sdk.OneDec()), NewExchangeRate("stake", sdk.OneDec())}, false},
+		{"valid", ExchangeRates{NewExchangeRate("atom", sdk.OneDec()), NewExchangeRate("stake", sdk.OneDec())}, true},
+	}
+	
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.rates.Validate(); (err == nil) != tc.valid {
+				t.Errorf("\ngot = %v, valid = %t", err, tc.valid)
+			}
+		})
+	}
+}
+
+func TestExchangeRates_Sort(t *testing.T) {
+	rates := ExchangeRates{NewExchangeRate("stake", sdk.OneDec()), NewExchangeRate("atom", sdk.OneDec())}.Sort()
+	require.Equal(t, "atom", rates[0].Denom)
+	require.Nil(t, rates.Validate())
+}
+
+func TestExchangeRates_Convert(t *testing.T) {
+	rates := ExchangeRates{
+		NewExchangeRate("atom", sdk.NewDecWithPrec(5, 1)),
+		NewExchangeRate("stake", sdk.NewDec(2)),
+		NewExchangeRate("udvpn", sdk.NewDec(3)),
+	}
+	
+	price, ok := rates.Convert(sdk.NewInt64Coin("stake", 100), "stake")
+	require.True(t, ok)
+	require.Equal(t, sdk.NewInt64Coin("stake", 100), price)
+	
+	price, ok = rates.Convert(sdk.NewInt64Coin("stake", 100), "atom")
+	require.True(t, ok)
+	require.Equal(t, sdk.NewInt64Coin("atom", 400), price)
+	
+	price, ok = rates.Convert(sdk.NewInt64Coin("stake", 100), "udvpn")
+	require.True(t, ok)
+	require.Equal(t, sdk.NewInt64Coin("udvpn", 67), price)
+	
+	_, ok = rates.Convert(sdk.NewInt64Coin("stake", 100), "other")
+	require.False(t, ok)
+	
+	_, ok = rates.Convert(sdk.NewInt64Coin("other", 100), "stake")
+	require.False(t, ok)
+}
+
+func TestExchangeRates_Value(t *testing.T) {
+	rates := ExchangeRates{
+		NewExchangeRate("atom", sdk.NewDecWithPrec(5, 1)),
+		NewExchangeRate("stake", sdk.NewDec(2)),
+		NewExchangeRate("udvpn", sdk.NewDec(3)),
+	}
+	
+	value, ok := rates.Value(sdk.Coins{sdk.NewInt64Coin("stake", 100)}, "stake")
+	require.True(t, ok)
+	require.Equal(t, sdk.NewInt64Coin("stake", 100), value)
+	
+	value, ok = rates.Value(sdk.Coins{sdk.NewInt64Coin("atom", 400), sdk.NewInt64Coin("stake", 100)}, "stake")
+	require.True(t, ok)
+	require.Equal(t, sdk.NewInt64Coin("stake", 200), value)
+	
+	value, ok = rates.Value(sdk.Coins{sdk.NewInt64Coin("stake", 100)}, "udvpn")
+	require.True(t, ok)
+	require.Equal(t, sdk.NewInt64Coin("udvpn", 66), value)
+	
+	_, ok = rates.Value(sdk.Coins{sdk.NewInt64Coin("other", 100)}, "stake")
+	require.False(t, ok)
+	
+	_, ok = ExchangeRates{}.Value(sdk.Coins{sdk.NewInt64Coin("atom", 100)}, "stake")
+	require.False(t, ok)
+}
+
+func TestExchangeRates_Filter(t *testing.T) {
+	rates := ExchangeRates{
+		NewExchangeRate("atom", sdk.NewDecWithPrec(5, 1)),
+		NewExchangeRate("stake", sdk.NewDec(2)),
+		NewExchangeRate("udvpn", sdk.NewDec(3)),
+	}
+	
+	require.Nil(t, rates.Filter(nil))
+	require.Equal(t, ExchangeRates{NewExchangeRate("atom", sdk.NewDecWithPrec(5, 1)), NewExchangeRate("udvpn", sdk.NewDec(3))},
+		rates.Filter(sdk.Coins{sdk.NewInt64Coin("atom", 1), sdk.NewInt64Coin("other", 1), sdk.NewInt64Coin("udvpn", 1)}))
+}
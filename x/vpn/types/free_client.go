@@ -8,14 +8,100 @@ import (
 	hub "github.com/sentinel-official/hub/types"
 )
 
+// FreeClient is a grant of free use of a node. A zero bandwidth quota, expiry height or maximum number of
+// concurrent subscriptions means the grant is not limited by it.
 type FreeClient struct {
-	NodeID hub.NodeID
-	Client sdk.AccAddress
+	NodeID            hub.NodeID     `json:"node_id"`
+	Client            sdk.AccAddress `json:"client"`
+	Bandwidth         hub.Bandwidth  `json:"bandwidth"`
+	ConsumedBandwidth hub.Bandwidth  `json:"consumed_bandwidth"`
+	ExpiresAt         int64          `json:"expires_at"`
+	MaxSubscriptions  uint64         `json:"max_subscriptions"`
+}
+
+func NewFreeClient(nodeID hub.NodeID, client sdk.AccAddress, bandwidth hub.Bandwidth,
+	expiresAt int64, maxSubscriptions uint64) FreeClient {
+	return FreeClient{
+		NodeID:            nodeID,
+		Client:            client,
+		Bandwidth:         bandwidth,
+		ConsumedBandwidth: hub.NewBandwidthFromInt64(0, 0),
+		ExpiresAt:         expiresAt,
+		MaxSubscriptions:  maxSubscriptions,
+	}
 }
 
 func (fc FreeClient) String() string {
 	return fmt.Sprintf(`
-	NodeID : %s
-	Client : %s
-`, fc.NodeID.String(), fc.Client.String())
+	NodeID            : %s
+	Client            : %s
+	Bandwidth         : %s
+	ConsumedBandwidth : %s
+	ExpiresAt         : %d
+	MaxSubscriptions  : %d
+`, fc.NodeID.String(), fc.Client.String(), fc.Bandwidth, fc.ConsumedBandwidth, fc.ExpiresAt, fc.MaxSubscriptions)
+}
+
+func (fc FreeClient) IsValid() error {
+	if fc.NodeID == nil {
+		return fmt.Errorf("invalid node_id")
+	}
+	if fc.Client == nil || fc.Client.Empty() {
+		return fmt.Errorf("invalid client")
+	}
+	if fc.Bandwidth.AnyNil() || fc.Bandwidth.AnyNegative() {
+		return fmt.Errorf("invalid bandwidth")
+	}
+	if fc.ConsumedBandwidth.AnyNil() || fc.ConsumedBandwidth.AnyNegative() {
+		return fmt.Errorf("invalid consumed_bandwidth")
+	}
+	if fc.ExpiresAt < 0 {
+		return fmt.Errorf("invalid expires_at")
+	}
+	
+	return nil
+}
+
+func (fc FreeClient) HasQuota() bool {
+	return !fc.Bandwidth.AnyNil() && fc.Bandwidth.Sum().IsPositive()
+}
+
+// IsUnlimited returns true if the grant never runs out. The deposits of the subscriptions of such a client
+// are not locked, as they are never billed.
+func (fc FreeClient) IsUnlimited() bool {
+	return !fc.HasQuota() && fc.ExpiresAt == 0
+}
+
+func (fc FreeClient) IsExpired(height int64) bool {
+	return fc.ExpiresAt > 0 && height >= fc.ExpiresAt
+}
+
+func (fc FreeClient) IsExhausted() bool {
+	return fc.HasQuota() && fc.consumed().GTE(fc.Bandwidth.Sum())
+}
+
+// RemainingBandwidth returns the number of bytes left in the quota of the grant
+func (fc FreeClient) RemainingBandwidth() sdk.Int {
+	if fc.IsExhausted() {
+		return sdk.ZeroInt()
+	}
+	
+	return fc.Bandwidth.Sum().Sub(fc.consumed())
+}
+
+func (fc FreeClient) Consume(bandwidth hub.Bandwidth) FreeClient {
+	if fc.ConsumedBandwidth.AnyNil() {
+		fc.ConsumedBandwidth = hub.NewBandwidthFromInt64(0, 0)
+	}
+	
+	fc.ConsumedBandwidth = fc.ConsumedBandwidth.Add(bandwidth)
+	return fc
+}
+
+func (fc FreeClient) consumed() sdk.Int {
+	if fc.ConsumedBandwidth.AnyNil() {
+		return sdk.ZeroInt()
+	}
+	
+	return fc.ConsumedBandwidth.Sum()
 }
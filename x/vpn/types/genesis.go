@@ -1,23 +1,63 @@
 package types
 
+import (
+	hub "github.com/sentinel-official/hub/types"
+)
+
+// ActiveNodeIDs are the IDs of the nodes which were last active at the height
+type ActiveNodeIDs struct {
+	Height int64        `json:"height"`
+	IDs    []hub.NodeID `json:"ids"`
+}
+
+// ActiveSessionIDs are the IDs of the sessions which were last updated at the height
+type ActiveSessionIDs struct {
+	Height int64           `json:"height"`
+	IDs    []hub.SessionID `json:"ids"`
+}
+
+// ResolverNode links a node to a resolver, either as an accepted or as a pending listing
+type ResolverNode struct {
+	ResolverID hub.ResolverID `json:"resolver_id"`
+	NodeID     hub.NodeID     `json:"node_id"`
+}
+
 type GenesisState struct {
-	Nodes         []Node         `json:"nodes"`
-	Subscriptions []Subscription `json:"subscriptions"`
-	Sessions      []Session      `json:"sessions"`
-	Resolvers     []Resolver     `json:"resolvers"`
-	FreeClients   []FreeClient   `json:"free_clients"`
-	Params        Params         `json:"params"`
+	Nodes                []Node               `json:"nodes"`
+	Subscriptions        []Subscription       `json:"subscriptions"`
+	Sessions             []Session            `json:"sessions"`
+	Channels             []Channel            `json:"channels"`
+	Resolvers            []Resolver           `json:"resolvers"`
+	ResolverCommissions  []ResolverCommission `json:"resolver_commissions"`
+	ResolverNodes        []ResolverNode       `json:"resolver_nodes"`
+	PendingResolverNodes []ResolverNode       `json:"pending_resolver_nodes"`
+	FreeClients          []FreeClient         `json:"free_clients"`
+	Evidences            []Evidence           `json:"evidences"`
+	Settlements          []Settlement         `json:"settlements"`
+	ActiveNodeIDs        []ActiveNodeIDs      `json:"active_node_ids"`
+	ActiveSessionIDs     []ActiveSessionIDs   `json:"active_session_ids"`
+	Params               Params               `json:"params"`
 }
 
-func NewGenesisState(nodes []Node, subscriptions []Subscription, sessions []Session, resolvers []Resolver,
-	freeClients []FreeClient, params Params) GenesisState {
+func NewGenesisState(nodes []Node, subscriptions []Subscription, sessions []Session, channels []Channel,
+	resolvers []Resolver, resolverCommissions []ResolverCommission, resolverNodes, pendingResolverNodes []ResolverNode,
+	freeClients []FreeClient, evidences []Evidence, settlements []Settlement, activeNodeIDs []ActiveNodeIDs,
+	activeSessionIDs []ActiveSessionIDs, params Params) GenesisState {
 	return GenesisState{
-		Nodes:         nodes,
-		Subscriptions: subscriptions,
-		Sessions:      sessions,
-		Resolvers:     resolvers,
-		FreeClients:   freeClients,
-		Params:        params,
+		Nodes:                nodes,
+		Subscriptions:        subscriptions,
+		Sessions:             sessions,
+		Channels:             channels,
+		Resolvers:            resolvers,
+		ResolverCommissions:  resolverCommissions,
+		ResolverNodes:        resolverNodes,
+		PendingResolverNodes: pendingResolverNodes,
+		FreeClients:          freeClients,
+		Evidences:            evidences,
+		Settlements:          settlements,
+		ActiveNodeIDs:        activeNodeIDs,
+		ActiveSessionIDs:     activeSessionIDs,
+		Params:               params,
 	}
 }
 
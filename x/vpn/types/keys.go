@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+	
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	
 	hub "github.com/sentinel-official/hub/types"
@@ -17,40 +19,67 @@ const (
 	StoreKeyResolver     = "resolver_node"
 	
 	StatusRegistered   = "REGISTERED"
+	StatusUnbonding    = "UNBONDING"
 	StatusDeRegistered = "DE-REGISTERED"
 	
 	StatusActive   = "ACTIVE"
 	StatusInactive = "INACTIVE"
+	
+	// ConsensusVersion is the version of the layout of the stores of the module. Chains which started
+	// before the version was stored are at version 1.
+	ConsensusVersion uint64 = 6
 )
 
 var (
+	// The keys of the node store
 	NodesCountKey                = []byte{0x00}
 	NodeKeyPrefix                = []byte{0x01}
 	NodesCountOfAddressKeyPrefix = []byte{0x02}
 	NodeIDByAddressKeyPrefix     = []byte{0x03}
+	EvidenceKeyPrefix            = []byte{0x04}
+	NodeUnbondingQueueKeyPrefix  = []byte{0x05}
+	FreeClientKeyPrefix          = []byte{0x06}
+	FreeNodesOfClientKeyPrefix   = []byte{0x07}
+	ConsensusVersionKey          = []byte{0x08}
+	
+	// The free clients used to be stored under these prefixes of the node store, which collide with the
+	// prefixes of the nodes. They are only read by the migration to the prefixes above.
+	LegacyFreeClientKeyPrefix        = []byte{0x00}
+	LegacyFreeNodesOfClientKeyPrefix = []byte{0x01}
+	LegacyFreeClientsOfNodeKeyPrefix = []byte{0x02}
 	
+	// The keys of the subscription store
 	SubscriptionsCountKey                = []byte{0x00}
 	SubscriptionKeyPrefix                = []byte{0x01}
 	SubscriptionsCountOfNodeKeyPrefix    = []byte{0x02}
 	SubscriptionIDByNodeIDKeyPrefix      = []byte{0x03}
 	SubscriptionsCountOfAddressKeyPrefix = []byte{0x04}
 	SubscriptionIDByAddressKeyPrefix     = []byte{0x05}
+	SubscriptionExpiryQueueKeyPrefix     = []byte{0x06}
+	ChannelsCountKey                     = []byte{0x07}
+	ChannelKeyPrefix                     = []byte{0x08}
+	ChannelsCountOfNodeKeyPrefix         = []byte{0x09}
+	ChannelIDByNodeIDKeyPrefix           = []byte{0x0A}
+	ChannelsCountOfAddressKeyPrefix      = []byte{0x0B}
+	ChannelIDByAddressKeyPrefix          = []byte{0x0C}
 	
+	// The keys of the session store
 	SessionsCountKey                     = []byte{0x00}
 	SessionKeyPrefix                     = []byte{0x01}
 	SessionsCountOfSubscriptionKeyPrefix = []byte{0x02}
 	SessionIDBySubscriptionIDKeyPrefix   = []byte{0x03}
+	SettlementKeyPrefix                  = []byte{0x04}
 	
+	// The keys of the resolver store
 	ResolverCountKey                = []byte{0x00}
 	ResolverCountOfAddressKeyPrefix = []byte{0x01}
 	ResolverKeyPrefix               = []byte{0x02}
 	ResolverIDByAddressPrefix       = []byte{0x03}
 	NodesOfResolverKeyPrefix        = []byte{0x04}
 	ResolversOfNodeKeyPrefix        = []byte{0x05}
-	
-	FreeClientKey              = []byte{0x00}
-	FreeNodesOfClientKeyPrefix = []byte{0x01}
-	FreeClientOfNodeKeyPrefix  = []byte{0x02}
+	ResolverUnbondingQueueKeyPrefix = []byte{0x06}
+	ResolverCommissionKeyPrefix     = []byte{0x07}
+	PendingNodesOfResolverKeyPrefix = []byte{0x08}
 )
 
 func NodeKey(id hub.NodeID) []byte {
@@ -66,6 +95,18 @@ func NodeIDByAddressKey(address sdk.AccAddress, i uint64) []byte {
 		append(address.Bytes(), sdk.Uint64ToBigEndian(i)...)...)
 }
 
+func NodeUnbondingTimeKey(t time.Time) []byte {
+	return append(NodeUnbondingQueueKeyPrefix, sdk.FormatTimeBytes(t)...)
+}
+
+func NodeUnbondingQueueKey(t time.Time, id hub.NodeID) []byte {
+	return append(NodeUnbondingTimeKey(t), id.Bytes()...)
+}
+
+func EvidenceKey(id hub.SessionID) []byte {
+	return append(EvidenceKeyPrefix, id.Bytes()...)
+}
+
 func SubscriptionKey(id hub.SubscriptionID) []byte {
 	return append(SubscriptionKeyPrefix, id.Bytes()...)
 }
@@ -88,6 +129,36 @@ func SubscriptionIDByAddressKey(address sdk.AccAddress, i uint64) []byte {
 		append(address.Bytes(), sdk.Uint64ToBigEndian(i)...)...)
 }
 
+func SubscriptionExpiryTimeKey(t time.Time) []byte {
+	return append(SubscriptionExpiryQueueKeyPrefix, sdk.FormatTimeBytes(t)...)
+}
+
+func SubscriptionExpiryQueueKey(t time.Time, id hub.SubscriptionID) []byte {
+	return append(SubscriptionExpiryTimeKey(t), id.Bytes()...)
+}
+
+func ChannelKey(id hub.ChannelID) []byte {
+	return append(ChannelKeyPrefix, id.Bytes()...)
+}
+
+func ChannelsCountOfNodeKey(id hub.NodeID) []byte {
+	return append(ChannelsCountOfNodeKeyPrefix, id.Bytes()...)
+}
+
+func ChannelIDByNodeIDKey(id hub.NodeID, i uint64) []byte {
+	return append(ChannelIDByNodeIDKeyPrefix,
+		append(id.Bytes(), sdk.Uint64ToBigEndian(i)...)...)
+}
+
+func ChannelsCountOfAddressKey(address sdk.AccAddress) []byte {
+	return append(ChannelsCountOfAddressKeyPrefix, address.Bytes()...)
+}
+
+func ChannelIDByAddressKey(address sdk.AccAddress, i uint64) []byte {
+	return append(ChannelIDByAddressKeyPrefix,
+		append(address.Bytes(), sdk.Uint64ToBigEndian(i)...)...)
+}
+
 func SessionKey(id hub.SessionID) []byte {
 	return append(SessionKeyPrefix, id.Bytes()...)
 }
@@ -101,6 +172,10 @@ func SessionIDBySubscriptionIDKey(id hub.SubscriptionID, i uint64) []byte {
 		append(id.Bytes(), sdk.Uint64ToBigEndian(i)...)...)
 }
 
+func SettlementKey(id hub.SessionID) []byte {
+	return append(SettlementKeyPrefix, id.Bytes()...)
+}
+
 func ActiveNodeIDsKey(height int64) []byte {
 	return sdk.Uint64ToBigEndian(uint64(height))
 }
@@ -109,12 +184,20 @@ func ActiveSessionIDsKey(height int64) []byte {
 	return sdk.Uint64ToBigEndian(uint64(height))
 }
 
-func FreeNodesOfClientKey(client sdk.AccAddress, nodeID hub.NodeID) []byte {
-	return append(FreeNodesOfClientKeyPrefix, append(client.Bytes(), nodeID.Bytes()...)...)
+func FreeNodesOfClientKey(client sdk.AccAddress) []byte {
+	return append(FreeNodesOfClientKeyPrefix, client.Bytes()...)
+}
+
+func FreeNodeOfClientKey(client sdk.AccAddress, nodeID hub.NodeID) []byte {
+	return append(FreeNodesOfClientKey(client), nodeID.Bytes()...)
+}
+
+func FreeClientsOfNodeKey(nodeID hub.NodeID) []byte {
+	return append(FreeClientKeyPrefix, nodeID.Bytes()...)
 }
 
-func FreeClientOfNodeKey(nodeID hub.NodeID, client sdk.AccAddress) []byte {
-	return append(FreeClientOfNodeKeyPrefix, append(nodeID.Bytes(), client.Bytes()...)...)
+func FreeClientKey(nodeID hub.NodeID, client sdk.AccAddress) []byte {
+	return append(FreeClientsOfNodeKey(nodeID), client.Bytes()...)
 }
 
 func ResolverKey(resolverID hub.ResolverID) []byte {
@@ -131,6 +214,26 @@ func ResolverIDByAddressKey(address sdk.AccAddress, count uint64) []byte {
 		sdk.Uint64ToBigEndian(count)...)
 }
 
+func ResolverUnbondingTimeKey(t time.Time) []byte {
+	return append(ResolverUnbondingQueueKeyPrefix, sdk.FormatTimeBytes(t)...)
+}
+
+func ResolverUnbondingQueueKey(t time.Time, id hub.ResolverID) []byte {
+	return append(ResolverUnbondingTimeKey(t), id.Bytes()...)
+}
+
+func ResolverCommissionsKey(id hub.ResolverID) []byte {
+	return append(ResolverCommissionKeyPrefix, id.Bytes()...)
+}
+
+func ResolverCommissionKey(id hub.ResolverID, height int64) []byte {
+	return append(ResolverCommissionsKey(id), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+func PendingNodeOfResolverKey(resolverID hub.ResolverID, nodeID hub.NodeID) []byte {
+	return append(PendingNodesOfResolverKeyPrefix, append(resolverID.Bytes(), nodeID.Bytes()...)...)
+}
+
 func NodeOfResolverKey(resolverID hub.ResolverID, nodeID hub.NodeID) []byte {
 	return append(NodesOfResolverKeyPrefix, append(resolverID.Bytes(), nodeID.Bytes()...)...)
 }
@@ -139,6 +242,7 @@ func ResolverOfNodeKey(nodeID hub.NodeID, resolverID hub.ResolverID) []byte {
 	return append(ResolversOfNodeKeyPrefix, append(nodeID.Bytes(), resolverID.Bytes()...)...)
 }
 
-func GetFreeClientKey(nodeID hub.NodeID) []byte {
-	return append(FreeClientKey, nodeID.Bytes()...)
+// LegacyFreeClientKey is the key of the free client records which were keyed by the node ID only
+func LegacyFreeClientKey(nodeID hub.NodeID) []byte {
+	return append(LegacyFreeClientKeyPrefix, nodeID.Bytes()...)
 }
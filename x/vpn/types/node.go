@@ -3,12 +3,15 @@ package types
 import (
 	"fmt"
 	"sort"
+	"time"
 	
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	
 	hub "github.com/sentinel-official/hub/types"
 )
 
+// Node is a VPN node. MaxConcurrentSessions limits the sessions a subscription to the per-GB pricing of the
+// node can have open at the same time, zero means one.
 type Node struct {
 	ID      hub.NodeID     `json:"id"`
 	Owner   sdk.AccAddress `json:"owner"`
@@ -18,11 +21,20 @@ type Node struct {
 	Version       string        `json:"version"`
 	Moniker       string        `json:"moniker"`
 	PricesPerGB   sdk.Coins     `json:"prices_per_gb"`
+	Plans         []Plan        `json:"plans"`
 	InternetSpeed hub.Bandwidth `json:"internet_speed"`
 	Encryption    string        `json:"encryption"`
 	
-	Status           string `json:"status"`
-	StatusModifiedAt int64  `json:"status_modified_at"`
+	Jailed                  bool      `json:"jailed"`
+	Blacklisted             bool      `json:"blacklisted"`
+	Status                  string    `json:"status"`
+	StatusModifiedAt        int64     `json:"status_modified_at"`
+	UnbondingCompletionTime time.Time `json:"unbonding_completion_time"`
+	MaxConcurrentSessions   uint64    `json:"max_concurrent_sessions"`
+	
+	// Slashed is the part of the deposit slashed since the node was jailed, which has to be topped up
+	// to release the node
+	Slashed *sdk.Coin `json:"slashed,omitempty"`
 }
 
 func (n Node) String() string {
@@ -34,12 +46,18 @@ func (n Node) String() string {
   Version:             %s
   Moniker:             %s
   Price Per GB:        %s
+  Plans:               %d
   Internet Speed:      %s
   Encryption:          %s
+  Jailed:              %t
+  Blacklisted:         %t
   Status:              %s
-  Status Modified At:  %d`, n.ID, n.Owner, n.Deposit, n.Type, n.Version,
-		n.Moniker, n.PricesPerGB, n.InternetSpeed, n.Encryption,
-		n.Status, n.StatusModifiedAt)
+  Status Modified At:  %d
+  Unbonding Completes: %s
+  Concurrent Sessions: %d`, n.ID, n.Owner, n.Deposit, n.Type, n.Version,
+		n.Moniker, n.PricesPerGB, len(n.Plans), n.InternetSpeed, n.Encryption,
+		n.Jailed, n.Blacklisted, n.Status, n.StatusModifiedAt, n.UnbondingCompletionTime,
+		n.perGBPlan(sdk.Coin{}).ConcurrentSessions())
 }
 
 func (n Node) UpdateInfo(_node Node) Node {
@@ -62,6 +80,9 @@ func (n Node) UpdateInfo(_node Node) Node {
 	if _node.Encryption != "" {
 		n.Encryption = _node.Encryption
 	}
+	if _node.MaxConcurrentSessions > 0 {
+		n.MaxConcurrentSessions = _node.MaxConcurrentSessions
+	}
 	
 	return n
 }
@@ -79,23 +100,92 @@ func (n Node) FindPricePerGB(denom string) (coin sdk.Coin) {
 	return n.PricesPerGB[index]
 }
 
-func (n Node) DepositToBandwidth(deposit sdk.Coin) (bandwidth hub.Bandwidth, err sdk.Error) {
-	pricePerGB := n.FindPricePerGB(deposit.Denom)
-	if pricePerGB.Denom == "" || pricePerGB.Amount.IsZero() {
-		return bandwidth, ErrorInvalidDeposit()
+// FindPlan returns the plan at the given position, starting from one. Zero stands for the per-GB pricing
+// of the deposit denomination.
+func (n Node) FindPlan(i uint64, denom string) (plan Plan, found bool) {
+	if i == 0 {
+		pricePerGB := n.FindPricePerGB(denom)
+		if pricePerGB.Denom == "" {
+			return plan, false
+		}
+		
+		return n.perGBPlan(pricePerGB), true
+	}
+	if i > uint64(len(n.Plans)) {
+		return plan, false
+	}
+	
+	return n.Plans[i-1], true
+}
+
+// QuotePlan returns the plan at the given position priced in the denomination, along with the price the node
+// quotes for it. A price which the node does not quote in the denomination is converted at the exchange rates,
+// and the plan is not found if there is no rate to convert it.
+func (n Node) QuotePlan(i uint64, denom string, rates ExchangeRates) (plan Plan, quoted sdk.Coin, found bool) {
+	if i == 0 {
+		if pricePerGB := n.FindPricePerGB(denom); pricePerGB.Denom != "" {
+			return n.perGBPlan(pricePerGB), pricePerGB, true
+		}
+		
+		for _, pricePerGB := range n.PricesPerGB {
+			if price, ok := rates.Convert(pricePerGB, denom); ok {
+				return n.perGBPlan(price), pricePerGB, true
+			}
+		}
+		
+		return plan, quoted, false
+	}
+	
+	plan, found = n.FindPlan(i, denom)
+	if !found {
+		return plan, quoted, false
+	}
+	
+	quoted = plan.Price
+	if plan.Price, found = rates.Convert(plan.Price, denom); !found {
+		return Plan{}, sdk.Coin{}, false
 	}
 	
-	x := deposit.Amount.Mul(hub.MB500).Quo(pricePerGB.Amount)
-	return hub.NewBandwidth(x, x), nil
+	return plan, quoted, true
+}
+
+// QuoteDeposit returns the plan at the given position priced in the first denomination of the deposit which
+// the plan can be quoted in, along with the price the node quotes for it
+func (n Node) QuoteDeposit(i uint64, deposit sdk.Coins, rates ExchangeRates) (plan Plan, quoted sdk.Coin, found bool) {
+	for _, coin := range deposit {
+		if plan, quoted, found = n.QuotePlan(i, coin.Denom, rates); found {
+			return plan, quoted, true
+		}
+	}
+	
+	return plan, quoted, false
+}
+
+// perGBPlan returns the per-GB plan of the node at the price
+func (n Node) perGBPlan(pricePerGB sdk.Coin) Plan {
+	plan := NewPerGBPlan(pricePerGB)
+	plan.MaxConcurrentSessions = n.MaxConcurrentSessions
+	
+	return plan
+}
+
+func (n Node) DepositToBandwidth(deposit sdk.Coin) (bandwidth hub.Bandwidth, err sdk.Error) {
+	return NewPerGBPlan(n.FindPricePerGB(deposit.Denom)).DepositToBandwidth(deposit)
 }
 
 func (n Node) IsValid() error {
+	if n.ID == nil {
+		return fmt.Errorf("invalid id")
+	}
 	if n.Owner == nil || n.Owner.Empty() {
 		return fmt.Errorf("invalid owner")
 	}
 	if n.Deposit.Denom == "" {
 		return fmt.Errorf("invalid deposit")
 	}
+	if n.Slashed != nil && (!n.Jailed || !n.Slashed.IsValid() || n.Slashed.Denom != n.Deposit.Denom) {
+		return fmt.Errorf("invalid slashed")
+	}
 	if n.Type == "" || len(n.Type) < 4 || len(n.Type) > 16 {
 		return fmt.Errorf("invalid type")
 	}
@@ -112,13 +202,18 @@ func (n Node) IsValid() error {
 	if n.InternetSpeed.AnyNil() || !n.InternetSpeed.AllPositive() {
 		return fmt.Errorf("invalid internet speed")
 	}
+	for _, plan := range n.Plans {
+		if err := plan.IsValid(); err != nil {
+			return fmt.Errorf("invalid plans")
+		}
+	}
 	
 	if n.Encryption == "" || len(n.Encryption) < 4 || len(n.Encryption) > 16 {
 		return fmt.Errorf("invalid encryption")
 	}
 	
-	if n.Status != StatusRegistered &&
-		n.Status != StatusDeRegistered {
+	if n.Status != StatusRegistered && n.Status != StatusInactive &&
+		n.Status != StatusUnbonding && n.Status != StatusDeRegistered {
 		return fmt.Errorf("invalid status")
 	}
 	
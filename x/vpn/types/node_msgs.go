@@ -93,6 +93,8 @@ type MsgUpdateNodeInfo struct {
 	PricesPerGB   sdk.Coins      `json:"prices_per_gb"`
 	InternetSpeed hub.Bandwidth  `json:"internet_speed"`
 	Encryption    string         `json:"encryption"`
+	
+	MaxConcurrentSessions uint64 `json:"max_concurrent_sessions"`
 }
 
 func (msg MsgUpdateNodeInfo) Type() string {
@@ -136,25 +138,84 @@ func (msg MsgUpdateNodeInfo) Route() string {
 
 func NewMsgUpdateNodeInfo(from sdk.AccAddress, id hub.NodeID,
 	t, version, moniker string, pricesPerGB sdk.Coins,
-	internetSpeed hub.Bandwidth, encryption string) *MsgUpdateNodeInfo {
+	internetSpeed hub.Bandwidth, encryption string, maxConcurrentSessions uint64) *MsgUpdateNodeInfo {
 	return &MsgUpdateNodeInfo{
-		From:          from,
-		ID:            id,
-		T:             t,
-		Version:       version,
-		Moniker:       moniker,
-		PricesPerGB:   pricesPerGB,
-		InternetSpeed: internetSpeed,
-		Encryption:    encryption,
+		From:                  from,
+		ID:                    id,
+		T:                     t,
+		Version:               version,
+		Moniker:               moniker,
+		PricesPerGB:           pricesPerGB,
+		InternetSpeed:         internetSpeed,
+		Encryption:            encryption,
+		MaxConcurrentSessions: maxConcurrentSessions,
+	}
+}
+
+var _ sdk.Msg = (*MsgUpdateNodePlans)(nil)
+
+type MsgUpdateNodePlans struct {
+	From  sdk.AccAddress `json:"from"`
+	ID    hub.NodeID     `json:"id"`
+	Plans []Plan         `json:"plans"`
+}
+
+func (msg MsgUpdateNodePlans) Type() string {
+	return "update_node_plans"
+}
+
+func (msg MsgUpdateNodePlans) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if msg.ID == nil || len(msg.ID) == 0 {
+		return ErrorInvalidField("id")
+	}
+	for _, plan := range msg.Plans {
+		if err := plan.IsValid(); err != nil {
+			return ErrorInvalidField("plans")
+		}
+	}
+	
+	return nil
+}
+
+func (msg MsgUpdateNodePlans) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	
+	return bz
+}
+
+func (msg MsgUpdateNodePlans) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgUpdateNodePlans) Route() string {
+	return RouterKey
+}
+
+func NewMsgUpdateNodePlans(from sdk.AccAddress, id hub.NodeID, plans []Plan) *MsgUpdateNodePlans {
+	return &MsgUpdateNodePlans{
+		From:  from,
+		ID:    id,
+		Plans: plans,
 	}
 }
 
 var _ sdk.Msg = (*MsgAddFreeClient)(nil)
 
+// MsgAddFreeClient grants the client free use of the node, optionally limited to a bandwidth quota,
+// an expiry height and a maximum number of concurrent subscriptions
 type MsgAddFreeClient struct {
-	From   sdk.AccAddress `json:"from"`
-	NodeID hub.NodeID     `json:"node_id"`
-	Client sdk.AccAddress `json:"client"`
+	From             sdk.AccAddress `json:"from"`
+	NodeID           hub.NodeID     `json:"node_id"`
+	Client           sdk.AccAddress `json:"client"`
+	Bandwidth        hub.Bandwidth  `json:"bandwidth"`
+	ExpiresAt        int64          `json:"expires_at"`
+	MaxSubscriptions uint64         `json:"max_subscriptions"`
 }
 
 func (msg MsgAddFreeClient) Type() string {
@@ -171,6 +232,12 @@ func (msg MsgAddFreeClient) ValidateBasic() sdk.Error {
 	if msg.Client == nil || msg.Client.Empty() {
 		return ErrorInvalidField("client")
 	}
+	if msg.Bandwidth.AnyNil() || msg.Bandwidth.AnyNegative() {
+		return ErrorInvalidField("bandwidth")
+	}
+	if msg.ExpiresAt < 0 {
+		return ErrorInvalidField("expires_at")
+	}
 	
 	return nil
 }
@@ -192,11 +259,15 @@ func (msg MsgAddFreeClient) Route() string {
 	return RouterKey
 }
 
-func NewMsgAddFreeClient(from sdk.AccAddress, nodeID hub.NodeID, client sdk.AccAddress) *MsgAddFreeClient {
+func NewMsgAddFreeClient(from sdk.AccAddress, nodeID hub.NodeID, client sdk.AccAddress,
+	bandwidth hub.Bandwidth, expiresAt int64, maxSubscriptions uint64) *MsgAddFreeClient {
 	return &MsgAddFreeClient{
-		From:   from,
-		NodeID: nodeID,
-		Client: client,
+		From:             from,
+		NodeID:           nodeID,
+		Client:           client,
+		Bandwidth:        bandwidth,
+		ExpiresAt:        expiresAt,
+		MaxSubscriptions: maxSubscriptions,
 	}
 }
 
@@ -253,6 +324,7 @@ func NewMsgRemoveFreeClient(from sdk.AccAddress, nodeID hub.NodeID, client sdk.A
 
 var _ sdk.Msg = (*MsgRegisterVPNOnResolver)(nil)
 
+// MsgRegisterVPNOnResolver requests a listing of the node on the resolver, which the resolver owner accepts or rejects
 type MsgRegisterVPNOnResolver struct {
 	From       sdk.AccAddress `json:"from"`
 	NodeID     hub.NodeID     `json:"node_id"`
@@ -305,6 +377,7 @@ func NewMsgRegisterVPNOnResolver(from sdk.AccAddress, nodeID hub.NodeID,
 
 var _ sdk.Msg = (*MsgDeregisterVPNOnResolver)(nil)
 
+// MsgDeregisterVPNOnResolver revokes a listing of the node on the resolver, sent by either of the owners
 type MsgDeregisterVPNOnResolver struct {
 	From       sdk.AccAddress `json:"from"`
 	NodeID     hub.NodeID     `json:"node_id"`
@@ -397,3 +470,100 @@ func NewMsgDeregisterNode(from sdk.AccAddress, id hub.NodeID) *MsgDeregisterNode
 		ID:   id,
 	}
 }
+
+var _ sdk.Msg = (*MsgNodeHeartbeat)(nil)
+
+type MsgNodeHeartbeat struct {
+	From sdk.AccAddress `json:"from"`
+	ID   hub.NodeID     `json:"id"`
+}
+
+func (msg MsgNodeHeartbeat) Type() string {
+	return "node_heartbeat"
+}
+
+func (msg MsgNodeHeartbeat) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if msg.ID == nil {
+		return ErrorInvalidField("id")
+	}
+	
+	return nil
+}
+
+func (msg MsgNodeHeartbeat) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	
+	return bz
+}
+
+func (msg MsgNodeHeartbeat) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgNodeHeartbeat) Route() string {
+	return RouterKey
+}
+
+func NewMsgNodeHeartbeat(from sdk.AccAddress, id hub.NodeID) *MsgNodeHeartbeat {
+	return &MsgNodeHeartbeat{
+		From: from,
+		ID:   id,
+	}
+}
+
+var _ sdk.Msg = (*MsgTopUpNodeDeposit)(nil)
+
+type MsgTopUpNodeDeposit struct {
+	From    sdk.AccAddress `json:"from"`
+	ID      hub.NodeID     `json:"id"`
+	Deposit sdk.Coin       `json:"deposit"`
+}
+
+func (msg MsgTopUpNodeDeposit) Type() string {
+	return "top_up_node_deposit"
+}
+
+func (msg MsgTopUpNodeDeposit) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if msg.ID == nil {
+		return ErrorInvalidField("id")
+	}
+	if msg.Deposit.Denom == "" || !msg.Deposit.IsValid() || !msg.Deposit.IsPositive() {
+		return ErrorInvalidField("deposit")
+	}
+	
+	return nil
+}
+
+func (msg MsgTopUpNodeDeposit) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	
+	return bz
+}
+
+func (msg MsgTopUpNodeDeposit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgTopUpNodeDeposit) Route() string {
+	return RouterKey
+}
+
+func NewMsgTopUpNodeDeposit(from sdk.AccAddress, id hub.NodeID, deposit sdk.Coin) *MsgTopUpNodeDeposit {
+	return &MsgTopUpNodeDeposit{
+		From:    from,
+		ID:      id,
+		Deposit: deposit,
+	}
+}
@@ -115,55 +115,55 @@ func TestMsgUpdateNodeInfo_ValidateBasic(t *testing.T) {
 	}{
 		{
 			"from is nil",
-			NewMsgUpdateNodeInfo(nil, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption"),
+			NewMsgUpdateNodeInfo(nil, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", 0),
 			ErrorInvalidField("from"),
 		}, {
 			"from is empty",
-			NewMsgUpdateNodeInfo([]byte(""), hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption"),
+			NewMsgUpdateNodeInfo([]byte(""), hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", 0),
 			ErrorInvalidField("from"),
 		}, {
 			"node_moniker length is greater than 128",
-			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", strings.Repeat("X", 130), sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption"),
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", strings.Repeat("X", 130), sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", 0),
 			ErrorInvalidField("moniker"),
 		}, {
 			"prices_per_gb is nil",
-			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", nil, TestBandwidthPos1, "encryption"),
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", nil, TestBandwidthPos1, "encryption", 0),
 			nil,
 		}, {
 			"prices_per_gb is empty",
-			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{}, TestBandwidthPos1, "encryption"),
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{}, TestBandwidthPos1, "encryption", 0),
 			ErrorInvalidField("prices_per_gb"),
 		}, {
 			"prices_per_gb is negative",
-			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.Coin{"stake", sdk.NewInt(-100)}}, TestBandwidthPos1, "encryption"),
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.Coin{"stake", sdk.NewInt(-100)}}, TestBandwidthPos1, "encryption", 0),
 			ErrorInvalidField("prices_per_gb"),
 		}, {
 			"prices_per_gb is zero",
-			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 0)}, TestBandwidthPos1, "encryption"),
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 0)}, TestBandwidthPos1, "encryption", 0),
 			ErrorInvalidField("prices_per_gb"),
 		}, {
 			"internet_speed is zero",
-			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthZero, "encryption"),
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthZero, "encryption", 0),
 			nil,
 		}, {
 			"internet_speed is negative",
-			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthNeg, "encryption"),
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthNeg, "encryption", 0),
 			ErrorInvalidField("internet_speed"),
 		}, {
 			"encryption is empty",
-			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, ""),
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "", 0),
 			nil,
 		}, {
 			"type is empty",
-			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption"),
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", 0),
 			nil,
 		}, {
 			"version is empty",
-			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption"),
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", 0),
 			nil,
 		}, {
 			"valid",
-			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption"),
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", 0),
 			nil,
 		},
 	}
@@ -178,7 +178,7 @@ func TestMsgUpdateNodeInfo_ValidateBasic(t *testing.T) {
 }
 
 func TestMsgUpdateNode_GetSignBytes(t *testing.T) {
-	msg := NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption")
+	msg := NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", 0)
 	msgBytes, err := json.Marshal(msg)
 	if err != nil {
 		panic(err)
@@ -188,17 +188,17 @@ func TestMsgUpdateNode_GetSignBytes(t *testing.T) {
 }
 
 func TestMsgUpdateNode_GetSigners(t *testing.T) {
-	msg := NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption")
+	msg := NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", 0)
 	require.Equal(t, []sdk.AccAddress{TestAddress1}, msg.GetSigners())
 }
 
 func TestMsgUpdateNode_Type(t *testing.T) {
-	msg := NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption")
+	msg := NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", 0)
 	require.Equal(t, "update_node_info", msg.Type())
 }
 
 func TestMsgUpdateNode_Route(t *testing.T) {
-	msg := NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption")
+	msg := NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", 0)
 	require.Equal(t, RouterKey, msg.Route())
 }
 
@@ -210,27 +210,39 @@ func TestMsgAddFreeClient_ValidateBasic(t *testing.T) {
 	}{
 		{
 			"from is nil",
-			NewMsgAddFreeClient(nil, hub.NewNodeID(1), TestAddress1),
+			NewMsgAddFreeClient(nil, hub.NewNodeID(1), TestAddress1, TestBandwidthZero, 0, 0),
 			ErrorInvalidField("from"),
 		}, {
 			"from is empty",
-			NewMsgAddFreeClient([]byte(""), hub.NewNodeID(1), TestAddress1),
+			NewMsgAddFreeClient([]byte(""), hub.NewNodeID(1), TestAddress1, TestBandwidthZero, 0, 0),
 			ErrorInvalidField("from"),
 		}, {
 			"node_id is nil",
-			NewMsgAddFreeClient(TestAddress1, nil, TestAddress1),
+			NewMsgAddFreeClient(TestAddress1, nil, TestAddress1, TestBandwidthZero, 0, 0),
 			ErrorInvalidField("node_id"),
 		}, {
 			"client is nil",
-			NewMsgAddFreeClient(TestAddress1, hub.NewNodeID(1), nil),
+			NewMsgAddFreeClient(TestAddress1, hub.NewNodeID(1), nil, TestBandwidthZero, 0, 0),
 			ErrorInvalidField("client"),
 		}, {
 			"client is empty",
-			NewMsgAddFreeClient(TestAddress1, hub.NewNodeID(1), []byte("")),
+			NewMsgAddFreeClient(TestAddress1, hub.NewNodeID(1), []byte(""), TestBandwidthZero, 0, 0),
 			ErrorInvalidField("client"),
+		}, {
+			"bandwidth is negative",
+			NewMsgAddFreeClient(TestAddress1, hub.NewNodeID(1), TestAddress1, TestBandwidthNeg, 0, 0),
+			ErrorInvalidField("bandwidth"),
+		}, {
+			"expires_at is negative",
+			NewMsgAddFreeClient(TestAddress1, hub.NewNodeID(1), TestAddress1, TestBandwidthZero, -1, 0),
+			ErrorInvalidField("expires_at"),
 		}, {
 			"valid",
-			NewMsgAddFreeClient(TestAddress1, hub.NewNodeID(1), TestAddress1),
+			NewMsgAddFreeClient(TestAddress1, hub.NewNodeID(1), TestAddress1, TestBandwidthZero, 0, 0),
+			nil,
+		}, {
+			"valid with limits",
+			NewMsgAddFreeClient(TestAddress1, hub.NewNodeID(1), TestAddress1, TestBandwidthPos1, 100, 1),
 			nil,
 		},
 	}
@@ -245,7 +257,7 @@ func TestMsgAddFreeClient_ValidateBasic(t *testing.T) {
 }
 
 func TestMsgAddFreeClient_GetSignBytes(t *testing.T) {
-	msg := NewMsgAddFreeClient(TestAddress1, hub.NewNodeID(1), TestAddress1)
+	msg := NewMsgAddFreeClient(TestAddress1, hub.NewNodeID(1), TestAddress1, TestBandwidthZero, 0, 0)
 	msgBytes, err := json.Marshal(msg)
 	if err != nil {
 		panic(err)
@@ -255,17 +267,17 @@ func TestMsgAddFreeClient_GetSignBytes(t *testing.T) {
 }
 
 func TestMsgAddFreeClient_GetSigners(t *testing.T) {
-	msg := NewMsgAddFreeClient(TestAddress1, hub.NewNodeID(1), TestAddress1)
+	msg := NewMsgAddFreeClient(TestAddress1, hub.NewNodeID(1), TestAddress1, TestBandwidthZero, 0, 0)
 	require.Equal(t, []sdk.AccAddress{TestAddress1}, msg.GetSigners())
 }
 
 func TestMsgAddFreeClient_Type(t *testing.T) {
-	msg := NewMsgAddFreeClient(TestAddress1, hub.NewNodeID(1), TestAddress1)
+	msg := NewMsgAddFreeClient(TestAddress1, hub.NewNodeID(1), TestAddress1, TestBandwidthZero, 0, 0)
 	require.Equal(t, "add_free_client", msg.Type())
 }
 
 func TestMsgAddFreeClient_Route(t *testing.T) {
-	msg := NewMsgAddFreeClient(TestAddress1, hub.NewNodeID(1), TestAddress1)
+	msg := NewMsgAddFreeClient(TestAddress1, hub.NewNodeID(1), TestAddress1, TestBandwidthZero, 0, 0)
 	require.Equal(t, RouterKey, msg.Route())
 }
 
@@ -516,3 +528,115 @@ func TestMsgDeregisterNode_Route(t *testing.T) {
 	msg := NewMsgDeregisterNode(TestAddress1, hub.NewNodeID(1))
 	require.Equal(t, RouterKey, msg.Route())
 }
+
+func TestMsgNodeHeartbeat_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgNodeHeartbeat
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgNodeHeartbeat(nil, hub.NewNodeID(1)),
+			ErrorInvalidField("from"),
+		}, {
+			"from is empty",
+			NewMsgNodeHeartbeat([]byte(""), hub.NewNodeID(1)),
+			ErrorInvalidField("from"),
+		}, {
+			"id is nil",
+			NewMsgNodeHeartbeat(TestAddress1, nil),
+			ErrorInvalidField("id"),
+		}, {
+			"valid",
+			NewMsgNodeHeartbeat(TestAddress1, hub.NewNodeID(1)),
+			nil,
+		},
+	}
+	
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMsgUpdateNodePlans_ValidateBasic(t *testing.T) {
+	plan := Plan{Type: PlanTypePerDay, Price: sdk.NewInt64Coin("stake", 100)}
+	
+	tests := []struct {
+		name string
+		msg  *MsgUpdateNodePlans
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgUpdateNodePlans(nil, hub.NewNodeID(1), []Plan{plan}),
+			ErrorInvalidField("from"),
+		}, {
+			"id is nil",
+			NewMsgUpdateNodePlans(TestAddress1, nil, []Plan{plan}),
+			ErrorInvalidField("id"),
+		}, {
+			"plan is invalid",
+			NewMsgUpdateNodePlans(TestAddress1, hub.NewNodeID(1), []Plan{{Type: PlanTypePerDay}}),
+			ErrorInvalidField("plans"),
+		}, {
+			"plans are empty",
+			NewMsgUpdateNodePlans(TestAddress1, hub.NewNodeID(1), nil),
+			nil,
+		}, {
+			"valid",
+			NewMsgUpdateNodePlans(TestAddress1, hub.NewNodeID(1), []Plan{plan}),
+			nil,
+		},
+	}
+	
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMsgTopUpNodeDeposit_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgTopUpNodeDeposit
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgTopUpNodeDeposit(nil, hub.NewNodeID(1), sdk.NewInt64Coin("stake", 10)),
+			ErrorInvalidField("from"),
+		}, {
+			"id is nil",
+			NewMsgTopUpNodeDeposit(TestAddress1, nil, sdk.NewInt64Coin("stake", 10)),
+			ErrorInvalidField("id"),
+		}, {
+			"deposit is empty",
+			NewMsgTopUpNodeDeposit(TestAddress1, hub.NewNodeID(1), sdk.Coin{}),
+			ErrorInvalidField("deposit"),
+		}, {
+			"deposit is zero",
+			NewMsgTopUpNodeDeposit(TestAddress1, hub.NewNodeID(1), sdk.NewInt64Coin("stake", 0)),
+			ErrorInvalidField("deposit"),
+		}, {
+			"valid",
+			NewMsgTopUpNodeDeposit(TestAddress1, hub.NewNodeID(1), sdk.NewInt64Coin("stake", 10)),
+			nil,
+		},
+	}
+	
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -103,6 +103,51 @@ func TestNode_FindPricePerGB(t *testing.T) {
 	require.Equal(t, node.FindPricePerGB("stake"), sdk.NewInt64Coin("stake", 100))
 }
 
+func TestNode_QuotePlan(t *testing.T) {
+	node := Node{
+		PricesPerGB: sdk.Coins{sdk.NewInt64Coin("stake", 100)},
+		Plans:       []Plan{{Type: PlanTypePerHour, Price: sdk.NewInt64Coin("stake", 30)}},
+	}
+	rates := ExchangeRates{NewExchangeRate("atom", sdk.NewDecWithPrec(5, 1)), NewExchangeRate("stake", sdk.NewDec(2))}
+	
+	plan, quoted, found := node.QuotePlan(0, "stake", nil)
+	require.True(t, found)
+	require.Equal(t, NewPerGBPlan(sdk.NewInt64Coin("stake", 100)), plan)
+	require.Equal(t, sdk.NewInt64Coin("stake", 100), quoted)
+	
+	_, _, found = node.QuotePlan(0, "atom", nil)
+	require.False(t, found)
+	
+	node.MaxConcurrentSessions = 2
+	plan, _, _ = node.QuotePlan(0, "stake", nil)
+	require.Equal(t, uint64(2), plan.ConcurrentSessions())
+	node.MaxConcurrentSessions = 0
+	
+	plan, quoted, found = node.QuotePlan(0, "atom", rates)
+	require.True(t, found)
+	require.Equal(t, NewPerGBPlan(sdk.NewInt64Coin("atom", 400)), plan)
+	require.Equal(t, sdk.NewInt64Coin("stake", 100), quoted)
+	
+	plan, quoted, found = node.QuotePlan(1, "stake", nil)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt64Coin("stake", 30), plan.Price)
+	require.Equal(t, sdk.NewInt64Coin("stake", 30), quoted)
+	
+	_, _, found = node.QuotePlan(1, "atom", nil)
+	require.False(t, found)
+	
+	_, _, found = node.QuotePlan(1, "atom", ExchangeRates{NewExchangeRate("atom", sdk.NewDecWithPrec(5, 1))})
+	require.False(t, found)
+	
+	plan, quoted, found = node.QuotePlan(1, "atom", rates)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt64Coin("atom", 120), plan.Price)
+	require.Equal(t, sdk.NewInt64Coin("stake", 30), quoted)
+	
+	_, _, found = node.QuotePlan(2, "stake", rates)
+	require.False(t, found)
+}
+
 func TestNode_DepositToBandwidth(t *testing.T) {
 	node := Node{
 		PricesPerGB: sdk.Coins{sdk.NewInt64Coin("stake", 100)},
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 	
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
@@ -9,38 +10,83 @@ import (
 )
 
 var (
-	DefaultFreeNodesCount          uint64 = 5
-	DefaultDeposit                        = sdk.NewInt64Coin("stake", 100)
-	DefaultSessionInactiveInterval int64  = 25
+	DefaultFreeNodesCount           uint64 = 5
+	DefaultDeposit                         = sdk.NewInt64Coin("stake", 100)
+	DefaultSessionInactiveInterval  int64  = 25
+	DefaultNodeInactiveInterval     int64  = 100
+	DefaultSlashFraction                   = sdk.NewDecWithPrec(1, 1)
+	DefaultNodeUnbondingPeriod             = 7 * 24 * time.Hour
+	DefaultResolverUnbondingPeriod         = 7 * 24 * time.Hour
+	DefaultMaxPricesPerGB           sdk.Coins
+	DefaultResolverMinDeposit             = sdk.NewInt64Coin("stake", 100)
+	DefaultCommissionChangeInterval int64 = 100
+	DefaultExchangeRates            ExchangeRates
 )
 
 var (
-	KeyFreeNodesCount          = []byte("FreeNodesCount")
-	KeyDeposit                 = []byte("Deposit")
-	KeySessionInactiveInterval = []byte("SessionInactiveInterval")
+	KeyFreeNodesCount           = []byte("FreeNodesCount")
+	KeyDeposit                  = []byte("Deposit")
+	KeySessionInactiveInterval  = []byte("SessionInactiveInterval")
+	KeyNodeInactiveInterval     = []byte("NodeInactiveInterval")
+	KeySlashFraction            = []byte("SlashFraction")
+	KeyNodeUnbondingPeriod      = []byte("NodeUnbondingPeriod")
+	KeyResolverUnbondingPeriod  = []byte("ResolverUnbondingPeriod")
+	KeyMaxPricesPerGB           = []byte("MaxPricesPerGB")
+	KeyResolverMinDeposit       = []byte("ResolverMinDeposit")
+	KeyCommissionChangeInterval = []byte("CommissionChangeInterval")
+	KeyExchangeRates            = []byte("ExchangeRates")
 )
 
 var _ params.ParamSet = (*Params)(nil)
 
 type Params struct {
-	FreeNodesCount          uint64   `json:"free_nodes_count"`
-	Deposit                 sdk.Coin `json:"deposit"`
-	SessionInactiveInterval int64    `json:"session_inactive_interval"`
+	FreeNodesCount           uint64        `json:"free_nodes_count"`
+	Deposit                  sdk.Coin      `json:"deposit"`
+	SessionInactiveInterval  int64         `json:"session_inactive_interval"`
+	NodeInactiveInterval     int64         `json:"node_inactive_interval"`
+	SlashFraction            sdk.Dec       `json:"slash_fraction"`
+	NodeUnbondingPeriod      time.Duration `json:"node_unbonding_period"`
+	ResolverUnbondingPeriod  time.Duration `json:"resolver_unbonding_period"`
+	MaxPricesPerGB           sdk.Coins     `json:"max_prices_per_gb"`
+	ResolverMinDeposit       sdk.Coin      `json:"resolver_min_deposit"`
+	CommissionChangeInterval int64         `json:"commission_change_interval"`
+	ExchangeRates            ExchangeRates `json:"exchange_rates"`
 }
 
-func NewParams(freeNodesCount uint64, deposit sdk.Coin, sessionInactiveInterval int64) Params {
+func NewParams(freeNodesCount uint64, deposit sdk.Coin,
+	sessionInactiveInterval, nodeInactiveInterval int64, slashFraction sdk.Dec,
+	nodeUnbondingPeriod, resolverUnbondingPeriod time.Duration, maxPricesPerGB sdk.Coins,
+	resolverMinDeposit sdk.Coin, commissionChangeInterval int64, exchangeRates ExchangeRates) Params {
 	return Params{
-		FreeNodesCount:          freeNodesCount,
-		Deposit:                 deposit,
-		SessionInactiveInterval: sessionInactiveInterval,
+		FreeNodesCount:           freeNodesCount,
+		Deposit:                  deposit,
+		SessionInactiveInterval:  sessionInactiveInterval,
+		NodeInactiveInterval:     nodeInactiveInterval,
+		SlashFraction:            slashFraction,
+		NodeUnbondingPeriod:      nodeUnbondingPeriod,
+		ResolverUnbondingPeriod:  resolverUnbondingPeriod,
+		MaxPricesPerGB:           maxPricesPerGB,
+		ResolverMinDeposit:       resolverMinDeposit,
+		CommissionChangeInterval: commissionChangeInterval,
+		ExchangeRates:            exchangeRates,
 	}
 }
 
 func (p Params) String() string {
 	return fmt.Sprintf(`Params
-  Free Nodes Count:          %d
-  Deposit:                   %s
-  Session Inactive Interval: %d`, p.FreeNodesCount, p.Deposit, p.SessionInactiveInterval)
+  Free Nodes Count:           %d
+  Deposit:                    %s
+  Session Inactive Interval:  %d
+  Node Inactive Interval:     %d
+  Slash Fraction:             %s
+  Node Unbonding Period:      %s
+  Resolver Unbonding Period:  %s
+  Max Prices Per GB:          %s
+  Resolver Min Deposit:       %s
+  Commission Change Interval: %d
+  Exchange Rates:             %s`, p.FreeNodesCount, p.Deposit, p.SessionInactiveInterval,
+		p.NodeInactiveInterval, p.SlashFraction, p.NodeUnbondingPeriod, p.ResolverUnbondingPeriod, p.MaxPricesPerGB,
+		p.ResolverMinDeposit, p.CommissionChangeInterval, p.ExchangeRates)
 }
 
 func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
@@ -48,14 +94,30 @@ func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 		{Key: KeyFreeNodesCount, Value: &p.FreeNodesCount},
 		{Key: KeyDeposit, Value: &p.Deposit},
 		{Key: KeySessionInactiveInterval, Value: &p.SessionInactiveInterval},
+		{Key: KeyNodeInactiveInterval, Value: &p.NodeInactiveInterval},
+		{Key: KeySlashFraction, Value: &p.SlashFraction},
+		{Key: KeyNodeUnbondingPeriod, Value: &p.NodeUnbondingPeriod},
+		{Key: KeyResolverUnbondingPeriod, Value: &p.ResolverUnbondingPeriod},
+		{Key: KeyMaxPricesPerGB, Value: &p.MaxPricesPerGB},
+		{Key: KeyResolverMinDeposit, Value: &p.ResolverMinDeposit},
+		{Key: KeyCommissionChangeInterval, Value: &p.CommissionChangeInterval},
+		{Key: KeyExchangeRates, Value: &p.ExchangeRates},
 	}
 }
 
 func DefaultParams() Params {
 	return Params{
-		FreeNodesCount:          DefaultFreeNodesCount,
-		Deposit:                 DefaultDeposit,
-		SessionInactiveInterval: DefaultSessionInactiveInterval,
+		FreeNodesCount:           DefaultFreeNodesCount,
+		Deposit:                  DefaultDeposit,
+		SessionInactiveInterval:  DefaultSessionInactiveInterval,
+		NodeInactiveInterval:     DefaultNodeInactiveInterval,
+		SlashFraction:            DefaultSlashFraction,
+		NodeUnbondingPeriod:      DefaultNodeUnbondingPeriod,
+		ResolverUnbondingPeriod:  DefaultResolverUnbondingPeriod,
+		MaxPricesPerGB:           DefaultMaxPricesPerGB,
+		ResolverMinDeposit:       DefaultResolverMinDeposit,
+		CommissionChangeInterval: DefaultCommissionChangeInterval,
+		ExchangeRates:            DefaultExchangeRates,
 	}
 }
 
@@ -66,6 +128,47 @@ func (p Params) Validate() error {
 	if p.SessionInactiveInterval < 0 {
 		return fmt.Errorf("SessionInactiveInterval: %d should be positive interger", p.SessionInactiveInterval)
 	}
+	if p.NodeInactiveInterval <= 0 {
+		return fmt.Errorf("NodeInactiveInterval: %d should be positive interger", p.NodeInactiveInterval)
+	}
+	if p.SlashFraction.IsNil() || p.SlashFraction.IsNegative() || p.SlashFraction.GT(sdk.OneDec()) {
+		return fmt.Errorf("SlashFraction: %s should be between 0 and 1", p.SlashFraction)
+	}
+	if p.NodeUnbondingPeriod < 0 {
+		return fmt.Errorf("NodeUnbondingPeriod: %s should be positive", p.NodeUnbondingPeriod)
+	}
+	if p.ResolverUnbondingPeriod < 0 {
+		return fmt.Errorf("ResolverUnbondingPeriod: %s should be positive", p.ResolverUnbondingPeriod)
+	}
+	if !p.MaxPricesPerGB.IsValid() {
+		return fmt.Errorf("MaxPricesPerGB: %s is invalid", p.MaxPricesPerGB)
+	}
+	if !p.ResolverMinDeposit.IsValid() {
+		return fmt.Errorf("ResolverMinDeposit: %s is invalid", p.ResolverMinDeposit)
+	}
+	if p.CommissionChangeInterval < 0 {
+		return fmt.Errorf("CommissionChangeInterval: %d should be positive interger", p.CommissionChangeInterval)
+	}
+	if err := p.ExchangeRates.Validate(); err != nil {
+		return fmt.Errorf("ExchangeRates: %s", err)
+	}
 	
 	return nil
 }
+
+// ExceedsMaxPricesPerGB reports whether any of the prices is above the maximum of its denomination.
+// When a maximum is set, prices in a denomination without a maximum are not allowed.
+func (p Params) ExceedsMaxPricesPerGB(prices sdk.Coins) bool {
+	if len(p.MaxPricesPerGB) == 0 {
+		return false
+	}
+	
+	for _, price := range prices {
+		max := p.MaxPricesPerGB.AmountOf(price.Denom)
+		if max.IsZero() || price.Amount.GT(max) {
+			return true
+		}
+	}
+	
+	return false
+}
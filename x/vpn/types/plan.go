@@ -0,0 +1,127 @@
+package types
+
+import (
+	"fmt"
+	"time"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	hub "github.com/sentinel-official/hub/types"
+)
+
+const (
+	PlanTypePerGB    = "PER_GB"
+	PlanTypePerHour  = "PER_HOUR"
+	PlanTypePerDay   = "PER_DAY"
+	PlanTypeFlatRate = "FLAT_RATE"
+)
+
+// MaxSubscriptionDuration is the longest time a subscription to a time based or flat-rate plan can run
+const MaxSubscriptionDuration = 100 * 365 * 24 * time.Hour
+
+// Plan is a pricing plan published by a node. Time based plans charge the price for every started
+// hour or day, flat-rate plans charge the price once for the duration and cap the bandwidth.
+// MaxConcurrentSessions limits the sessions a subscription can have open at the same time, zero means one.
+type Plan struct {
+	Type                  string        `json:"type"`
+	Price                 sdk.Coin      `json:"price"`
+	Bandwidth             hub.Bandwidth `json:"bandwidth"`
+	Duration              time.Duration `json:"duration"`
+	MaxConcurrentSessions uint64        `json:"max_concurrent_sessions"`
+}
+
+func NewPerGBPlan(pricePerGB sdk.Coin) Plan {
+	return Plan{
+		Type:      PlanTypePerGB,
+		Price:     pricePerGB,
+		Bandwidth: hub.NewBandwidthFromInt64(0, 0),
+	}
+}
+
+func (p Plan) String() string {
+	return fmt.Sprintf(`Plan
+  Type:                %s
+  Price:               %s
+  Bandwidth:           %s
+  Duration:            %s
+  Concurrent Sessions: %d`, p.Type, p.Price, p.Bandwidth, p.Duration, p.ConcurrentSessions())
+}
+
+// IsPerGB reports whether the plan charges for the bandwidth. The subscriptions which started before the plans
+// existed have no plan type and are billed per GB.
+func (p Plan) IsPerGB() bool {
+	return p.Type == PlanTypePerGB || p.Type == ""
+}
+
+func (p Plan) IsTimeBased() bool {
+	return p.Type == PlanTypePerHour || p.Type == PlanTypePerDay
+}
+
+// ConcurrentSessions returns the number of sessions a subscription to the plan can have open at the same time
+func (p Plan) ConcurrentSessions() uint64 {
+	if p.MaxConcurrentSessions == 0 {
+		return 1
+	}
+	
+	return p.MaxConcurrentSessions
+}
+
+// Period returns the time bought by paying the price of the plan once
+func (p Plan) Period() time.Duration {
+	switch p.Type {
+	case PlanTypePerHour:
+		return time.Hour
+	case PlanTypePerDay:
+		return 24 * time.Hour
+	case PlanTypeFlatRate:
+		return p.Duration
+	default:
+		return 0
+	}
+}
+
+// Periods returns the number of whole periods the deposit buys. It fails when the periods run longer than
+// MaxSubscriptionDuration, so that the duration of the periods can not overflow.
+func (p Plan) Periods(deposit sdk.Coin) (int64, sdk.Error) {
+	if p.Period() <= 0 || p.Price.Denom != deposit.Denom || !p.Price.IsPositive() {
+		return 0, ErrorInvalidDeposit()
+	}
+	
+	periods := deposit.Amount.Quo(p.Price.Amount)
+	if periods.GT(sdk.NewInt(int64(MaxSubscriptionDuration / p.Period()))) {
+		return 0, ErrorDurationExceedsMax()
+	}
+	
+	return periods.Int64(), nil
+}
+
+// DepositToBandwidth returns the bandwidth bought with the deposit at the price of a per-GB plan
+func (p Plan) DepositToBandwidth(deposit sdk.Coin) (bandwidth hub.Bandwidth, err sdk.Error) {
+	if p.Price.Denom == "" || p.Price.Denom != deposit.Denom || p.Price.Amount.IsZero() {
+		return bandwidth, ErrorInvalidDeposit()
+	}
+	
+	x := deposit.Amount.Mul(hub.MB500).Quo(p.Price.Amount)
+	return hub.NewBandwidth(x, x), nil
+}
+
+func (p Plan) IsValid() error {
+	switch p.Type {
+	case PlanTypePerHour, PlanTypePerDay:
+	case PlanTypeFlatRate:
+		if p.Bandwidth.AnyNil() || !p.Bandwidth.AllPositive() {
+			return fmt.Errorf("invalid bandwidth")
+		}
+		if p.Duration <= 0 || p.Duration > MaxSubscriptionDuration {
+			return fmt.Errorf("invalid duration")
+		}
+	default:
+		return fmt.Errorf("invalid type")
+	}
+	
+	if p.Price.Denom == "" || !p.Price.IsPositive() {
+		return fmt.Errorf("invalid price")
+	}
+	
+	return nil
+}
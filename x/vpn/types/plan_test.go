@@ -0,0 +1,144 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+	"time"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlan_IsValid(t *testing.T) {
+	tests := []struct {
+		name string
+		plan Plan
+		want bool
+	}{
+		{
+			"type is empty",
+			Plan{Price: sdk.NewInt64Coin("stake", 10)},
+			false,
+		}, {
+			"type is per gb",
+			NewPerGBPlan(sdk.NewInt64Coin("stake", 10)),
+			false,
+		}, {
+			"price is empty",
+			Plan{Type: PlanTypePerHour},
+			false,
+		}, {
+			"price is zero",
+			Plan{Type: PlanTypePerDay, Price: sdk.NewInt64Coin("stake", 0)},
+			false,
+		}, {
+			"per hour",
+			Plan{Type: PlanTypePerHour, Price: sdk.NewInt64Coin("stake", 10)},
+			true,
+		}, {
+			"flat rate without bandwidth",
+			Plan{Type: PlanTypeFlatRate, Price: sdk.NewInt64Coin("stake", 10), Duration: time.Hour},
+			false,
+		}, {
+			"flat rate without duration",
+			Plan{Type: PlanTypeFlatRate, Price: sdk.NewInt64Coin("stake", 10), Bandwidth: TestBandwidthPos1},
+			false,
+		}, {
+			"flat rate above the maximum duration",
+			Plan{Type: PlanTypeFlatRate, Price: sdk.NewInt64Coin("stake", 10), Bandwidth: TestBandwidthPos1,
+				Duration: MaxSubscriptionDuration + time.Hour},
+			false,
+		}, {
+			"flat rate",
+			Plan{Type: PlanTypeFlatRate, Price: sdk.NewInt64Coin("stake", 10), Bandwidth: TestBandwidthPos1, Duration: time.Hour},
+			true,
+		},
+	}
+	
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.plan.IsValid() == nil; !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSubscription_UnusedDeposit(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	
+	subscription := TestSubscription
+	require.Equal(t, subscription.RemainingDeposit, subscription.UnusedDeposit(now))
+	
+	subscription.Plan = Plan{Type: PlanTypePerHour, Price: sdk.NewInt64Coin("stake", 30)}
+	subscription.ExpiresAt = now.Add(3 * time.Hour)
+	require.Equal(t, false, subscription.IsExpired(now))
+	require.Equal(t, sdk.NewInt64Coin("stake", 100), subscription.UnusedDeposit(now))
+	require.Equal(t, sdk.NewInt64Coin("stake", 40), subscription.UnusedDeposit(now.Add(90*time.Minute)))
+	require.Equal(t, true, subscription.IsExpired(subscription.ExpiresAt))
+	require.Equal(t, sdk.NewInt64Coin("stake", 10), subscription.UnusedDeposit(subscription.ExpiresAt))
+	
+	subscription.Plan = Plan{Type: PlanTypeFlatRate, Price: sdk.NewInt64Coin("stake", 80),
+		Bandwidth: TestBandwidthPos1, Duration: time.Hour}
+	require.Equal(t, sdk.NewInt64Coin("stake", 20), subscription.UnusedDeposit(now))
+}
+
+func TestSubscription_Draw(t *testing.T) {
+	subscription := TestSubscription
+	subscription.Deposits = sdk.Coins{sdk.NewInt64Coin("atom", 400), sdk.NewInt64Coin("stake", 50)}
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 30)}, subscription.Draw(sdk.NewInt64Coin("stake", 30)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 50)}, subscription.Draw(sdk.NewInt64Coin("stake", 80)))
+	
+	subscription.ExchangeRates = ExchangeRates{NewExchangeRate("atom", sdk.NewDecWithPrec(5, 1)), NewExchangeRate("stake", sdk.NewDec(2))}
+	require.Nil(t, subscription.IsValid())
+	require.Equal(t, sdk.Coins{}, subscription.Draw(sdk.NewInt64Coin("stake", 0)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("atom", 120), sdk.NewInt64Coin("stake", 50)},
+		subscription.Draw(sdk.NewInt64Coin("stake", 80)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("atom", 400), sdk.NewInt64Coin("stake", 50)},
+		subscription.Draw(sdk.NewInt64Coin("stake", 200)))
+}
+
+func TestSubscription_IsValid_LegacyPlan(t *testing.T) {
+	subscription := TestSubscription
+	subscription.Plan = Plan{}
+	require.Nil(t, subscription.IsValid())
+	require.Equal(t, true, subscription.Plan.IsPerGB())
+	require.Equal(t, TestBandwidthPos1, subscription.TotalBandwidth())
+	require.Equal(t, subscription.RemainingDeposit, subscription.UnusedDeposit(time.Now()))
+	
+	subscription.Plan.Type = "PER_WEEK"
+	require.NotNil(t, subscription.IsValid())
+}
+
+func TestPlan_ConcurrentSessions(t *testing.T) {
+	plan := NewPerGBPlan(sdk.NewInt64Coin("stake", 10))
+	require.Equal(t, uint64(1), plan.ConcurrentSessions())
+	
+	plan.MaxConcurrentSessions = 4
+	require.Equal(t, uint64(4), plan.ConcurrentSessions())
+}
+
+func TestPlan_Periods(t *testing.T) {
+	plan := Plan{Type: PlanTypePerHour, Price: sdk.NewInt64Coin("stake", 30)}
+	
+	_, err := plan.Periods(sdk.NewInt64Coin("atom", 100))
+	require.NotNil(t, err)
+	
+	periods, err := plan.Periods(sdk.NewInt64Coin("stake", 100))
+	require.Nil(t, err)
+	require.Equal(t, int64(3), periods)
+	
+	max := int64(MaxSubscriptionDuration / time.Hour)
+	periods, err = plan.Periods(sdk.NewInt64Coin("stake", 30*max))
+	require.Nil(t, err)
+	require.Equal(t, max, periods)
+	
+	_, err = plan.Periods(sdk.NewInt64Coin("stake", 30*(max+1)))
+	require.Equal(t, ErrorDurationExceedsMax(), err)
+	
+	_, err = plan.Periods(sdk.Coin{Denom: "stake", Amount: sdk.NewIntWithDecimal(1, 30)})
+	require.Equal(t, ErrorDurationExceedsMax(), err)
+	
+	_, err = Plan{Type: PlanTypePerGB, Price: sdk.NewInt64Coin("stake", 30)}.Periods(sdk.NewInt64Coin("stake", 100))
+	require.NotNil(t, err)
+}
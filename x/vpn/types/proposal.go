@@ -0,0 +1,207 @@
+package types
+
+import (
+	"fmt"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	
+	hub "github.com/sentinel-official/hub/types"
+)
+
+const (
+	ProposalTypeChangeParams   = "ChangeVPNParams"
+	ProposalTypeBlacklist      = "VPNBlacklist"
+	ProposalTypeMaxPricesPerGB = "VPNMaxPricesPerGB"
+	ProposalTypeExchangeRates  = "VPNExchangeRates"
+)
+
+var (
+	_ govtypes.Content = ChangeParamsProposal{}
+	_ govtypes.Content = BlacklistProposal{}
+	_ govtypes.Content = MaxPricesPerGBProposal{}
+	_ govtypes.Content = ExchangeRatesProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeChangeParams)
+	govtypes.RegisterProposalTypeCodec(ChangeParamsProposal{}, "x/vpn/ChangeParamsProposal")
+	govtypes.RegisterProposalType(ProposalTypeBlacklist)
+	govtypes.RegisterProposalTypeCodec(BlacklistProposal{}, "x/vpn/BlacklistProposal")
+	govtypes.RegisterProposalType(ProposalTypeMaxPricesPerGB)
+	govtypes.RegisterProposalTypeCodec(MaxPricesPerGBProposal{}, "x/vpn/MaxPricesPerGBProposal")
+	govtypes.RegisterProposalType(ProposalTypeExchangeRates)
+	govtypes.RegisterProposalTypeCodec(ExchangeRatesProposal{}, "x/vpn/ExchangeRatesProposal")
+}
+
+// ChangeParamsProposal replaces all the parameters of the module
+type ChangeParamsProposal struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Params      Params `json:"params"`
+}
+
+func NewChangeParamsProposal(title, description string, params Params) ChangeParamsProposal {
+	return ChangeParamsProposal{
+		Title:       title,
+		Description: description,
+		Params:      params,
+	}
+}
+
+func (p ChangeParamsProposal) GetTitle() string       { return p.Title }
+func (p ChangeParamsProposal) GetDescription() string { return p.Description }
+func (p ChangeParamsProposal) ProposalRoute() string  { return RouterKey }
+func (p ChangeParamsProposal) ProposalType() string   { return ProposalTypeChangeParams }
+
+func (p ChangeParamsProposal) ValidateBasic() sdk.Error {
+	if err := govtypes.ValidateAbstract(Codespace, p); err != nil {
+		return err
+	}
+	if err := p.Params.Validate(); err != nil {
+		return ErrorInvalidField("params: " + err.Error())
+	}
+	
+	return nil
+}
+
+func (p ChangeParamsProposal) String() string {
+	return fmt.Sprintf(`Change VPN Params Proposal
+  Title:               %s
+  Description:         %s
+%s`, p.Title, p.Description, p.Params)
+}
+
+// BlacklistProposal blacklists the nodes and the resolvers, or lifts the blacklisting when Blacklisted is false.
+// Blacklisted nodes are not listed and neither blacklisted nodes nor blacklisted resolvers accept subscriptions.
+type BlacklistProposal struct {
+	Title       string           `json:"title"`
+	Description string           `json:"description"`
+	NodeIDs     []hub.NodeID     `json:"node_ids"`
+	ResolverIDs []hub.ResolverID `json:"resolver_ids"`
+	Blacklisted bool             `json:"blacklisted"`
+}
+
+func NewBlacklistProposal(title, description string,
+	nodeIDs []hub.NodeID, resolverIDs []hub.ResolverID, blacklisted bool) BlacklistProposal {
+	return BlacklistProposal{
+		Title:       title,
+		Description: description,
+		NodeIDs:     nodeIDs,
+		ResolverIDs: resolverIDs,
+		Blacklisted: blacklisted,
+	}
+}
+
+func (p BlacklistProposal) GetTitle() string       { return p.Title }
+func (p BlacklistProposal) GetDescription() string { return p.Description }
+func (p BlacklistProposal) ProposalRoute() string  { return RouterKey }
+func (p BlacklistProposal) ProposalType() string   { return ProposalTypeBlacklist }
+
+func (p BlacklistProposal) ValidateBasic() sdk.Error {
+	if err := govtypes.ValidateAbstract(Codespace, p); err != nil {
+		return err
+	}
+	if len(p.NodeIDs) == 0 && len(p.ResolverIDs) == 0 {
+		return ErrorInvalidField("node_ids or resolver_ids")
+	}
+	for _, id := range p.NodeIDs {
+		if len(id) != 8 {
+			return ErrorInvalidField("node_ids")
+		}
+	}
+	for _, id := range p.ResolverIDs {
+		if len(id) != 8 {
+			return ErrorInvalidField("resolver_ids")
+		}
+	}
+	
+	return nil
+}
+
+func (p BlacklistProposal) String() string {
+	return fmt.Sprintf(`VPN Blacklist Proposal
+  Title:               %s
+  Description:         %s
+  Node IDs:            %s
+  Resolver IDs:        %s
+  Blacklisted:         %t`, p.Title, p.Description, p.NodeIDs, p.ResolverIDs, p.Blacklisted)
+}
+
+// MaxPricesPerGBProposal sets the network wide maximum of the prices per GB of the nodes.
+// An empty list removes the maximum.
+type MaxPricesPerGBProposal struct {
+	Title          string    `json:"title"`
+	Description    string    `json:"description"`
+	MaxPricesPerGB sdk.Coins `json:"max_prices_per_gb"`
+}
+
+func NewMaxPricesPerGBProposal(title, description string, maxPricesPerGB sdk.Coins) MaxPricesPerGBProposal {
+	return MaxPricesPerGBProposal{
+		Title:          title,
+		Description:    description,
+		MaxPricesPerGB: maxPricesPerGB,
+	}
+}
+
+func (p MaxPricesPerGBProposal) GetTitle() string       { return p.Title }
+func (p MaxPricesPerGBProposal) GetDescription() string { return p.Description }
+func (p MaxPricesPerGBProposal) ProposalRoute() string  { return RouterKey }
+func (p MaxPricesPerGBProposal) ProposalType() string   { return ProposalTypeMaxPricesPerGB }
+
+func (p MaxPricesPerGBProposal) ValidateBasic() sdk.Error {
+	if err := govtypes.ValidateAbstract(Codespace, p); err != nil {
+		return err
+	}
+	if !p.MaxPricesPerGB.IsValid() {
+		return ErrorInvalidField("max_prices_per_gb")
+	}
+	
+	return nil
+}
+
+func (p MaxPricesPerGBProposal) String() string {
+	return fmt.Sprintf(`VPN Max Prices Per GB Proposal
+  Title:               %s
+  Description:         %s
+  Max Prices Per GB:   %s`, p.Title, p.Description, p.MaxPricesPerGB)
+}
+
+// ExchangeRatesProposal replaces the table of the exchange rates the prices of the nodes are converted with.
+// An empty table removes the conversion.
+type ExchangeRatesProposal struct {
+	Title         string        `json:"title"`
+	Description   string        `json:"description"`
+	ExchangeRates ExchangeRates `json:"exchange_rates"`
+}
+
+func NewExchangeRatesProposal(title, description string, exchangeRates ExchangeRates) ExchangeRatesProposal {
+	return ExchangeRatesProposal{
+		Title:         title,
+		Description:   description,
+		ExchangeRates: exchangeRates,
+	}
+}
+
+func (p ExchangeRatesProposal) GetTitle() string       { return p.Title }
+func (p ExchangeRatesProposal) GetDescription() string { return p.Description }
+func (p ExchangeRatesProposal) ProposalRoute() string  { return RouterKey }
+func (p ExchangeRatesProposal) ProposalType() string   { return ProposalTypeExchangeRates }
+
+func (p ExchangeRatesProposal) ValidateBasic() sdk.Error {
+	if err := govtypes.ValidateAbstract(Codespace, p); err != nil {
+		return err
+	}
+	if err := p.ExchangeRates.Validate(); err != nil {
+		return ErrorInvalidField("exchange_rates: " + err.Error())
+	}
+	
+	return nil
+}
+
+func (p ExchangeRatesProposal) String() string {
+	return fmt.Sprintf(`VPN Exchange Rates Proposal
+  Title:               %s
+  Description:         %s
+  Exchange Rates:      %s`, p.Title, p.Description, p.ExchangeRates)
+}
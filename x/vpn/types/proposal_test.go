@@ -0,0 +1,178 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	
+	hub "github.com/sentinel-official/hub/types"
+)
+
+func TestChangeParamsProposal_ValidateBasic(t *testing.T) {
+	params := DefaultParams()
+	params.SlashFraction = sdk.NewDec(2)
+	
+	tests := []struct {
+		name     string
+		proposal ChangeParamsProposal
+		want     sdk.Error
+	}{
+		{
+			"title is empty",
+			NewChangeParamsProposal("", "description", DefaultParams()),
+			govtypes.ErrInvalidProposalContent(Codespace, "proposal title cannot be blank"),
+		}, {
+			"description is empty",
+			NewChangeParamsProposal("title", "", DefaultParams()),
+			govtypes.ErrInvalidProposalContent(Codespace, "proposal description cannot be blank"),
+		}, {
+			"params are invalid",
+			NewChangeParamsProposal("title", "description", params),
+			ErrorInvalidField("params: " + params.Validate().Error()),
+		}, {
+			"valid",
+			NewChangeParamsProposal("title", "description", DefaultParams()),
+			nil,
+		},
+	}
+	
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.proposal.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBlacklistProposal_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name     string
+		proposal BlacklistProposal
+		want     sdk.Error
+	}{
+		{
+			"title is empty",
+			NewBlacklistProposal("", "description", []hub.NodeID{hub.NewNodeID(0)}, nil, true),
+			govtypes.ErrInvalidProposalContent(Codespace, "proposal title cannot be blank"),
+		}, {
+			"ids are empty",
+			NewBlacklistProposal("title", "description", nil, nil, true),
+			ErrorInvalidField("node_ids or resolver_ids"),
+		}, {
+			"node_id is invalid",
+			NewBlacklistProposal("title", "description", []hub.NodeID{[]byte("id")}, nil, true),
+			ErrorInvalidField("node_ids"),
+		}, {
+			"resolver_id is invalid",
+			NewBlacklistProposal("title", "description", nil, []hub.ResolverID{[]byte("id")}, true),
+			ErrorInvalidField("resolver_ids"),
+		}, {
+			"valid",
+			NewBlacklistProposal("title", "description", []hub.NodeID{hub.NewNodeID(0)}, []hub.ResolverID{hub.NewResolverID(0)}, false),
+			nil,
+		},
+	}
+	
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.proposal.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMaxPricesPerGBProposal_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name     string
+		proposal MaxPricesPerGBProposal
+		want     sdk.Error
+	}{
+		{
+			"title is empty",
+			NewMaxPricesPerGBProposal("", "description", nil),
+			govtypes.ErrInvalidProposalContent(Codespace, "proposal title cannot be blank"),
+		}, {
+			"max_prices_per_gb is zero",
+			NewMaxPricesPerGBProposal("title", "description", sdk.Coins{sdk.NewInt64Coin("stake", 0)}),
+			ErrorInvalidField("max_prices_per_gb"),
+		}, {
+			"max_prices_per_gb is empty",
+			NewMaxPricesPerGBProposal("title", "description", nil),
+			nil,
+		}, {
+			"valid",
+			NewMaxPricesPerGBProposal("title", "description", sdk.Coins{sdk.NewInt64Coin("stake", 100)}),
+			nil,
+		},
+	}
+	
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.proposal.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestExchangeRatesProposal_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name     string
+		proposal ExchangeRatesProposal
+		want     sdk.Error
+	}{
+		{
+			"title is empty",
+			NewExchangeRatesProposal("", "description", nil),
+			govtypes.ErrInvalidProposalContent(Codespace, "proposal title cannot be blank"),
+		}, {
+			"exchange_rates has a zero rate",
+			NewExchangeRatesProposal("title", "description", ExchangeRates{NewExchangeRate("stake", sdk.ZeroDec())}),
+			ErrorInvalidField("exchange_rates: 0.000000000000000000stake: invalid rate"),
+		}, {
+			"exchange_rates is empty",
+			NewExchangeRatesProposal("title", "description", nil),
+			nil,
+		}, {
+			"valid",
+			NewExchangeRatesProposal("title", "description", ExchangeRates{NewExchangeRate("stake", sdk.OneDec())}),
+			nil,
+		},
+	}
+	
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.proposal.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParams_ExceedsMaxPricesPerGB(t *testing.T) {
+	params := DefaultParams()
+	prices := sdk.Coins{sdk.NewInt64Coin("stake", 100)}
+	
+	if params.ExceedsMaxPricesPerGB(prices) {
+		t.Errorf("prices exceed the maximum without a maximum")
+	}
+	
+	params.MaxPricesPerGB = sdk.Coins{sdk.NewInt64Coin("stake", 100)}
+	if params.ExceedsMaxPricesPerGB(prices) {
+		t.Errorf("prices equal to the maximum exceed the maximum")
+	}
+	
+	params.MaxPricesPerGB = sdk.Coins{sdk.NewInt64Coin("stake", 99)}
+	if !params.ExceedsMaxPricesPerGB(prices) {
+		t.Errorf("prices above the maximum do not exceed the maximum")
+	}
+	
+	params.MaxPricesPerGB = sdk.Coins{sdk.NewInt64Coin("atom", 100)}
+	if !params.ExceedsMaxPricesPerGB(prices) {
+		t.Errorf("prices without a maximum of the denomination do not exceed the maximum")
+	}
+}
@@ -10,12 +10,18 @@ const (
 	QueryNode           = "node"
 	QueryNodesOfAddress = "nodes_of_address"
 	QueryAllNodes       = "all_nodes"
+	QueryNodes          = "nodes"
+	
+	SortNodesByPrice = "price"
+	SortNodesBySpeed = "speed"
 	
 	QueryFreeNodesOfClient = "free_nodes_of_client"
 	QueryFreeClientsOfNode = "free_clients_of_node"
 	
-	QueryResolversOfNode = "resolvers_of_node"
-	QueryNodesOfResolver = "nodes_of_resolver"
+	QueryResolversOfNode        = "resolvers_of_node"
+	QueryNodesOfResolver        = "nodes_of_resolver"
+	QueryCommissionsOfResolver  = "commissions_of_resolver"
+	QueryPendingNodesOfResolver = "pending_nodes_of_resolver"
 	
 	QuerySubscription                = "subscription"
 	QuerySubscriptionsOfNode         = "subscriptions_of_node"
@@ -23,10 +29,15 @@ const (
 	QueryAllSubscriptions            = "all_subscriptions"
 	QuerySessionsCountOfSubscription = "sessions_count_of_subscription"
 	
+	QueryChannel           = "channel"
+	QueryChannelsOfNode    = "channels_of_node"
+	QueryChannelsOfAddress = "channels_of_address"
+	
 	QuerySession                = "session"
 	QuerySessionOfSubscription  = "session_of_subscription"
 	QuerySessionsOfSubscription = "sessions_of_subscription"
 	QueryAllSessions            = "all_sessions"
+	QuerySettlement             = "settlement"
 	QueryParams                 = "params"
 	QueryResolvers              = "resolvers"
 )
@@ -41,6 +52,79 @@ func NewQueryNodeParams(id hub.NodeID) QueryNodeParams {
 	}
 }
 
+// QueryNodesParams selects a page of the nodes matching all of the given filters, empty filters match any node.
+// A node matches the price ceiling when it accepts at least one of the denominations at or below the ceiling.
+type QueryNodesParams struct {
+	Page             int
+	Limit            int
+	Status           string
+	Type             string
+	Encryption       string
+	Version          string
+	MinInternetSpeed hub.Bandwidth
+	MaxPricesPerGB   sdk.Coins
+	ResolverID       hub.ResolverID `json:",omitempty"`
+	SortBy           string
+}
+
+func NewQueryNodesParams(page, limit int, status, _type, encryption, version string,
+	minInternetSpeed hub.Bandwidth, maxPricesPerGB sdk.Coins, resolverID hub.ResolverID,
+	sortBy string) QueryNodesParams {
+	return QueryNodesParams{
+		Page:             page,
+		Limit:            limit,
+		Status:           status,
+		Type:             _type,
+		Encryption:       encryption,
+		Version:          version,
+		MinInternetSpeed: minInternetSpeed,
+		MaxPricesPerGB:   maxPricesPerGB,
+		ResolverID:       resolverID,
+		SortBy:           sortBy,
+	}
+}
+
+func (p QueryNodesParams) Matches(node Node) bool {
+	if node.Blacklisted {
+		return false
+	}
+	if p.Status != "" && p.Status != node.Status {
+		return false
+	}
+	if p.Type != "" && p.Type != node.Type {
+		return false
+	}
+	if p.Encryption != "" && p.Encryption != node.Encryption {
+		return false
+	}
+	if p.Version != "" && p.Version != node.Version {
+		return false
+	}
+	if !p.MinInternetSpeed.AnyNil() && node.InternetSpeed.AnyLT(p.MinInternetSpeed) {
+		return false
+	}
+	if len(p.MaxPricesPerGB) > 0 && !p.MaxPricesPerGB.IsAnyGTE(node.PricesPerGB) {
+		return false
+	}
+	
+	return true
+}
+
+// LowestPrice returns the lowest price per GB of the node in the denominations of the price ceiling,
+// or in any denomination when there is no ceiling
+func (p QueryNodesParams) LowestPrice(node Node) (price sdk.Int, found bool) {
+	for _, coin := range node.PricesPerGB {
+		if len(p.MaxPricesPerGB) > 0 && p.MaxPricesPerGB.AmountOf(coin.Denom).IsZero() {
+			continue
+		}
+		if !found || coin.Amount.LT(price) {
+			price, found = coin.Amount, true
+		}
+	}
+	
+	return price, found
+}
+
 type QueryNodesOfAddressPrams struct {
 	Address sdk.AccAddress
 }
@@ -91,6 +175,16 @@ func NewQueryNodesOfResolverPrams(resolverID hub.ResolverID) QueryNodesOfResolve
 	}
 }
 
+type QueryCommissionsOfResolverParams struct {
+	ID hub.ResolverID
+}
+
+func NewQueryCommissionsOfResolverParams(id hub.ResolverID) QueryCommissionsOfResolverParams {
+	return QueryCommissionsOfResolverParams{
+		ID: id,
+	}
+}
+
 type QuerySubscriptionParams struct {
 	ID hub.SubscriptionID
 }
@@ -141,6 +235,16 @@ func NewQuerySessionParams(id hub.SessionID) QuerySessionParams {
 	}
 }
 
+type QuerySettlementParams struct {
+	ID hub.SessionID
+}
+
+func NewQuerySettlementParams(id hub.SessionID) QuerySettlementParams {
+	return QuerySettlementParams{
+		ID: id,
+	}
+}
+
 type QuerySessionOfSubscriptionPrams struct {
 	ID    hub.SubscriptionID
 	Index uint64
@@ -162,3 +266,33 @@ func NewQuerySessionsOfSubscriptionPrams(id hub.SubscriptionID) QuerySessionsOfS
 		ID: id,
 	}
 }
+
+type QueryChannelParams struct {
+	ID hub.ChannelID
+}
+
+func NewQueryChannelParams(id hub.ChannelID) QueryChannelParams {
+	return QueryChannelParams{
+		ID: id,
+	}
+}
+
+type QueryChannelsOfNodeParams struct {
+	ID hub.NodeID
+}
+
+func NewQueryChannelsOfNodeParams(id hub.NodeID) QueryChannelsOfNodeParams {
+	return QueryChannelsOfNodeParams{
+		ID: id,
+	}
+}
+
+type QueryChannelsOfAddressParams struct {
+	Address sdk.AccAddress
+}
+
+func NewQueryChannelsOfAddressParams(address sdk.AccAddress) QueryChannelsOfAddressParams {
+	return QueryChannelsOfAddressParams{
+		Address: address,
+	}
+}
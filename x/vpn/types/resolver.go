@@ -2,34 +2,70 @@ package types
 
 import (
 	"fmt"
+	"net/url"
 	"strings"
+	"time"
 	
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	
 	hub "github.com/sentinel-official/hub/types"
 )
 
+// Resolver is a discovery service of the nodes. Clients reach the resolver at its endpoint,
+// and the owner keeps a self-stake of at least the minimum resolver deposit locked while it is registered.
+// The commission can not exceed MaxCommissionRate, and each change is limited to MaxCommissionChangeRate;
+// both limits are fixed at registration.
 type Resolver struct {
-	ID               hub.ResolverID `json:"id"`
-	Owner            sdk.AccAddress `json:"owner"`
-	Commission       sdk.Dec        `json:"commission"`
-	Status           string         `json:"status"`
-	StatusModifiedAt int64          `json:"status_modified_at"`
+	ID                      hub.ResolverID `json:"id"`
+	Owner                   sdk.AccAddress `json:"owner"`
+	Moniker                 string         `json:"moniker"`
+	Endpoint                string         `json:"endpoint"`
+	Regions                 []string       `json:"regions"`
+	Deposit                 sdk.Coin       `json:"deposit"`
+	Commission              sdk.Dec        `json:"commission"`
+	MaxCommissionRate       sdk.Dec        `json:"max_commission_rate"`
+	MaxCommissionChangeRate sdk.Dec        `json:"max_commission_change_rate"`
+	CommissionUpdatedAt     int64          `json:"commission_updated_at"`
+	Blacklisted             bool           `json:"blacklisted"`
+	Status                  string         `json:"status"`
+	StatusModifiedAt        int64          `json:"status_modified_at"`
+	UnbondingCompletionTime time.Time      `json:"unbonding_completion_time"`
 }
 
 func (resolver Resolver) String() string {
 	return fmt.Sprintf(`
-  ID :                 %s
-  Owner :              %s
-  Commission :         %s
-  Status :             %s
-  StatusModifiedAt :   %d
-`, resolver.ID.String(), resolver.Owner, resolver.Commission,
-		resolver.Status, resolver.StatusModifiedAt)
+  ID :                  %s
+  Owner :               %s
+  Moniker :             %s
+  Endpoint :            %s
+  Regions :             %s
+  Deposit :             %s
+  Commission :          %s
+  MaxCommission :       %s
+  MaxCommissionChange : %s
+  CommissionUpdatedAt : %d
+  Blacklisted :         %t
+  Status :              %s
+  StatusModifiedAt :    %d
+  UnbondingCompletes :  %s
+`, resolver.ID.String(), resolver.Owner, resolver.Moniker, resolver.Endpoint,
+		strings.Join(resolver.Regions, ","), resolver.Deposit, resolver.Commission,
+		resolver.MaxCommissionRate, resolver.MaxCommissionChangeRate, resolver.CommissionUpdatedAt, resolver.Blacklisted,
+		resolver.Status, resolver.StatusModifiedAt, resolver.UnbondingCompletionTime)
 }
 
 func (resolver Resolver) UpdateInfo(_resolver Resolver) Resolver {
-	if _resolver.Commission.GTE(sdk.ZeroDec()) && _resolver.Commission.LTE(sdk.OneDec()) {
+	if _resolver.Moniker != "" {
+		resolver.Moniker = _resolver.Moniker
+	}
+	if _resolver.Endpoint != "" {
+		resolver.Endpoint = _resolver.Endpoint
+	}
+	if len(_resolver.Regions) > 0 {
+		resolver.Regions = _resolver.Regions
+	}
+	if !_resolver.Commission.IsNil() &&
+		_resolver.Commission.GTE(sdk.ZeroDec()) && _resolver.Commission.LTE(sdk.OneDec()) {
 		// commission rate between 0 to 1
 		resolver.Commission = _resolver.Commission
 	}
@@ -38,14 +74,127 @@ func (resolver Resolver) UpdateInfo(_resolver Resolver) Resolver {
 }
 
 func (resolver Resolver) GetCommission(pay sdk.Coin) sdk.Coin {
-	commission := resolver.Commission.Mul(sdk.NewDec(100)).MulInt(pay.Amount).Quo(sdk.NewDec(100))
+	return commissionOf(resolver.Commission, pay)
+}
+
+// ValidateCommissionChange reports whether the commission can be changed to the rate at the given height
+func (resolver Resolver) ValidateCommissionChange(rate sdk.Dec, height, interval int64) sdk.Error {
+	if rate.GT(resolver.MaxCommissionRate) {
+		return ErrorCommissionRateTooHigh()
+	}
+	if rate.Sub(resolver.Commission).Abs().GT(resolver.MaxCommissionChangeRate) {
+		return ErrorCommissionChangeTooHigh()
+	}
+	if height-resolver.CommissionUpdatedAt < interval {
+		return ErrorCommissionUpdatedTooSoon()
+	}
+	
+	return nil
+}
+
+func commissionOf(rate sdk.Dec, pay sdk.Coin) sdk.Coin {
+	commission := rate.Mul(sdk.NewDec(100)).MulInt(pay.Amount).Quo(sdk.NewDec(100))
 	pay.Amount = commission.RoundInt()
 	
 	return pay
 }
 
+func (resolver Resolver) IsValid() error {
+	if resolver.ID == nil {
+		return fmt.Errorf("invalid id")
+	}
+	if resolver.Owner == nil || resolver.Owner.Empty() {
+		return fmt.Errorf("invalid owner")
+	}
+	if resolver.Moniker == "" || len(resolver.Moniker) > 128 {
+		return fmt.Errorf("invalid moniker")
+	}
+	if !IsValidResolverEndpoint(resolver.Endpoint) {
+		return fmt.Errorf("invalid endpoint")
+	}
+	if !IsValidResolverRegions(resolver.Regions) {
+		return fmt.Errorf("invalid regions")
+	}
+	if resolver.Deposit.Denom == "" || resolver.Deposit.IsNegative() {
+		return fmt.Errorf("invalid deposit")
+	}
+	if resolver.MaxCommissionRate.IsNil() || resolver.MaxCommissionRate.IsNegative() ||
+		resolver.MaxCommissionRate.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid max commission rate")
+	}
+	if resolver.MaxCommissionChangeRate.IsNil() || resolver.MaxCommissionChangeRate.IsNegative() ||
+		resolver.MaxCommissionChangeRate.GT(resolver.MaxCommissionRate) {
+		return fmt.Errorf("invalid max commission change rate")
+	}
+	if resolver.Commission.IsNil() || resolver.Commission.IsNegative() ||
+		resolver.Commission.GT(resolver.MaxCommissionRate) {
+		return fmt.Errorf("invalid commission")
+	}
+	if resolver.Status != StatusRegistered && resolver.Status != StatusUnbonding &&
+		resolver.Status != StatusDeRegistered {
+		return fmt.Errorf("invalid status")
+	}
+	
+	return nil
+}
+
+// IsValidResolverEndpoint reports whether the endpoint is an absolute http or https URL
+func IsValidResolverEndpoint(endpoint string) bool {
+	if endpoint == "" || len(endpoint) > 256 {
+		return false
+	}
+	
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return false
+	}
+	
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// IsValidResolverRegions reports whether the regions are a non-empty list of unique, non-empty names
+func IsValidResolverRegions(regions []string) bool {
+	if len(regions) == 0 {
+		return false
+	}
+	
+	seen := make(map[string]bool, len(regions))
+	for _, region := range regions {
+		if region == "" || len(region) > 32 || seen[region] {
+			return false
+		}
+		
+		seen[region] = true
+	}
+	
+	return true
+}
+
+// HasRegion reports whether the resolver serves the region
+func (resolver Resolver) HasRegion(region string) bool {
+	for _, r := range resolver.Regions {
+		if r == region {
+			return true
+		}
+	}
+	
+	return false
+}
+
 type Resolvers []Resolver
 
+// WithRegion returns the registered resolvers which serve the region
+func (resolvers Resolvers) WithRegion(region string) Resolvers {
+	var res Resolvers
+	for _, resolver := range resolvers {
+		if resolver.Status == StatusRegistered && !resolver.Blacklisted && resolver.HasRegion(region) {
+			res = append(res, resolver)
+		}
+	}
+	
+	return res
+}
+
 func (resolvers Resolvers) String() string {
 	var out string
 	for _, resolver := range resolvers {
@@ -54,3 +203,29 @@ func (resolvers Resolvers) String() string {
 	
 	return strings.TrimSpace(out)
 }
+
+// ResolverCommission is a commission rate of the resolver which applies from the height
+type ResolverCommission struct {
+	ResolverID hub.ResolverID `json:"resolver_id"`
+	Commission sdk.Dec        `json:"commission"`
+	Height     int64          `json:"height"`
+}
+
+func (c ResolverCommission) String() string {
+	return fmt.Sprintf(`
+  ResolverID : %s
+  Commission : %s
+  Height :     %d
+`, c.ResolverID, c.Commission, c.Height)
+}
+
+type ResolverCommissions []ResolverCommission
+
+func (commissions ResolverCommissions) String() string {
+	var out string
+	for _, commission := range commissions {
+		out += commission.String() + "\n"
+	}
+	
+	return strings.TrimSpace(out)
+}
@@ -9,8 +9,14 @@ import (
 )
 
 type MsgRegisterResolver struct {
-	From       sdk.AccAddress `json:"from"`
-	Commission sdk.Dec        `json:"commission"`
+	From                    sdk.AccAddress `json:"from"`
+	Moniker                 string         `json:"moniker"`
+	Endpoint                string         `json:"endpoint"`
+	Regions                 []string       `json:"regions"`
+	Deposit                 sdk.Coin       `json:"deposit"`
+	Commission              sdk.Dec        `json:"commission"`
+	MaxCommissionRate       sdk.Dec        `json:"max_commission_rate"`
+	MaxCommissionChangeRate sdk.Dec        `json:"max_commission_change_rate"`
 }
 
 func (msg MsgRegisterResolver) Route() string {
@@ -25,7 +31,27 @@ func (msg MsgRegisterResolver) ValidateBasic() sdk.Error {
 	if msg.From == nil || msg.From.Empty() {
 		return ErrorInvalidField("from")
 	}
-	if msg.Commission.LT(sdk.ZeroDec()) || msg.Commission.GT(sdk.OneDec()) {
+	if msg.Moniker == "" || len(msg.Moniker) > 128 {
+		return ErrorInvalidField("moniker")
+	}
+	if !IsValidResolverEndpoint(msg.Endpoint) {
+		return ErrorInvalidField("endpoint")
+	}
+	if !IsValidResolverRegions(msg.Regions) {
+		return ErrorInvalidField("regions")
+	}
+	if msg.Deposit.Denom == "" || !msg.Deposit.IsPositive() {
+		return ErrorInvalidField("deposit")
+	}
+	if msg.MaxCommissionRate.IsNil() || msg.MaxCommissionRate.LT(sdk.ZeroDec()) ||
+		msg.MaxCommissionRate.GT(sdk.OneDec()) {
+		return ErrorInvalidField("max_commission_rate")
+	}
+	if msg.MaxCommissionChangeRate.IsNil() || msg.MaxCommissionChangeRate.LT(sdk.ZeroDec()) ||
+		msg.MaxCommissionChangeRate.GT(msg.MaxCommissionRate) {
+		return ErrorInvalidField("max_commission_change_rate")
+	}
+	if msg.Commission.IsNil() || msg.Commission.LT(sdk.ZeroDec()) || msg.Commission.GT(msg.MaxCommissionRate) {
 		return ErrorInvalidField("commission")
 	}
 	
@@ -43,10 +69,17 @@ func (msg MsgRegisterResolver) GetSignBytes() []byte {
 func (msg MsgRegisterResolver) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.From}
 }
-func NewMsgRegisterResolver(from sdk.AccAddress, commission sdk.Dec) MsgRegisterResolver {
+func NewMsgRegisterResolver(from sdk.AccAddress, moniker, endpoint string, regions []string,
+	deposit sdk.Coin, commission, maxCommissionRate, maxCommissionChangeRate sdk.Dec) MsgRegisterResolver {
 	return MsgRegisterResolver{
-		From:       from,
-		Commission: commission,
+		From:                    from,
+		Moniker:                 moniker,
+		Endpoint:                endpoint,
+		Regions:                 regions,
+		Deposit:                 deposit,
+		Commission:              commission,
+		MaxCommissionRate:       maxCommissionRate,
+		MaxCommissionChangeRate: maxCommissionChangeRate,
 	}
 }
 
@@ -55,7 +88,10 @@ var _ sdk.Msg = (*MsgRegisterResolver)(nil)
 type MsgUpdateResolverInfo struct {
 	ResolverID hub.ResolverID `json:"id"`
 	From       sdk.AccAddress `json:"from"`
-	Commission sdk.Dec        `json:"commission"`
+	Moniker    string         `json:"moniker"`
+	Endpoint   string         `json:"endpoint"`
+	Regions    []string       `json:"regions"`
+	Commission *sdk.Dec       `json:"commission"`
 }
 
 func (msg MsgUpdateResolverInfo) Route() string {
@@ -70,8 +106,18 @@ func (msg MsgUpdateResolverInfo) ValidateBasic() sdk.Error {
 	if msg.From == nil || msg.From.Empty() {
 		return ErrorInvalidField("from")
 	}
+	if len(msg.Moniker) > 128 {
+		return ErrorInvalidField("moniker")
+	}
+	if msg.Endpoint != "" && !IsValidResolverEndpoint(msg.Endpoint) {
+		return ErrorInvalidField("endpoint")
+	}
+	if len(msg.Regions) > 0 && !IsValidResolverRegions(msg.Regions) {
+		return ErrorInvalidField("regions")
+	}
 	
-	if msg.Commission.LT(sdk.ZeroDec()) || msg.Commission.GT(sdk.OneDec()) {
+	if msg.Commission != nil && (msg.Commission.IsNil() ||
+		msg.Commission.LT(sdk.ZeroDec()) || msg.Commission.GT(sdk.OneDec())) {
 		return ErrorInvalidField("commission")
 	}
 	
@@ -91,10 +137,16 @@ func (msg MsgUpdateResolverInfo) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.From}
 }
 
-func NewMsgUpdateResolverInfo(from sdk.AccAddress, id hub.ResolverID, commission sdk.Dec) MsgUpdateResolverInfo {
+// NewMsgUpdateResolverInfo returns the message updating the info of the resolver. A nil commission leaves the
+// commission unchanged, as a nil sdk.Dec does not survive the encoding of the message.
+func NewMsgUpdateResolverInfo(from sdk.AccAddress, id hub.ResolverID,
+	moniker, endpoint string, regions []string, commission *sdk.Dec) MsgUpdateResolverInfo {
 	return MsgUpdateResolverInfo{
 		From:       from,
 		ResolverID: id,
+		Moniker:    moniker,
+		Endpoint:   endpoint,
+		Regions:    regions,
 		Commission: commission,
 	}
 }
@@ -143,3 +195,107 @@ func NewMsgDeregisterResolver(from sdk.AccAddress, id hub.ResolverID) MsgDeregis
 }
 
 var _ sdk.Msg = (*MsgDeregisterResolver)(nil)
+
+// MsgAcceptVPNOnResolver is sent by the resolver owner to list a node which requested a listing on the resolver
+type MsgAcceptVPNOnResolver struct {
+	From       sdk.AccAddress `json:"from"`
+	ResolverID hub.ResolverID `json:"resolver_id"`
+	NodeID     hub.NodeID     `json:"node_id"`
+}
+
+func (msg MsgAcceptVPNOnResolver) Route() string {
+	return RouterKey
+}
+
+func (msg MsgAcceptVPNOnResolver) Type() string {
+	return "accept_vpn_on_resolver"
+}
+
+func (msg MsgAcceptVPNOnResolver) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if msg.ResolverID == nil {
+		return ErrorInvalidField("resolver_id")
+	}
+	if msg.NodeID == nil {
+		return ErrorInvalidField("node_id")
+	}
+	
+	return nil
+}
+
+func (msg MsgAcceptVPNOnResolver) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	
+	return bz
+}
+
+func (msg MsgAcceptVPNOnResolver) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func NewMsgAcceptVPNOnResolver(from sdk.AccAddress, resolverID hub.ResolverID, nodeID hub.NodeID) MsgAcceptVPNOnResolver {
+	return MsgAcceptVPNOnResolver{
+		From:       from,
+		ResolverID: resolverID,
+		NodeID:     nodeID,
+	}
+}
+
+var _ sdk.Msg = (*MsgAcceptVPNOnResolver)(nil)
+
+// MsgRejectVPNOnResolver is sent by the resolver owner to drop a listing request of a node
+type MsgRejectVPNOnResolver struct {
+	From       sdk.AccAddress `json:"from"`
+	ResolverID hub.ResolverID `json:"resolver_id"`
+	NodeID     hub.NodeID     `json:"node_id"`
+}
+
+func (msg MsgRejectVPNOnResolver) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRejectVPNOnResolver) Type() string {
+	return "reject_vpn_on_resolver"
+}
+
+func (msg MsgRejectVPNOnResolver) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if msg.ResolverID == nil {
+		return ErrorInvalidField("resolver_id")
+	}
+	if msg.NodeID == nil {
+		return ErrorInvalidField("node_id")
+	}
+	
+	return nil
+}
+
+func (msg MsgRejectVPNOnResolver) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	
+	return bz
+}
+
+func (msg MsgRejectVPNOnResolver) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func NewMsgRejectVPNOnResolver(from sdk.AccAddress, resolverID hub.ResolverID, nodeID hub.NodeID) MsgRejectVPNOnResolver {
+	return MsgRejectVPNOnResolver{
+		From:       from,
+		ResolverID: resolverID,
+		NodeID:     nodeID,
+	}
+}
+
+var _ sdk.Msg = (*MsgRejectVPNOnResolver)(nil)
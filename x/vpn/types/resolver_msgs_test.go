@@ -13,7 +13,7 @@ import (
 )
 
 func TestMsgRegisterResolver_GetSignBytes(t *testing.T) {
-	msg := NewMsgRegisterResolver(TestAddress1, sdk.NewDecWithPrec(1, 2))
+	msg := NewMsgRegisterResolver(TestAddress1, "moniker", "https://resolver.example", []string{"us"}, sdk.NewInt64Coin("stake", 100), sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2))
 	msgBytes, err := json.Marshal(msg)
 	if err != nil {
 		panic(err)
@@ -23,17 +23,17 @@ func TestMsgRegisterResolver_GetSignBytes(t *testing.T) {
 }
 
 func TestMsgRegisterResolver_GetSigners(t *testing.T) {
-	msg := NewMsgRegisterResolver(TestAddress1, sdk.NewDecWithPrec(1, 2))
+	msg := NewMsgRegisterResolver(TestAddress1, "moniker", "https://resolver.example", []string{"us"}, sdk.NewInt64Coin("stake", 100), sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2))
 	require.Equal(t, []sdk.AccAddress{TestAddress1}, msg.GetSigners())
 }
 
 func TestMsgRegisterResolver_Route(t *testing.T) {
-	msg := NewMsgRegisterResolver(TestAddress1, sdk.NewDecWithPrec(1, 2))
+	msg := NewMsgRegisterResolver(TestAddress1, "moniker", "https://resolver.example", []string{"us"}, sdk.NewInt64Coin("stake", 100), sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2))
 	require.Equal(t, RouterKey, msg.Route())
 }
 
 func TestMsgRegisterResolver_Type(t *testing.T) {
-	msg := NewMsgRegisterResolver(TestAddress1, sdk.NewDecWithPrec(1, 2))
+	msg := NewMsgRegisterResolver(TestAddress1, "moniker", "https://resolver.example", []string{"us"}, sdk.NewInt64Coin("stake", 100), sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2))
 	require.Equal(t, "register_resolver", msg.Type())
 }
 
@@ -45,27 +45,87 @@ func TestMsgRegisterResolver_ValidateBasic(t *testing.T) {
 	}{
 		{
 			"from is nil",
-			NewMsgRegisterResolver(nil, sdk.NewDecWithPrec(2, 1)),
+			NewMsgRegisterResolver(nil, "moniker", "https://resolver.example", []string{"us"}, sdk.NewInt64Coin("stake", 100), sdk.NewDecWithPrec(2, 1), sdk.OneDec(), sdk.OneDec()),
 			ErrorInvalidField("from"),
 		}, {
 			"from is empty",
-			NewMsgRegisterResolver([]byte(""), sdk.OneDec()),
+			NewMsgRegisterResolver([]byte(""), "moniker", "https://resolver.example", []string{"us"}, sdk.NewInt64Coin("stake", 100), sdk.OneDec(), sdk.OneDec(), sdk.OneDec()),
 			ErrorInvalidField("from"),
+		}, {
+			"moniker is empty",
+			NewMsgRegisterResolver(TestAddress1, "", "https://resolver.example", []string{"us"}, sdk.NewInt64Coin("stake", 100), sdk.OneDec(), sdk.OneDec(), sdk.OneDec()),
+			ErrorInvalidField("moniker"),
+		}, {
+			"endpoint is empty",
+			NewMsgRegisterResolver(TestAddress1, "moniker", "", []string{"us"}, sdk.NewInt64Coin("stake", 100), sdk.OneDec(), sdk.OneDec(), sdk.OneDec()),
+			ErrorInvalidField("endpoint"),
+		}, {
+			"endpoint is not a url",
+			NewMsgRegisterResolver(TestAddress1, "moniker", "resolver.example", []string{"us"}, sdk.NewInt64Coin("stake", 100), sdk.OneDec(), sdk.OneDec(), sdk.OneDec()),
+			ErrorInvalidField("endpoint"),
+		}, {
+			"endpoint scheme is invalid",
+			NewMsgRegisterResolver(TestAddress1, "moniker", "ftp://resolver.example", []string{"us"}, sdk.NewInt64Coin("stake", 100), sdk.OneDec(), sdk.OneDec(), sdk.OneDec()),
+			ErrorInvalidField("endpoint"),
+		}, {
+			"regions is nil",
+			NewMsgRegisterResolver(TestAddress1, "moniker", "https://resolver.example", nil, sdk.NewInt64Coin("stake", 100), sdk.OneDec(), sdk.OneDec(), sdk.OneDec()),
+			ErrorInvalidField("regions"),
+		}, {
+			"regions has an empty region",
+			NewMsgRegisterResolver(TestAddress1, "moniker", "https://resolver.example", []string{"us", ""}, sdk.NewInt64Coin("stake", 100), sdk.OneDec(), sdk.OneDec(), sdk.OneDec()),
+			ErrorInvalidField("regions"),
+		}, {
+			"regions has a duplicate region",
+			NewMsgRegisterResolver(TestAddress1, "moniker", "https://resolver.example", []string{"us", "us"}, sdk.NewInt64Coin("stake", 100), sdk.OneDec(), sdk.OneDec(), sdk.OneDec()),
+			ErrorInvalidField("regions"),
+		}, {
+			"deposit is empty",
+			NewMsgRegisterResolver(TestAddress1, "moniker", "https://resolver.example", []string{"us"}, sdk.Coin{}, sdk.OneDec(), sdk.OneDec(), sdk.OneDec()),
+			ErrorInvalidField("deposit"),
+		}, {
+			"deposit is zero",
+			NewMsgRegisterResolver(TestAddress1, "moniker", "https://resolver.example", []string{"us"}, sdk.NewInt64Coin("stake", 0), sdk.OneDec(), sdk.OneDec(), sdk.OneDec()),
+			ErrorInvalidField("deposit"),
 		}, {
 			"commission is negative",
-			NewMsgRegisterResolver(TestAddress1, sdk.NewDecWithPrec(-1, 0)),
+			NewMsgRegisterResolver(TestAddress1, "moniker", "https://resolver.example", []string{"us"}, sdk.NewInt64Coin("stake", 100), sdk.NewDecWithPrec(-1, 0), sdk.OneDec(), sdk.OneDec()),
 			ErrorInvalidField("commission"),
 		}, {
 			"commission is grater than 1",
-			NewMsgRegisterResolver(TestAddress2, sdk.NewDecWithPrec(2, 0)),
+			NewMsgRegisterResolver(TestAddress2, "moniker", "https://resolver.example", []string{"us"}, sdk.NewInt64Coin("stake", 100), sdk.NewDecWithPrec(2, 0), sdk.OneDec(), sdk.OneDec()),
 			ErrorInvalidField("commission"),
 		}, {
 			"commission with zero",
-			NewMsgRegisterResolver(TestAddress2, sdk.NewDecWithPrec(0, 0)),
+			NewMsgRegisterResolver(TestAddress2, "moniker", "https://resolver.example", []string{"us"}, sdk.NewInt64Coin("stake", 100), sdk.NewDecWithPrec(0, 0), sdk.OneDec(), sdk.OneDec()),
 			nil,
 		}, {
 			"commission with one",
-			NewMsgRegisterResolver(TestAddress2, sdk.NewDecWithPrec(1, 0)),
+			NewMsgRegisterResolver(TestAddress2, "moniker", "https://resolver.example", []string{"us"}, sdk.NewInt64Coin("stake", 100), sdk.NewDecWithPrec(1, 0), sdk.OneDec(), sdk.OneDec()),
+			nil,
+		}, {
+			"max commission rate is negative",
+			NewMsgRegisterResolver(TestAddress2, "moniker", "https://resolver.example", []string{"us"}, sdk.NewInt64Coin("stake", 100), sdk.ZeroDec(), sdk.NewDecWithPrec(-1, 0), sdk.ZeroDec()),
+			ErrorInvalidField("max_commission_rate"),
+		}, {
+			"max commission rate is greater than 1",
+			NewMsgRegisterResolver(TestAddress2, "moniker", "https://resolver.example", []string{"us"}, sdk.NewInt64Coin("stake", 100), sdk.ZeroDec(), sdk.NewDecWithPrec(2, 0), sdk.ZeroDec()),
+			ErrorInvalidField("max_commission_rate"),
+		}, {
+			"max commission change rate is negative",
+			NewMsgRegisterResolver(TestAddress2, "moniker", "https://resolver.example", []string{"us"}, sdk.NewInt64Coin("stake", 100), sdk.ZeroDec(), sdk.OneDec(), sdk.NewDecWithPrec(-1, 1)),
+			ErrorInvalidField("max_commission_change_rate"),
+		}, {
+			"max commission change rate is greater than max commission rate",
+			NewMsgRegisterResolver(TestAddress2, "moniker", "https://resolver.example", []string{"us"}, sdk.NewInt64Coin("stake", 100), sdk.ZeroDec(), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(3, 1)),
+			ErrorInvalidField("max_commission_change_rate"),
+		}, {
+			"commission is greater than max commission rate",
+			NewMsgRegisterResolver(TestAddress2, "moniker", "https://resolver.example", []string{"us"}, sdk.NewInt64Coin("stake", 100), sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 1)),
+			ErrorInvalidField("commission"),
+		}, {
+			"commission with max commission rate",
+			NewMsgRegisterResolver(TestAddress2, "moniker", "https://resolver.example", []string{"us"}, sdk.NewInt64Coin("stake", 100), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 1)),
 			nil,
 		},
 	}
@@ -80,7 +140,7 @@ func TestMsgRegisterResolver_ValidateBasic(t *testing.T) {
 }
 
 func TestMsgUpdateResolverInfo_GetSignBytes(t *testing.T) {
-	msg := NewMsgUpdateResolverInfo(TestAddress1, hub.NewResolverID(0), sdk.OneDec())
+	msg := NewMsgUpdateResolverInfo(TestAddress1, hub.NewResolverID(0), "", "", nil, decRef(sdk.OneDec()))
 	msgBytes, err := json.Marshal(msg)
 	if err != nil {
 		panic(err)
@@ -90,17 +150,17 @@ func TestMsgUpdateResolverInfo_GetSignBytes(t *testing.T) {
 }
 
 func TestMsgUpdateResolverInfo_GetSigners(t *testing.T) {
-	msg := NewMsgUpdateResolverInfo(TestAddress1, hub.NewResolverID(0), sdk.OneDec())
+	msg := NewMsgUpdateResolverInfo(TestAddress1, hub.NewResolverID(0), "", "", nil, decRef(sdk.OneDec()))
 	require.Equal(t, []sdk.AccAddress{TestAddress1}, msg.GetSigners())
 }
 
 func TestMsgUpdateResolverInfo_Route(t *testing.T) {
-	msg := NewMsgUpdateResolverInfo(TestAddress1, hub.NewResolverID(0), sdk.OneDec())
+	msg := NewMsgUpdateResolverInfo(TestAddress1, hub.NewResolverID(0), "", "", nil, decRef(sdk.OneDec()))
 	require.Equal(t, RouterKey, msg.Route())
 }
 
 func TestMsgUpdateResolverInfo_Type(t *testing.T) {
-	msg := NewMsgUpdateResolverInfo(TestAddress1, hub.NewResolverID(0), sdk.OneDec())
+	msg := NewMsgUpdateResolverInfo(TestAddress1, hub.NewResolverID(0), "", "", nil, decRef(sdk.OneDec()))
 	require.Equal(t, "update_resolver_info", msg.Type())
 }
 
@@ -112,31 +172,51 @@ func TestMsgUpdateResolverInfo_ValidateBasic(t *testing.T) {
 	}{
 		{
 			"from is nil",
-			NewMsgUpdateResolverInfo(nil, hub.NewResolverID(0), sdk.NewDecWithPrec(2, 1)),
+			NewMsgUpdateResolverInfo(nil, hub.NewResolverID(0), "", "", nil, decRef(sdk.NewDecWithPrec(2, 1))),
 			ErrorInvalidField("from"),
 		}, {
 			"from is empty",
-			NewMsgUpdateResolverInfo([]byte(""), hub.NewResolverID(0), sdk.OneDec()),
+			NewMsgUpdateResolverInfo([]byte(""), hub.NewResolverID(0), "", "", nil, decRef(sdk.OneDec())),
 			ErrorInvalidField("from"),
 		}, {
 			"id is nil",
-			NewMsgUpdateResolverInfo(nil, nil, sdk.NewDecWithPrec(2, 1)),
+			NewMsgUpdateResolverInfo(nil, nil, "", "", nil, decRef(sdk.NewDecWithPrec(2, 1))),
 			ErrorInvalidField("from"),
+		}, {
+			"endpoint is not a url",
+			NewMsgUpdateResolverInfo(TestAddress1, hub.NewResolverID(0), "", "resolver.example", nil, decRef(sdk.OneDec())),
+			ErrorInvalidField("endpoint"),
+		}, {
+			"regions has a duplicate region",
+			NewMsgUpdateResolverInfo(TestAddress1, hub.NewResolverID(0), "", "", []string{"us", "us"}, decRef(sdk.OneDec())),
+			ErrorInvalidField("regions"),
+		}, {
+			"valid info",
+			NewMsgUpdateResolverInfo(TestAddress1, hub.NewResolverID(0), "moniker", "https://resolver.example", []string{"us", "eu"}, decRef(sdk.OneDec())),
+			nil,
 		}, {
 			"commission is negative",
-			NewMsgUpdateResolverInfo(TestAddress1, hub.NewResolverID(0), sdk.NewDecWithPrec(-1, 0)),
+			NewMsgUpdateResolverInfo(TestAddress1, hub.NewResolverID(0), "", "", nil, decRef(sdk.NewDecWithPrec(-1, 0))),
 			ErrorInvalidField("commission"),
 		}, {
 			"commission is grater than 1",
-			NewMsgUpdateResolverInfo(TestAddress2, hub.NewResolverID(0), sdk.NewDecWithPrec(2, 0)),
+			NewMsgUpdateResolverInfo(TestAddress2, hub.NewResolverID(0), "", "", nil, decRef(sdk.NewDecWithPrec(2, 0))),
+			ErrorInvalidField("commission"),
+		}, {
+			"commission is not given",
+			NewMsgUpdateResolverInfo(TestAddress1, hub.NewResolverID(0), "moniker", "", nil, nil),
+			nil,
+		}, {
+			"commission is nil",
+			NewMsgUpdateResolverInfo(TestAddress1, hub.NewResolverID(0), "", "", nil, &sdk.Dec{}),
 			ErrorInvalidField("commission"),
 		}, {
 			"commission with zero",
-			NewMsgUpdateResolverInfo(TestAddress2, hub.NewResolverID(0), sdk.NewDecWithPrec(0, 0)),
+			NewMsgUpdateResolverInfo(TestAddress2, hub.NewResolverID(0), "", "", nil, decRef(sdk.NewDecWithPrec(0, 0))),
 			nil,
 		}, {
 			"commission with one",
-			NewMsgUpdateResolverInfo(TestAddress2, hub.NewResolverID(0), sdk.NewDecWithPrec(1, 0)),
+			NewMsgUpdateResolverInfo(TestAddress2, hub.NewResolverID(0), "", "", nil, decRef(sdk.NewDecWithPrec(1, 0))),
 			nil,
 		},
 	}
@@ -207,3 +287,83 @@ func TestMsgDeregisterResolver_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgAcceptVPNOnResolver_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgAcceptVPNOnResolver
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgAcceptVPNOnResolver(nil, hub.NewResolverID(0), hub.NewNodeID(0)),
+			ErrorInvalidField("from"),
+		}, {
+			"from is empty",
+			NewMsgAcceptVPNOnResolver([]byte(""), hub.NewResolverID(0), hub.NewNodeID(0)),
+			ErrorInvalidField("from"),
+		}, {
+			"resolver id is nil",
+			NewMsgAcceptVPNOnResolver(TestAddress3, nil, hub.NewNodeID(0)),
+			ErrorInvalidField("resolver_id"),
+		}, {
+			"node id is nil",
+			NewMsgAcceptVPNOnResolver(TestAddress3, hub.NewResolverID(0), nil),
+			ErrorInvalidField("node_id"),
+		}, {
+			"valid input",
+			NewMsgAcceptVPNOnResolver(TestAddress3, hub.NewResolverID(0), hub.NewNodeID(0)),
+			nil,
+		},
+	}
+	
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMsgRejectVPNOnResolver_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRejectVPNOnResolver
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgRejectVPNOnResolver(nil, hub.NewResolverID(0), hub.NewNodeID(0)),
+			ErrorInvalidField("from"),
+		}, {
+			"from is empty",
+			NewMsgRejectVPNOnResolver([]byte(""), hub.NewResolverID(0), hub.NewNodeID(0)),
+			ErrorInvalidField("from"),
+		}, {
+			"resolver id is nil",
+			NewMsgRejectVPNOnResolver(TestAddress3, nil, hub.NewNodeID(0)),
+			ErrorInvalidField("resolver_id"),
+		}, {
+			"node id is nil",
+			NewMsgRejectVPNOnResolver(TestAddress3, hub.NewResolverID(0), nil),
+			ErrorInvalidField("node_id"),
+		}, {
+			"valid input",
+			NewMsgRejectVPNOnResolver(TestAddress3, hub.NewResolverID(0), hub.NewNodeID(0)),
+			nil,
+		},
+	}
+	
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func decRef(d sdk.Dec) *sdk.Dec {
+	return &d
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"testing"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	
+	hub "github.com/sentinel-official/hub/types"
+)
+
+func TestResolver_IsValid(t *testing.T) {
+	var resolver Resolver
+	require.NotNil(t, resolver.IsValid())
+	
+	resolver.ID = hub.NewResolverID(0)
+	require.NotNil(t, resolver.IsValid())
+	
+	resolver.Owner = TestAddress3
+	require.NotNil(t, resolver.IsValid())
+	
+	resolver.Moniker = "moniker"
+	require.NotNil(t, resolver.IsValid())
+	
+	resolver.Endpoint = "resolver.example"
+	require.NotNil(t, resolver.IsValid())
+	
+	resolver.Endpoint = "https://resolver.example"
+	require.NotNil(t, resolver.IsValid())
+	
+	resolver.Regions = []string{"us", "us"}
+	require.NotNil(t, resolver.IsValid())
+	
+	resolver.Regions = []string{"us", "eu"}
+	require.NotNil(t, resolver.IsValid())
+	
+	resolver.Deposit = sdk.NewInt64Coin("stake", 100)
+	require.NotNil(t, resolver.IsValid())
+	
+	resolver.MaxCommissionRate = sdk.NewDecWithPrec(12, 1)
+	require.NotNil(t, resolver.IsValid())
+	
+	resolver.MaxCommissionRate = sdk.NewDecWithPrec(5, 1)
+	require.NotNil(t, resolver.IsValid())
+	
+	resolver.MaxCommissionChangeRate = sdk.NewDecWithPrec(6, 1)
+	require.NotNil(t, resolver.IsValid())
+	
+	resolver.MaxCommissionChangeRate = sdk.NewDecWithPrec(1, 1)
+	require.NotNil(t, resolver.IsValid())
+	
+	resolver.Commission = sdk.NewDecWithPrec(6, 1)
+	require.NotNil(t, resolver.IsValid())
+	
+	resolver.Commission = sdk.NewDecWithPrec(12, 2)
+	require.NotNil(t, resolver.IsValid())
+	
+	resolver.Status = StatusInactive
+	require.NotNil(t, resolver.IsValid())
+	
+	resolver.Status = StatusRegistered
+	require.Nil(t, resolver.IsValid())
+}
+
+func TestResolver_ValidateCommissionChange(t *testing.T) {
+	resolver := TestResolver
+	resolver.CommissionUpdatedAt = 10
+	
+	require.Equal(t, ErrorCommissionRateTooHigh(), resolver.ValidateCommissionChange(sdk.NewDecWithPrec(6, 1), 110, 100))
+	require.Equal(t, ErrorCommissionChangeTooHigh(), resolver.ValidateCommissionChange(sdk.NewDecWithPrec(3, 1), 110, 100))
+	require.Equal(t, ErrorCommissionChangeTooHigh(), resolver.ValidateCommissionChange(sdk.NewDecWithPrec(1, 2), 110, 100))
+	require.Equal(t, ErrorCommissionUpdatedTooSoon(), resolver.ValidateCommissionChange(sdk.NewDecWithPrec(2, 1), 109, 100))
+	require.Nil(t, resolver.ValidateCommissionChange(sdk.NewDecWithPrec(2, 1), 110, 100))
+	require.Nil(t, resolver.ValidateCommissionChange(sdk.NewDecWithPrec(2, 2), 110, 100))
+}
+
+func TestResolvers_WithRegion(t *testing.T) {
+	resolver1 := TestResolver
+	resolver2 := TestResolver
+	resolver2.ID = hub.NewResolverID(1)
+	resolver2.Regions = []string{"eu", "us"}
+	resolver3 := TestResolver
+	resolver3.ID = hub.NewResolverID(2)
+	resolver3.Regions = []string{"eu"}
+	resolver4 := resolver3
+	resolver4.ID = hub.NewResolverID(3)
+	resolver4.Status = StatusUnbonding
+	
+	resolvers := Resolvers{resolver1, resolver2, resolver3, resolver4}
+	require.Equal(t, Resolvers{resolver1, resolver2}, resolvers.WithRegion("us"))
+	require.Equal(t, Resolvers{resolver2, resolver3}, resolvers.WithRegion("eu"))
+	require.Equal(t, Resolvers(nil), resolvers.WithRegion("asia"))
+}
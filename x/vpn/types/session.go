@@ -3,6 +3,9 @@ package types
 import (
 	"fmt"
 	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	
 	hub "github.com/sentinel-official/hub/types"
 )
 
@@ -24,12 +27,61 @@ func (s Session) String() string {
 }
 
 func (s Session) IsValid() error {
+	if s.ID == nil {
+		return fmt.Errorf("invalid id")
+	}
+	if s.SubscriptionID == nil {
+		return fmt.Errorf("invalid subscription id")
+	}
 	if s.Bandwidth.AnyNil() {
 		return fmt.Errorf("invalid bandwidth")
 	}
-	if s.Status != StatusRegistered && s.Status != StatusDeRegistered {
+	if s.Status != StatusActive && s.Status != StatusInactive {
 		return fmt.Errorf("invalid status")
 	}
 	
 	return nil
 }
+
+// SessionUpdate is the bandwidth of a session signed by both the node owner and the client
+type SessionUpdate struct {
+	SessionID          hub.SessionID     `json:"session_id"`
+	Bandwidth          hub.Bandwidth     `json:"bandwidth"`
+	NodeOwnerSignature auth.StdSignature `json:"node_owner_signature"`
+	ClientSignature    auth.StdSignature `json:"client_signature"`
+}
+
+func NewSessionUpdate(sessionID hub.SessionID, bandwidth hub.Bandwidth,
+	nodeOwnerSignature, clientSignature auth.StdSignature) SessionUpdate {
+	return SessionUpdate{
+		SessionID:          sessionID,
+		Bandwidth:          bandwidth,
+		NodeOwnerSignature: nodeOwnerSignature,
+		ClientSignature:    clientSignature,
+	}
+}
+
+func (u SessionUpdate) IsValid() error {
+	if u.SessionID == nil {
+		return fmt.Errorf("invalid session id")
+	}
+	if u.Bandwidth.AnyNil() || !u.Bandwidth.AllPositive() {
+		return fmt.Errorf("invalid bandwidth")
+	}
+	if u.NodeOwnerSignature.Signature == nil || u.NodeOwnerSignature.PubKey == nil {
+		return fmt.Errorf("invalid node owner signature")
+	}
+	if u.ClientSignature.Signature == nil || u.ClientSignature.PubKey == nil {
+		return fmt.Errorf("invalid client signature")
+	}
+	
+	return nil
+}
+
+// SessionUpdateResult is the outcome of a single update of a batch, a zero code means the update is applied
+type SessionUpdateResult struct {
+	SessionID hub.SessionID     `json:"session_id"`
+	Codespace sdk.CodespaceType `json:"codespace,omitempty"`
+	Code      sdk.CodeType      `json:"code"`
+	Log       string            `json:"log,omitempty"`
+}
@@ -9,14 +9,60 @@ import (
 	hub "github.com/sentinel-official/hub/types"
 )
 
+var _ sdk.Msg = (*MsgStartSession)(nil)
+
+type MsgStartSession struct {
+	From           sdk.AccAddress     `json:"from"`
+	SubscriptionID hub.SubscriptionID `json:"subscription_id"`
+}
+
+func (msg MsgStartSession) Type() string {
+	return "start_session"
+}
+
+func (msg MsgStartSession) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if msg.SubscriptionID == nil {
+		return ErrorInvalidField("subscription_id")
+	}
+	
+	return nil
+}
+
+func (msg MsgStartSession) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	
+	return bz
+}
+
+func (msg MsgStartSession) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgStartSession) Route() string {
+	return RouterKey
+}
+
+func NewMsgStartSession(from sdk.AccAddress, subscriptionID hub.SubscriptionID) *MsgStartSession {
+	return &MsgStartSession{
+		From:           from,
+		SubscriptionID: subscriptionID,
+	}
+}
+
 var _ sdk.Msg = (*MsgUpdateSessionInfo)(nil)
 
 type MsgUpdateSessionInfo struct {
-	From               sdk.AccAddress     `json:"from"`
-	SubscriptionID     hub.SubscriptionID `json:"subscription_id"`
-	Bandwidth          hub.Bandwidth      `json:"bandwidth"`
-	NodeOwnerSignature auth.StdSignature  `json:"node_owner_signature"`
-	ClientSignature    auth.StdSignature  `json:"client_signature"`
+	From               sdk.AccAddress    `json:"from"`
+	SessionID          hub.SessionID     `json:"session_id"`
+	Bandwidth          hub.Bandwidth     `json:"bandwidth"`
+	NodeOwnerSignature auth.StdSignature `json:"node_owner_signature"`
+	ClientSignature    auth.StdSignature `json:"client_signature"`
 }
 
 func (msg MsgUpdateSessionInfo) Type() string {
@@ -27,6 +73,9 @@ func (msg MsgUpdateSessionInfo) ValidateBasic() sdk.Error {
 	if msg.From == nil || msg.From.Empty() {
 		return ErrorInvalidField("from")
 	}
+	if msg.SessionID == nil {
+		return ErrorInvalidField("session_id")
+	}
 	if !msg.Bandwidth.AllPositive() {
 		return ErrorInvalidField("bandwidth")
 	}
@@ -58,22 +107,74 @@ func (msg MsgUpdateSessionInfo) Route() string {
 }
 
 func NewMsgUpdateSessionInfo(from sdk.AccAddress,
-	subscriptionID hub.SubscriptionID, bandwidth hub.Bandwidth,
+	sessionID hub.SessionID, bandwidth hub.Bandwidth,
 	nodeOwnerSignature, clientSignature auth.StdSignature) *MsgUpdateSessionInfo {
 	return &MsgUpdateSessionInfo{
 		From:               from,
-		SubscriptionID:     subscriptionID,
+		SessionID:          sessionID,
 		Bandwidth:          bandwidth,
 		NodeOwnerSignature: nodeOwnerSignature,
 		ClientSignature:    clientSignature,
 	}
 }
 
+var _ sdk.Msg = (*MsgBatchUpdateSessions)(nil)
+
+// MsgBatchUpdateSessions carries the signed bandwidths of many sessions in a single transaction
+type MsgBatchUpdateSessions struct {
+	From    sdk.AccAddress  `json:"from"`
+	Updates []SessionUpdate `json:"updates"`
+}
+
+func (msg MsgBatchUpdateSessions) Type() string {
+	return "batch_update_sessions"
+}
+
+func (msg MsgBatchUpdateSessions) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if len(msg.Updates) == 0 {
+		return ErrorInvalidField("updates")
+	}
+	for _, update := range msg.Updates {
+		if err := update.IsValid(); err != nil {
+			return ErrorInvalidField("updates: " + err.Error())
+		}
+	}
+	
+	return nil
+}
+
+func (msg MsgBatchUpdateSessions) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	
+	return bz
+}
+
+func (msg MsgBatchUpdateSessions) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgBatchUpdateSessions) Route() string {
+	return RouterKey
+}
+
+func NewMsgBatchUpdateSessions(from sdk.AccAddress, updates []SessionUpdate) *MsgBatchUpdateSessions {
+	return &MsgBatchUpdateSessions{
+		From:    from,
+		Updates: updates,
+	}
+}
+
 var _ sdk.Msg = (*MsgEndSession)(nil)
 
 type MsgEndSession struct {
-	From           sdk.AccAddress     `json:"from"`
-	SubscriptionID hub.SubscriptionID `json:"subscription_id"`
+	From      sdk.AccAddress `json:"from"`
+	SessionID hub.SessionID  `json:"session_id"`
 }
 
 func (msg MsgEndSession) Type() string {
@@ -84,6 +185,9 @@ func (msg MsgEndSession) ValidateBasic() sdk.Error {
 	if msg.From == nil || msg.From.Empty() {
 		return ErrorInvalidField("from")
 	}
+	if msg.SessionID == nil {
+		return ErrorInvalidField("session_id")
+	}
 
 	return nil
 }
@@ -105,9 +209,55 @@ func (msg MsgEndSession) Route() string {
 	return RouterKey
 }
 
-func NewMsgEndSession(from sdk.AccAddress, subscriptionID hub.SubscriptionID) *MsgEndSession {
+func NewMsgEndSession(from sdk.AccAddress, sessionID hub.SessionID) *MsgEndSession {
 	return &MsgEndSession{
-		From:           from,
-		SubscriptionID: subscriptionID,
+		From:      from,
+		SessionID: sessionID,
+	}
+}
+
+var _ sdk.Msg = (*MsgSubmitEvidence)(nil)
+
+type MsgSubmitEvidence struct {
+	From     sdk.AccAddress `json:"from"`
+	Evidence Evidence       `json:"evidence"`
+}
+
+func (msg MsgSubmitEvidence) Type() string {
+	return "submit_evidence"
+}
+
+func (msg MsgSubmitEvidence) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if err := msg.Evidence.IsValid(); err != nil {
+		return ErrorInvalidField("evidence")
+	}
+	
+	return nil
+}
+
+func (msg MsgSubmitEvidence) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	
+	return bz
+}
+
+func (msg MsgSubmitEvidence) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgSubmitEvidence) Route() string {
+	return RouterKey
+}
+
+func NewMsgSubmitEvidence(from sdk.AccAddress, evidence Evidence) *MsgSubmitEvidence {
+	return &MsgSubmitEvidence{
+		From:     from,
+		Evidence: evidence,
 	}
 }
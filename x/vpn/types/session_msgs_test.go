@@ -12,6 +12,40 @@ import (
 	hub "github.com/sentinel-official/hub/types"
 )
 
+func TestMsgStartSession_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgStartSession
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgStartSession(nil, hub.NewSubscriptionID(1)),
+			ErrorInvalidField("from"),
+		}, {
+			"from is empty",
+			NewMsgStartSession([]byte(""), hub.NewSubscriptionID(1)),
+			ErrorInvalidField("from"),
+		}, {
+			"subscription id is nil",
+			NewMsgStartSession(TestAddress2, nil),
+			ErrorInvalidField("subscription_id"),
+		}, {
+			"valid",
+			NewMsgStartSession(TestAddress2, hub.NewSubscriptionID(1)),
+			nil,
+		},
+	}
+	
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
 func TestMsgUpdateSessionInfo_ValidateBasic(t *testing.T) {
 	tests := []struct {
 		name string
@@ -20,35 +54,39 @@ func TestMsgUpdateSessionInfo_ValidateBasic(t *testing.T) {
 	}{
 		{
 			"from is nil",
-			NewMsgUpdateSessionInfo(nil, hub.NewSubscriptionID(1), TestBandwidthPos1, TestNodeOwnerStdSignaturePos1, TestClientStdSignaturePos1),
+			NewMsgUpdateSessionInfo(nil, hub.NewSessionID(1), TestBandwidthPos1, TestNodeOwnerStdSignaturePos1, TestClientStdSignaturePos1),
 			ErrorInvalidField("from"),
 		}, {
 			"from is empty",
-			NewMsgUpdateSessionInfo([]byte(""), hub.NewSubscriptionID(1), TestBandwidthPos1, TestNodeOwnerStdSignaturePos1, TestClientStdSignaturePos1),
+			NewMsgUpdateSessionInfo([]byte(""), hub.NewSessionID(1), TestBandwidthPos1, TestNodeOwnerStdSignaturePos1, TestClientStdSignaturePos1),
 			ErrorInvalidField("from"),
+		}, {
+			"session id is nil",
+			NewMsgUpdateSessionInfo(TestAddress1, nil, TestBandwidthPos1, TestNodeOwnerStdSignaturePos1, TestClientStdSignaturePos1),
+			ErrorInvalidField("session_id"),
 		}, {
 			"bandwidth is zero",
-			NewMsgUpdateSessionInfo(TestAddress1, hub.NewSubscriptionID(1), TestBandwidthZero, TestNodeOwnerStdSignaturePos1, TestClientStdSignaturePos1),
+			NewMsgUpdateSessionInfo(TestAddress1, hub.NewSessionID(1), TestBandwidthZero, TestNodeOwnerStdSignaturePos1, TestClientStdSignaturePos1),
 			ErrorInvalidField("bandwidth"),
 		}, {
 			"bandwidth is neg",
-			NewMsgUpdateSessionInfo(TestAddress1, hub.NewSubscriptionID(1), TestBandwidthNeg, TestNodeOwnerStdSignaturePos1, TestClientStdSignaturePos1),
+			NewMsgUpdateSessionInfo(TestAddress1, hub.NewSessionID(1), TestBandwidthNeg, TestNodeOwnerStdSignaturePos1, TestClientStdSignaturePos1),
 			ErrorInvalidField("bandwidth"),
 		}, {
 			"bandwidth is zero",
-			NewMsgUpdateSessionInfo(TestAddress1, hub.NewSubscriptionID(1), TestBandwidthZero, TestNodeOwnerStdSignaturePos1, TestClientStdSignaturePos1),
+			NewMsgUpdateSessionInfo(TestAddress1, hub.NewSessionID(1), TestBandwidthZero, TestNodeOwnerStdSignaturePos1, TestClientStdSignaturePos1),
 			ErrorInvalidField("bandwidth"),
 		}, {
 			"node owner sign is empty  ",
-			NewMsgUpdateSessionInfo(TestAddress1, hub.NewSubscriptionID(1), TestBandwidthPos1, auth.StdSignature{}, TestClientStdSignaturePos1),
+			NewMsgUpdateSessionInfo(TestAddress1, hub.NewSessionID(1), TestBandwidthPos1, auth.StdSignature{}, TestClientStdSignaturePos1),
 			ErrorInvalidField("node_owner_signature"),
 		}, {
 			"client sign is empty  ",
-			NewMsgUpdateSessionInfo(TestAddress1, hub.NewSubscriptionID(1), TestBandwidthPos1, TestNodeOwnerStdSignaturePos1, auth.StdSignature{}),
+			NewMsgUpdateSessionInfo(TestAddress1, hub.NewSessionID(1), TestBandwidthPos1, TestNodeOwnerStdSignaturePos1, auth.StdSignature{}),
 			ErrorInvalidField("client_signature"),
 		}, {
 			"valid ",
-			NewMsgUpdateSessionInfo(TestAddress1, hub.NewSubscriptionID(1), TestBandwidthPos1, TestNodeOwnerStdSignaturePos1, TestClientStdSignaturePos1),
+			NewMsgUpdateSessionInfo(TestAddress1, hub.NewSessionID(1), TestBandwidthPos1, TestNodeOwnerStdSignaturePos1, TestClientStdSignaturePos1),
 			nil,
 		},
 	}
@@ -63,7 +101,7 @@ func TestMsgUpdateSessionInfo_ValidateBasic(t *testing.T) {
 }
 
 func TestMsgUpdateSessionInfo_GetSignBytes(t *testing.T) {
-	msg := NewMsgUpdateSessionInfo(TestAddress1, hub.NewSubscriptionID(1), TestBandwidthPos1, TestNodeOwnerStdSignaturePos1, TestClientStdSignaturePos1)
+	msg := NewMsgUpdateSessionInfo(TestAddress1, hub.NewSessionID(1), TestBandwidthPos1, TestNodeOwnerStdSignaturePos1, TestClientStdSignaturePos1)
 	msgBytes, err := json.Marshal(msg)
 	if err != nil {
 		panic(err)
@@ -73,16 +111,110 @@ func TestMsgUpdateSessionInfo_GetSignBytes(t *testing.T) {
 }
 
 func TestMsgUpdateSessionInfo_GetSigners(t *testing.T) {
-	msg := NewMsgUpdateSessionInfo(TestAddress1, hub.NewSubscriptionID(1), TestBandwidthPos1, TestNodeOwnerStdSignaturePos1, TestClientStdSignaturePos1)
+	msg := NewMsgUpdateSessionInfo(TestAddress1, hub.NewSessionID(1), TestBandwidthPos1, TestNodeOwnerStdSignaturePos1, TestClientStdSignaturePos1)
 	require.Equal(t, []sdk.AccAddress{TestAddress1}, msg.GetSigners())
 }
 
 func TestMsgUpdateSessionInfo_Type(t *testing.T) {
-	msg := NewMsgUpdateSessionInfo(TestAddress1, hub.NewSubscriptionID(1), TestBandwidthPos1, TestNodeOwnerStdSignaturePos1, TestClientStdSignaturePos1)
+	msg := NewMsgUpdateSessionInfo(TestAddress1, hub.NewSessionID(1), TestBandwidthPos1, TestNodeOwnerStdSignaturePos1, TestClientStdSignaturePos1)
 	require.Equal(t, "update_session_info", msg.Type())
 }
 
 func TestMsgUpdateSessionInfo_Route(t *testing.T) {
-	msg := NewMsgUpdateSessionInfo(TestAddress1, hub.NewSubscriptionID(1), TestBandwidthPos1, TestNodeOwnerStdSignaturePos1, TestClientStdSignaturePos1)
+	msg := NewMsgUpdateSessionInfo(TestAddress1, hub.NewSessionID(1), TestBandwidthPos1, TestNodeOwnerStdSignaturePos1, TestClientStdSignaturePos1)
 	require.Equal(t, RouterKey, msg.Route())
 }
+
+func TestMsgBatchUpdateSessions_ValidateBasic(t *testing.T) {
+	update := NewSessionUpdate(hub.NewSessionID(0), TestBandwidthPos1, TestNodeOwnerStdSignaturePos1, TestClientStdSignaturePos1)
+	
+	tests := []struct {
+		name string
+		msg  *MsgBatchUpdateSessions
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgBatchUpdateSessions(nil, []SessionUpdate{update}),
+			ErrorInvalidField("from"),
+		}, {
+			"updates are empty",
+			NewMsgBatchUpdateSessions(TestAddress1, nil),
+			ErrorInvalidField("updates"),
+		}, {
+			"session id is nil",
+			NewMsgBatchUpdateSessions(TestAddress1, []SessionUpdate{update,
+				NewSessionUpdate(nil, TestBandwidthPos1, TestNodeOwnerStdSignaturePos1, TestClientStdSignaturePos1)}),
+			ErrorInvalidField("updates: invalid session id"),
+		}, {
+			"bandwidth is nil",
+			NewMsgBatchUpdateSessions(TestAddress1, []SessionUpdate{
+				NewSessionUpdate(hub.NewSessionID(0), hub.Bandwidth{}, TestNodeOwnerStdSignaturePos1, TestClientStdSignaturePos1)}),
+			ErrorInvalidField("updates: invalid bandwidth"),
+		}, {
+			"bandwidth is zero",
+			NewMsgBatchUpdateSessions(TestAddress1, []SessionUpdate{
+				NewSessionUpdate(hub.NewSessionID(0), TestBandwidthZero, TestNodeOwnerStdSignaturePos1, TestClientStdSignaturePos1)}),
+			ErrorInvalidField("updates: invalid bandwidth"),
+		}, {
+			"node owner sign is empty",
+			NewMsgBatchUpdateSessions(TestAddress1, []SessionUpdate{
+				NewSessionUpdate(hub.NewSessionID(0), TestBandwidthPos1, auth.StdSignature{}, TestClientStdSignaturePos1)}),
+			ErrorInvalidField("updates: invalid node owner signature"),
+		}, {
+			"client sign is empty",
+			NewMsgBatchUpdateSessions(TestAddress1, []SessionUpdate{
+				NewSessionUpdate(hub.NewSessionID(0), TestBandwidthPos1, TestNodeOwnerStdSignaturePos1, auth.StdSignature{})}),
+			ErrorInvalidField("updates: invalid client signature"),
+		}, {
+			"valid",
+			NewMsgBatchUpdateSessions(TestAddress1, []SessionUpdate{update, update}),
+			nil,
+		},
+	}
+	
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMsgSubmitEvidence_ValidateBasic(t *testing.T) {
+	evidence := NewEvidence(hub.NewSubscriptionID(0), hub.NewSessionID(0), TestBandwidthPos1, TestNodeOwnerStdSignaturePos1,
+		TestBandwidthPos2, TestNodeOwnerStdSignaturePos2)
+	
+	tests := []struct {
+		name string
+		msg  *MsgSubmitEvidence
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgSubmitEvidence(nil, evidence),
+			ErrorInvalidField("from"),
+		}, {
+			"from is empty",
+			NewMsgSubmitEvidence([]byte(""), evidence),
+			ErrorInvalidField("from"),
+		}, {
+			"evidence is empty",
+			NewMsgSubmitEvidence(TestAddress2, Evidence{}),
+			ErrorInvalidField("evidence"),
+		}, {
+			"valid",
+			NewMsgSubmitEvidence(TestAddress2, evidence),
+			nil,
+		},
+	}
+	
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
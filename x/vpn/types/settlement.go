@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	hub "github.com/sentinel-official/hub/types"
+)
+
+type Settlement struct {
+	SessionID      hub.SessionID      `json:"session_id"`
+	SubscriptionID hub.SubscriptionID `json:"subscription_id"`
+	Payer          sdk.AccAddress     `json:"payer"`
+	NodePayee      sdk.AccAddress     `json:"node_payee"`
+	ResolverPayee  sdk.AccAddress     `json:"resolver_payee"`
+	Amount         sdk.Coin           `json:"amount"`
+	Commission     sdk.Coin           `json:"commission"`
+	Bandwidth      hub.Bandwidth      `json:"bandwidth"`
+	Height         int64              `json:"height"`
+}
+
+func (s Settlement) String() string {
+	return fmt.Sprintf(`Settlement
+  Session ID:          %s
+  Subscription ID:     %s
+  Payer:               %s
+  Node Payee:          %s
+  Resolver Payee:      %s
+  Amount:              %s
+  Commission:          %s
+  Bandwidth:           %s
+  Height:              %d`, s.SessionID, s.SubscriptionID, s.Payer, s.NodePayee, s.ResolverPayee,
+		s.Amount, s.Commission, s.Bandwidth, s.Height)
+}
+
+// NodeAmount returns the part of the settled amount paid to the node owner
+func (s Settlement) NodeAmount() sdk.Coin {
+	return s.Amount.Sub(s.Commission)
+}
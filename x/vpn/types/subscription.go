@@ -2,26 +2,49 @@ package types
 
 import (
 	"fmt"
+	"time"
 	
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	
 	hub "github.com/sentinel-official/hub/types"
 )
 
+// Subscription locks in the commission of the resolver at its start, so that later changes of the
+// commission apply to new subscriptions only. Likewise the price of the plan is converted to a denomination of
+// the deposit at the exchange rates in force at the start, and QuotedPrice keeps the price the node quoted.
+// A subscription may be funded in several denominations. Deposits are the coins still locked for it, and
+// TotalDeposit and RemainingDeposit their worth in the denomination of the plan at ExchangeRates, the rates
+// of the denominations of the deposit in force at the start, which the deposit is drawn down at.
+// DepositLocked records whether the deposit was locked at the start. The deposit of a client with an unlimited
+// free grant at the start is not locked, and is neither billed nor unlocked when the subscription settles.
 type Subscription struct {
 	ID                 hub.SubscriptionID `json:"id"`
 	ResolverID         hub.ResolverID     `json:"resolver_id"`
 	NodeID             hub.NodeID         `json:"node_id"`
 	Client             sdk.AccAddress     `json:"client"`
 	PricePerGB         sdk.Coin           `json:"price_per_gb"`
+	Commission         sdk.Dec            `json:"commission"`
+	Plan               Plan               `json:"plan"`
+	ExpiresAt          time.Time          `json:"expires_at"`
 	TotalDeposit       sdk.Coin           `json:"total_deposit"`
 	RemainingDeposit   sdk.Coin           `json:"remaining_deposit"`
 	RemainingBandwidth hub.Bandwidth      `json:"remaining_bandwidth"`
 	Status             string             `json:"status"`
 	StatusModifiedAt   int64              `json:"status_modified_at"`
+	QuotedPrice        sdk.Coin           `json:"quoted_price"`
+	DepositLocked      bool               `json:"deposit_locked"`
+	Deposits           sdk.Coins          `json:"deposits"`
+	ExchangeRates      ExchangeRates      `json:"exchange_rates"`
 }
 
 func (s Subscription) TotalBandwidth() hub.Bandwidth {
+	if s.Plan.IsTimeBased() {
+		return hub.NewBandwidthFromInt64(0, 0)
+	}
+	if s.Plan.Type == PlanTypeFlatRate {
+		return s.Plan.Bandwidth
+	}
+	
 	x := s.TotalDeposit.Amount.
 		Mul(hub.MB500).
 		Quo(s.PricePerGB.Amount)
@@ -36,14 +59,22 @@ func (s Subscription) String() string {
   Node ID:             %s
   Client Address:      %s
   Price Per GB:        %s
+  Commission:          %s
+  Plan Type:           %s
+  Plan Price:          %s
+  Quoted Price:        %s
+  Expires At:          %s
   Total Deposit:       %s
   Total Bandwidth:     %s
   Remaining Deposit:   %s
   Remaining Bandwidth: %s
+  Deposit Locked:      %t
+  Deposits:            %s
+  Exchange Rates:      %s
   Status:              %s
   Status Modified At:  %d`, s.ID, s.ResolverID, s.NodeID, s.Client,
-		s.PricePerGB, s.TotalDeposit, s.TotalBandwidth(),
-		s.RemainingDeposit, s.RemainingBandwidth, s.Status, s.StatusModifiedAt)
+		s.PricePerGB, s.Commission, s.Plan.Type, s.Plan.Price, s.QuotedPrice, s.ExpiresAt, s.TotalDeposit, s.TotalBandwidth(),
+		s.RemainingDeposit, s.RemainingBandwidth, s.DepositLocked, s.Deposits, s.ExchangeRates, s.Status, s.StatusModifiedAt)
 }
 
 func (s Subscription) IsValid() error {
@@ -56,8 +87,15 @@ func (s Subscription) IsValid() error {
 	if s.Client == nil || s.Client.Empty() {
 		return fmt.Errorf("invalid client")
 	}
-	if s.PricePerGB.Denom == "" || s.PricePerGB.IsZero() {
-		return fmt.Errorf("invalid price per gb")
+	if s.Plan.IsPerGB() {
+		if s.PricePerGB.Denom == "" || s.PricePerGB.IsZero() {
+			return fmt.Errorf("invalid price per gb")
+		}
+	} else if err := s.Plan.IsValid(); err != nil || s.ExpiresAt.IsZero() {
+		return fmt.Errorf("invalid plan")
+	}
+	if s.Commission.IsNil() || s.Commission.IsNegative() || s.Commission.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid commission")
 	}
 	if s.TotalDeposit.Denom != s.PricePerGB.Denom || s.TotalDeposit.IsZero() {
 		return fmt.Errorf("invalid total deposit")
@@ -65,6 +103,12 @@ func (s Subscription) IsValid() error {
 	if s.RemainingDeposit.Denom != s.TotalDeposit.Denom || s.TotalDeposit.IsLT(s.RemainingDeposit) {
 		return fmt.Errorf("invalid remaining deposit")
 	}
+	if !s.Deposits.IsValid() {
+		return fmt.Errorf("invalid deposits")
+	}
+	if err := s.ExchangeRates.Validate(); err != nil {
+		return fmt.Errorf("invalid exchange rates")
+	}
 	if s.RemainingBandwidth.AnyNil() || s.TotalBandwidth().AnyLT(s.RemainingBandwidth) {
 		return fmt.Errorf("invalid total remaining bandwidth")
 	}
@@ -74,3 +118,78 @@ func (s Subscription) IsValid() error {
 	
 	return nil
 }
+
+// GetCommission returns the commission of the resolver from the pay at the rate locked in by the subscription
+func (s Subscription) GetCommission(pay sdk.Coin) sdk.Coin {
+	return commissionOf(s.Commission, pay)
+}
+
+// IsExpired reports whether a time based or flat-rate subscription has run out of time
+func (s Subscription) IsExpired(t time.Time) bool {
+	return !s.ExpiresAt.IsZero() && !t.Before(s.ExpiresAt)
+}
+
+// UnusedDeposit returns the part of the remaining deposit which is not consumed by the plan at the given time
+func (s Subscription) UnusedDeposit(t time.Time) sdk.Coin {
+	switch {
+	case s.Plan.IsTimeBased():
+		unused := s.TotalDeposit.Amount.Mod(s.Plan.Price.Amount)
+		if t.Before(s.ExpiresAt) {
+			periods := int64(s.ExpiresAt.Sub(t) / s.Plan.Period())
+			unused = unused.Add(s.Plan.Price.Amount.MulRaw(periods))
+		}
+		if unused.GT(s.RemainingDeposit.Amount) {
+			unused = s.RemainingDeposit.Amount
+		}
+		
+		return sdk.NewCoin(s.RemainingDeposit.Denom, unused)
+	case s.Plan.Type == PlanTypeFlatRate:
+		if s.RemainingDeposit.IsLT(s.Plan.Price) {
+			return sdk.NewInt64Coin(s.RemainingDeposit.Denom, 0)
+		}
+		
+		return s.RemainingDeposit.Sub(s.Plan.Price)
+	default:
+		return s.RemainingDeposit
+	}
+}
+
+// Draw returns the coins of the deposit worth the amount in the denomination of the plan. The coins in the
+// denomination of the plan are drawn first, and the others at the exchange rates of the start, rounded up.
+// Fewer coins are returned if the deposit is not worth the amount.
+func (s Subscription) Draw(amount sdk.Coin) sdk.Coins {
+	coins := sdk.Coins{}
+	needed := amount.Amount
+	
+	if available := s.Deposits.AmountOf(amount.Denom); available.IsPositive() && needed.IsPositive() {
+		coin := sdk.NewCoin(amount.Denom, sdk.MinInt(available, needed))
+		coins = coins.Add(sdk.Coins{coin})
+		needed = needed.Sub(coin.Amount)
+	}
+	
+	for _, available := range s.Deposits {
+		if !needed.IsPositive() {
+			break
+		}
+		if available.Denom == amount.Denom {
+			continue
+		}
+		
+		coin, found := s.ExchangeRates.Convert(sdk.NewCoin(amount.Denom, needed), available.Denom)
+		if !found || !coin.IsPositive() {
+			continue
+		}
+		
+		if coin.Amount.GT(available.Amount) {
+			coin = available
+			value, _ := s.ExchangeRates.Value(sdk.Coins{coin}, amount.Denom)
+			needed = needed.Sub(sdk.MinInt(value.Amount, needed))
+		} else {
+			needed = sdk.ZeroInt()
+		}
+		
+		coins = coins.Add(sdk.Coins{coin})
+	}
+	
+	return coins
+}
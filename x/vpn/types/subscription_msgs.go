@@ -14,7 +14,8 @@ type MsgStartSubscription struct {
 	From       sdk.AccAddress `json:"from"`
 	ResolverID hub.ResolverID `json:"resolver_id"`
 	NodeID     hub.NodeID     `json:"node_id"`
-	Deposit    sdk.Coin       `json:"deposit"`
+	Deposit    sdk.Coins      `json:"deposit"`
+	Plan       uint64         `json:"plan"`
 }
 
 func (msg MsgStartSubscription) Type() string {
@@ -31,7 +32,7 @@ func (msg MsgStartSubscription) ValidateBasic() sdk.Error {
 	if msg.NodeID == nil || len(msg.NodeID) == 0 {
 		return ErrorInvalidField("node_id")
 	}
-	if msg.Deposit.Denom == "" || !msg.Deposit.IsPositive() {
+	if msg.Deposit.Empty() || !msg.Deposit.IsValid() || !msg.Deposit.IsAllPositive() {
 		return ErrorInvalidField("deposit")
 	}
 	
@@ -56,12 +57,13 @@ func (msg MsgStartSubscription) Route() string {
 }
 
 func NewMsgStartSubscription(from sdk.AccAddress, resolverID hub.ResolverID, nodeID hub.NodeID,
-	deposit sdk.Coin) *MsgStartSubscription {
+	deposit sdk.Coins, plan uint64) *MsgStartSubscription {
 	return &MsgStartSubscription{
 		From:       from,
 		ResolverID: resolverID,
 		NodeID:     nodeID,
 		Deposit:    deposit,
+		Plan:       plan,
 	}
 }
 
@@ -19,39 +19,53 @@ func TestMsgStartSubscription_ValidateBasic(t *testing.T) {
 	}{
 		{
 			"from is nil",
-			NewMsgStartSubscription(nil, hub.NewResolverID(0), hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100)),
+			NewMsgStartSubscription(nil, hub.NewResolverID(0), hub.NewNodeID(1), sdk.Coins{sdk.NewInt64Coin("stake", 100)}, 0),
 			ErrorInvalidField("from"),
 		}, {
 			"from is empty",
-			NewMsgStartSubscription([]byte(""), hub.NewResolverID(0), hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100)),
+			NewMsgStartSubscription([]byte(""), hub.NewResolverID(0), hub.NewNodeID(1), sdk.Coins{sdk.NewInt64Coin("stake", 100)}, 0),
 			ErrorInvalidField("from"),
 		}, {
 			"resolver id is nil",
-			NewMsgStartSubscription(TestAddress2, nil, hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100)),
+			NewMsgStartSubscription(TestAddress2, nil, hub.NewNodeID(1), sdk.Coins{sdk.NewInt64Coin("stake", 100)}, 0),
 			ErrorInvalidField("resolver"),
 		}, {
 			"resolver is empty",
-			NewMsgStartSubscription(TestAddress1, []byte(""), hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100)),
+			NewMsgStartSubscription(TestAddress1, []byte(""), hub.NewNodeID(1), sdk.Coins{sdk.NewInt64Coin("stake", 100)}, 0),
 			ErrorInvalidField("resolver"),
 		}, {
 			"node id is nil",
-			NewMsgStartSubscription(TestAddress1, hub.NewResolverID(0), nil, sdk.NewInt64Coin("stake", 100)),
+			NewMsgStartSubscription(TestAddress1, hub.NewResolverID(0), nil, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, 0),
 			ErrorInvalidField("node_id"),
 		}, {
 			"node id is empty",
-			NewMsgStartSubscription(TestAddress1, hub.NewResolverID(0), []byte(""), sdk.NewInt64Coin("stake", 100)),
+			NewMsgStartSubscription(TestAddress1, hub.NewResolverID(0), []byte(""), sdk.Coins{sdk.NewInt64Coin("stake", 100)}, 0),
 			ErrorInvalidField("node_id"),
+		}, {
+			"deposit is nil",
+			NewMsgStartSubscription(TestAddress1, hub.NewResolverID(0), hub.NewNodeID(1), nil, 0),
+			ErrorInvalidField("deposit"),
 		}, {
 			"deposit is empty",
-			NewMsgStartSubscription(TestAddress1, hub.NewResolverID(0), hub.NewNodeID(1), sdk.Coin{}),
+			NewMsgStartSubscription(TestAddress1, hub.NewResolverID(0), hub.NewNodeID(1), sdk.Coins{}, 0),
+			ErrorInvalidField("deposit"),
+		}, {
+			"deposit is not sorted",
+			NewMsgStartSubscription(TestAddress1, hub.NewResolverID(0), hub.NewNodeID(1),
+				sdk.Coins{sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("atom", 100)}, 0),
 			ErrorInvalidField("deposit"),
 		}, {
 			"deposit is zero",
-			NewMsgStartSubscription(TestAddress1, hub.NewResolverID(0), hub.NewNodeID(1), sdk.NewInt64Coin("stake", 0)),
+			NewMsgStartSubscription(TestAddress1, hub.NewResolverID(0), hub.NewNodeID(1), sdk.Coins{sdk.NewInt64Coin("stake", 0)}, 0),
 			ErrorInvalidField("deposit"),
 		}, {
 			"valid",
-			NewMsgStartSubscription(TestAddress1, hub.NewResolverID(0), hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100)),
+			NewMsgStartSubscription(TestAddress1, hub.NewResolverID(0), hub.NewNodeID(1), sdk.Coins{sdk.NewInt64Coin("stake", 100)}, 0),
+			nil,
+		}, {
+			"valid in several denominations",
+			NewMsgStartSubscription(TestAddress1, hub.NewResolverID(0), hub.NewNodeID(1),
+				sdk.Coins{sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("stake", 100)}, 0),
 			nil,
 		},
 	}
@@ -66,7 +80,7 @@ func TestMsgStartSubscription_ValidateBasic(t *testing.T) {
 }
 
 func TestMsgStartSubscription_GetSignBytes(t *testing.T) {
-	msg := NewMsgStartSubscription(TestAddress1, hub.NewResolverID(0), hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100))
+	msg := NewMsgStartSubscription(TestAddress1, hub.NewResolverID(0), hub.NewNodeID(1), sdk.Coins{sdk.NewInt64Coin("stake", 100)}, 0)
 	msgBytes, err := json.Marshal(msg)
 	if err != nil {
 		panic(err)
@@ -76,17 +90,17 @@ func TestMsgStartSubscription_GetSignBytes(t *testing.T) {
 }
 
 func TestMsgStartSubscription_GetSigners(t *testing.T) {
-	msg := NewMsgStartSubscription(TestAddress1, hub.NewResolverID(0), hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100))
+	msg := NewMsgStartSubscription(TestAddress1, hub.NewResolverID(0), hub.NewNodeID(1), sdk.Coins{sdk.NewInt64Coin("stake", 100)}, 0)
 	require.Equal(t, []sdk.AccAddress{TestAddress1}, msg.GetSigners())
 }
 
 func TestMsgStartSubscription_Type(t *testing.T) {
-	msg := NewMsgStartSubscription(TestAddress1, hub.NewResolverID(0), hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100))
+	msg := NewMsgStartSubscription(TestAddress1, hub.NewResolverID(0), hub.NewNodeID(1), sdk.Coins{sdk.NewInt64Coin("stake", 100)}, 0)
 	require.Equal(t, "start_subscription", msg.Type())
 }
 
 func TestMsgStartSubscription_Route(t *testing.T) {
-	msg := NewMsgStartSubscription(TestAddress1, hub.NewResolverID(0), hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100))
+	msg := NewMsgStartSubscription(TestAddress1, hub.NewResolverID(0), hub.NewNodeID(1), sdk.Coins{sdk.NewInt64Coin("stake", 100)}, 0)
 	require.Equal(t, RouterKey, msg.Route())
 }
 
@@ -2,6 +2,8 @@
 package types
 
 import (
+	"time"
+	
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/tendermint/tendermint/crypto/ed25519"
@@ -38,11 +40,16 @@ var (
 		Client:             TestAddress2,
 		ResolverID:         hub.NewResolverID(0),
 		PricePerGB:         sdk.NewInt64Coin("stake", 100),
+		Commission:         sdk.NewDecWithPrec(12, 2),
+		Plan:               NewPerGBPlan(sdk.NewInt64Coin("stake", 100)),
 		TotalDeposit:       sdk.NewInt64Coin("stake", 100),
 		RemainingDeposit:   sdk.NewInt64Coin("stake", 100),
 		RemainingBandwidth: TestBandwidthPos1,
 		Status:             StatusActive,
 		StatusModifiedAt:   0,
+		QuotedPrice:        sdk.NewInt64Coin("stake", 100),
+		DepositLocked:      true,
+		Deposits:           sdk.Coins{sdk.NewInt64Coin("stake", 100)},
 	}
 	TestSession = Session{
 		ID:               hub.NewSessionID(0),
@@ -51,35 +58,55 @@ var (
 		Status:           StatusActive,
 		StatusModifiedAt: 0,
 	}
+	TestChannel = Channel{
+		ID:               hub.NewChannelID(0),
+		NodeID:           hub.NewNodeID(0),
+		Client:           TestAddress2,
+		Deposit:          sdk.NewInt64Coin("stake", 100),
+		Redeemed:         sdk.NewInt64Coin("stake", 0),
+		ExpiresAt:        time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		Status:           StatusActive,
+		StatusModifiedAt: 0,
+	}
 	TestResolver = Resolver{
-		ID:         hub.NewResolverID(0),
-		Owner:      TestAddress3,
-		Commission: sdk.NewDecWithPrec(12, 2),
-		Status:     StatusRegistered,
+		ID:                      hub.NewResolverID(0),
+		Owner:                   TestAddress3,
+		Moniker:                 "moniker",
+		Endpoint:                "https://resolver.example",
+		Regions:                 []string{"us"},
+		Deposit:                 sdk.NewInt64Coin("stake", 100),
+		Commission:              sdk.NewDecWithPrec(12, 2),
+		MaxCommissionRate:       sdk.NewDecWithPrec(5, 1),
+		MaxCommissionChangeRate: sdk.NewDecWithPrec(1, 1),
+		Status:                  StatusRegistered,
 	}
 	
 	TestBandwidthNeg                  = hub.NewBandwidth(sdk.NewInt(-500000000), sdk.NewInt(-500000000))
 	TestBandwidthZero                 = hub.NewBandwidth(sdk.NewInt(0), sdk.NewInt(0))
 	TestBandwidthPos1                 = hub.NewBandwidth(sdk.NewInt(500000000), sdk.NewInt(500000000))
 	TestBandwidthPos2                 = TestBandwidthPos1.Add(TestBandwidthPos1)
-	TestBandWidthSignDataNeg          = hub.NewBandwidthSignatureData(hub.NewSubscriptionID(0), 0, TestBandwidthNeg)
+	TestBandWidthSignDataNeg          = hub.NewBandwidthSignatureData(hub.NewSubscriptionID(0), hub.NewSessionID(0), TestBandwidthNeg)
 	TestNodeOwnerSignBandWidthNeg, _  = TestPrivKey1.Sign(TestBandWidthSignDataNeg.Bytes())
 	TestNodeOwnerStdSignatureNeg      = auth.StdSignature{PubKey: TestPubkey1, Signature: TestNodeOwnerSignBandWidthNeg}
 	TestClientSignBandWidthNeg, _     = TestPrivKey2.Sign(TestBandWidthSignDataNeg.Bytes())
 	TestClientStdSignatureNeg         = auth.StdSignature{PubKey: TestPubkey2, Signature: TestClientSignBandWidthNeg}
-	TestBandWidthSignDataZero         = hub.NewBandwidthSignatureData(hub.NewSubscriptionID(0), 0, TestBandwidthZero)
+	TestBandWidthSignDataZero         = hub.NewBandwidthSignatureData(hub.NewSubscriptionID(0), hub.NewSessionID(0), TestBandwidthZero)
 	TestNodeOwnerSignBandWidthZero, _ = TestPrivKey1.Sign(TestBandWidthSignDataZero.Bytes())
 	TestNodeOwnerStdSignatureZero     = auth.StdSignature{PubKey: TestPubkey1, Signature: TestNodeOwnerSignBandWidthZero}
 	TestClientSignBandWidthZero, _    = TestPrivKey2.Sign(TestBandWidthSignDataZero.Bytes())
 	TestClientStdSignatureZero        = auth.StdSignature{PubKey: TestPubkey2, Signature: TestClientSignBandWidthZero}
-	TestBandWidthSignDataPos1         = hub.NewBandwidthSignatureData(hub.NewSubscriptionID(0), 1, TestBandwidthPos1)
+	TestBandWidthSignDataPos1         = hub.NewBandwidthSignatureData(hub.NewSubscriptionID(0), hub.NewSessionID(0), TestBandwidthPos1)
 	TestNodeOwnerSignBandWidthPos1, _ = TestPrivKey1.Sign(TestBandWidthSignDataPos1.Bytes())
 	TestNodeOwnerStdSignaturePos1     = auth.StdSignature{PubKey: TestPubkey1, Signature: TestNodeOwnerSignBandWidthPos1}
 	TestClientSignBandWidthPos1, _    = TestPrivKey2.Sign(TestBandWidthSignDataPos1.Bytes())
 	TestClientStdSignaturePos1        = auth.StdSignature{PubKey: TestPubkey2, Signature: TestClientSignBandWidthPos1}
-	TestBandWidthSignDataPos2         = hub.NewBandwidthSignatureData(hub.NewSubscriptionID(0), 1, TestBandwidthPos2)
+	TestBandWidthSignDataPos2         = hub.NewBandwidthSignatureData(hub.NewSubscriptionID(0), hub.NewSessionID(0), TestBandwidthPos2)
 	TestNodeOwnerSignBandWidthPos2, _ = TestPrivKey1.Sign(TestBandWidthSignDataPos2.Bytes())
 	TestNodeOwnerStdSignaturePos2     = auth.StdSignature{PubKey: TestPubkey1, Signature: TestNodeOwnerSignBandWidthPos2}
 	TestClientSignBandWidthPos2, _    = TestPrivKey2.Sign(TestBandWidthSignDataPos2.Bytes())
 	TestClientStdSignaturePos2        = auth.StdSignature{PubKey: TestPubkey2, Signature: TestClientSignBandWidthPos2}
+	TestBandwidthSkew                 = hub.NewBandwidth(sdk.NewInt(1000000000), sdk.NewInt(250000000))
+	TestBandWidthSignDataSkew         = hub.NewBandwidthSignatureData(hub.NewSubscriptionID(0), hub.NewSessionID(0), TestBandwidthSkew)
+	TestNodeOwnerSignBandWidthSkew, _ = TestPrivKey1.Sign(TestBandWidthSignDataSkew.Bytes())
+	TestNodeOwnerStdSignatureSkew     = auth.StdSignature{PubKey: TestPubkey1, Signature: TestNodeOwnerSignBandWidthSkew}
 )
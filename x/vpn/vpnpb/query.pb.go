@@ -0,0 +1,2342 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: sentinel/vpn/v1/query.proto
+
+package vpnpb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	hubpb "github.com/sentinel-official/hub/types/hubpb"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type Plan struct {
+	Type                  string             `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Price                 *hubpb.Coin        `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	Bandwidth             *hubpb.Bandwidth   `protobuf:"bytes,3,opt,name=bandwidth,proto3" json:"bandwidth,omitempty"`
+	Duration              *duration.Duration `protobuf:"bytes,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	MaxConcurrentSessions uint64             `protobuf:"varint,5,opt,name=max_concurrent_sessions,json=maxConcurrentSessions,proto3" json:"max_concurrent_sessions,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}           `json:"-"`
+	XXX_unrecognized      []byte             `json:"-"`
+	XXX_sizecache         int32              `json:"-"`
+}
+
+func (m *Plan) Reset()         { *m = Plan{} }
+func (m *Plan) String() string { return proto.CompactTextString(m) }
+func (*Plan) ProtoMessage()    {}
+func (*Plan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9c838d61a176b8, []int{0}
+}
+
+func (m *Plan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Plan.Unmarshal(m, b)
+}
+func (m *Plan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Plan.Marshal(b, m, deterministic)
+}
+func (m *Plan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Plan.Merge(m, src)
+}
+func (m *Plan) XXX_Size() int {
+	return xxx_messageInfo_Plan.Size(m)
+}
+func (m *Plan) XXX_DiscardUnknown() {
+	xxx_messageInfo_Plan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Plan proto.InternalMessageInfo
+
+func (m *Plan) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Plan) GetPrice() *hubpb.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return nil
+}
+
+func (m *Plan) GetBandwidth() *hubpb.Bandwidth {
+	if m != nil {
+		return m.Bandwidth
+	}
+	return nil
+}
+
+func (m *Plan) GetDuration() *duration.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return nil
+}
+
+func (m *Plan) GetMaxConcurrentSessions() uint64 {
+	if m != nil {
+		return m.MaxConcurrentSessions
+	}
+	return 0
+}
+
+type Node struct {
+	Id                      string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner                   string               `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Deposit                 *hubpb.Coin          `protobuf:"bytes,3,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	Type                    string               `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Version                 string               `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	Moniker                 string               `protobuf:"bytes,6,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	PricesPerGb             []*hubpb.Coin        `protobuf:"bytes,7,rep,name=prices_per_gb,json=pricesPerGb,proto3" json:"prices_per_gb,omitempty"`
+	Plans                   []*Plan              `protobuf:"bytes,8,rep,name=plans,proto3" json:"plans,omitempty"`
+	InternetSpeed           *hubpb.Bandwidth     `protobuf:"bytes,9,opt,name=internet_speed,json=internetSpeed,proto3" json:"internet_speed,omitempty"`
+	Encryption              string               `protobuf:"bytes,10,opt,name=encryption,proto3" json:"encryption,omitempty"`
+	Jailed                  bool                 `protobuf:"varint,11,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	Blacklisted             bool                 `protobuf:"varint,12,opt,name=blacklisted,proto3" json:"blacklisted,omitempty"`
+	Status                  string               `protobuf:"bytes,13,opt,name=status,proto3" json:"status,omitempty"`
+	StatusModifiedAt        int64                `protobuf:"varint,14,opt,name=status_modified_at,json=statusModifiedAt,proto3" json:"status_modified_at,omitempty"`
+	UnbondingCompletionTime *timestamp.Timestamp `protobuf:"bytes,15,opt,name=unbonding_completion_time,json=unbondingCompletionTime,proto3" json:"unbonding_completion_time,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}             `json:"-"`
+	XXX_unrecognized        []byte               `json:"-"`
+	XXX_sizecache           int32                `json:"-"`
+}
+
+func (m *Node) Reset()         { *m = Node{} }
+func (m *Node) String() string { return proto.CompactTextString(m) }
+func (*Node) ProtoMessage()    {}
+func (*Node) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9c838d61a176b8, []int{1}
+}
+
+func (m *Node) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Node.Unmarshal(m, b)
+}
+func (m *Node) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Node.Marshal(b, m, deterministic)
+}
+func (m *Node) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Node.Merge(m, src)
+}
+func (m *Node) XXX_Size() int {
+	return xxx_messageInfo_Node.Size(m)
+}
+func (m *Node) XXX_DiscardUnknown() {
+	xxx_messageInfo_Node.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Node proto.InternalMessageInfo
+
+func (m *Node) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Node) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Node) GetDeposit() *hubpb.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *Node) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Node) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *Node) GetMoniker() string {
+	if m != nil {
+		return m.Moniker
+	}
+	return ""
+}
+
+func (m *Node) GetPricesPerGb() []*hubpb.Coin {
+	if m != nil {
+		return m.PricesPerGb
+	}
+	return nil
+}
+
+func (m *Node) GetPlans() []*Plan {
+	if m != nil {
+		return m.Plans
+	}
+	return nil
+}
+
+func (m *Node) GetInternetSpeed() *hubpb.Bandwidth {
+	if m != nil {
+		return m.InternetSpeed
+	}
+	return nil
+}
+
+func (m *Node) GetEncryption() string {
+	if m != nil {
+		return m.Encryption
+	}
+	return ""
+}
+
+func (m *Node) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+func (m *Node) GetBlacklisted() bool {
+	if m != nil {
+		return m.Blacklisted
+	}
+	return false
+}
+
+func (m *Node) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Node) GetStatusModifiedAt() int64 {
+	if m != nil {
+		return m.StatusModifiedAt
+	}
+	return 0
+}
+
+func (m *Node) GetUnbondingCompletionTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.UnbondingCompletionTime
+	}
+	return nil
+}
+
+// Subscription carries the commission as a decimal string.
+type Subscription struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ResolverId           string               `protobuf:"bytes,2,opt,name=resolver_id,json=resolverId,proto3" json:"resolver_id,omitempty"`
+	NodeId               string               `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Client               string               `protobuf:"bytes,4,opt,name=client,proto3" json:"client,omitempty"`
+	PricePerGb           *hubpb.Coin          `protobuf:"bytes,5,opt,name=price_per_gb,json=pricePerGb,proto3" json:"price_per_gb,omitempty"`
+	Commission           string               `protobuf:"bytes,6,opt,name=commission,proto3" json:"commission,omitempty"`
+	Plan                 *Plan                `protobuf:"bytes,7,opt,name=plan,proto3" json:"plan,omitempty"`
+	ExpiresAt            *timestamp.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	TotalDeposit         *hubpb.Coin          `protobuf:"bytes,9,opt,name=total_deposit,json=totalDeposit,proto3" json:"total_deposit,omitempty"`
+	RemainingDeposit     *hubpb.Coin          `protobuf:"bytes,10,opt,name=remaining_deposit,json=remainingDeposit,proto3" json:"remaining_deposit,omitempty"`
+	RemainingBandwidth   *hubpb.Bandwidth     `protobuf:"bytes,11,opt,name=remaining_bandwidth,json=remainingBandwidth,proto3" json:"remaining_bandwidth,omitempty"`
+	Status               string               `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
+	StatusModifiedAt     int64                `protobuf:"varint,13,opt,name=status_modified_at,json=statusModifiedAt,proto3" json:"status_modified_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Subscription) Reset()         { *m = Subscription{} }
+func (m *Subscription) String() string { return proto.CompactTextString(m) }
+func (*Subscription) ProtoMessage()    {}
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9c838d61a176b8, []int{2}
+}
+
+func (m *Subscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Subscription.Unmarshal(m, b)
+}
+func (m *Subscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Subscription.Marshal(b, m, deterministic)
+}
+func (m *Subscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Subscription.Merge(m, src)
+}
+func (m *Subscription) XXX_Size() int {
+	return xxx_messageInfo_Subscription.Size(m)
+}
+func (m *Subscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_Subscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Subscription proto.InternalMessageInfo
+
+func (m *Subscription) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Subscription) GetResolverId() string {
+	if m != nil {
+		return m.ResolverId
+	}
+	return ""
+}
+
+func (m *Subscription) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *Subscription) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *Subscription) GetPricePerGb() *hubpb.Coin {
+	if m != nil {
+		return m.PricePerGb
+	}
+	return nil
+}
+
+func (m *Subscription) GetCommission() string {
+	if m != nil {
+		return m.Commission
+	}
+	return ""
+}
+
+func (m *Subscription) GetPlan() *Plan {
+	if m != nil {
+		return m.Plan
+	}
+	return nil
+}
+
+func (m *Subscription) GetExpiresAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *Subscription) GetTotalDeposit() *hubpb.Coin {
+	if m != nil {
+		return m.TotalDeposit
+	}
+	return nil
+}
+
+func (m *Subscription) GetRemainingDeposit() *hubpb.Coin {
+	if m != nil {
+		return m.RemainingDeposit
+	}
+	return nil
+}
+
+func (m *Subscription) GetRemainingBandwidth() *hubpb.Bandwidth {
+	if m != nil {
+		return m.RemainingBandwidth
+	}
+	return nil
+}
+
+func (m *Subscription) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Subscription) GetStatusModifiedAt() int64 {
+	if m != nil {
+		return m.StatusModifiedAt
+	}
+	return 0
+}
+
+type Session struct {
+	Id                   string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubscriptionId       string           `protobuf:"bytes,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	Bandwidth            *hubpb.Bandwidth `protobuf:"bytes,3,opt,name=bandwidth,proto3" json:"bandwidth,omitempty"`
+	Status               string           `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	StatusModifiedAt     int64            `protobuf:"varint,5,opt,name=status_modified_at,json=statusModifiedAt,proto3" json:"status_modified_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *Session) Reset()         { *m = Session{} }
+func (m *Session) String() string { return proto.CompactTextString(m) }
+func (*Session) ProtoMessage()    {}
+func (*Session) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9c838d61a176b8, []int{3}
+}
+
+func (m *Session) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Session.Unmarshal(m, b)
+}
+func (m *Session) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Session.Marshal(b, m, deterministic)
+}
+func (m *Session) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Session.Merge(m, src)
+}
+func (m *Session) XXX_Size() int {
+	return xxx_messageInfo_Session.Size(m)
+}
+func (m *Session) XXX_DiscardUnknown() {
+	xxx_messageInfo_Session.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Session proto.InternalMessageInfo
+
+func (m *Session) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Session) GetSubscriptionId() string {
+	if m != nil {
+		return m.SubscriptionId
+	}
+	return ""
+}
+
+func (m *Session) GetBandwidth() *hubpb.Bandwidth {
+	if m != nil {
+		return m.Bandwidth
+	}
+	return nil
+}
+
+func (m *Session) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Session) GetStatusModifiedAt() int64 {
+	if m != nil {
+		return m.StatusModifiedAt
+	}
+	return 0
+}
+
+// Resolver carries the commission and its limits as decimal strings.
+type Resolver struct {
+	Id                      string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner                   string               `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Moniker                 string               `protobuf:"bytes,3,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	Endpoint                string               `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Regions                 []string             `protobuf:"bytes,5,rep,name=regions,proto3" json:"regions,omitempty"`
+	Deposit                 *hubpb.Coin          `protobuf:"bytes,6,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	Commission              string               `protobuf:"bytes,7,opt,name=commission,proto3" json:"commission,omitempty"`
+	MaxCommissionRate       string               `protobuf:"bytes,8,opt,name=max_commission_rate,json=maxCommissionRate,proto3" json:"max_commission_rate,omitempty"`
+	MaxCommissionChangeRate string               `protobuf:"bytes,9,opt,name=max_commission_change_rate,json=maxCommissionChangeRate,proto3" json:"max_commission_change_rate,omitempty"`
+	CommissionUpdatedAt     int64                `protobuf:"varint,10,opt,name=commission_updated_at,json=commissionUpdatedAt,proto3" json:"commission_updated_at,omitempty"`
+	Blacklisted             bool                 `protobuf:"varint,11,opt,name=blacklisted,proto3" json:"blacklisted,omitempty"`
+	Status                  string               `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
+	StatusModifiedAt        int64                `protobuf:"varint,13,opt,name=status_modified_at,json=statusModifiedAt,proto3" json:"status_modified_at,omitempty"`
+	UnbondingCompletionTime *timestamp.Timestamp `protobuf:"bytes,14,opt,name=unbonding_completion_time,json=unbondingCompletionTime,proto3" json:"unbonding_completion_time,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}             `json:"-"`
+	XXX_unrecognized        []byte               `json:"-"`
+	XXX_sizecache           int32                `json:"-"`
+}
+
+func (m *Resolver) Reset()         { *m = Resolver{} }
+func (m *Resolver) String() string { return proto.CompactTextString(m) }
+func (*Resolver) ProtoMessage()    {}
+func (*Resolver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9c838d61a176b8, []int{4}
+}
+
+func (m *Resolver) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Resolver.Unmarshal(m, b)
+}
+func (m *Resolver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Resolver.Marshal(b, m, deterministic)
+}
+func (m *Resolver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Resolver.Merge(m, src)
+}
+func (m *Resolver) XXX_Size() int {
+	return xxx_messageInfo_Resolver.Size(m)
+}
+func (m *Resolver) XXX_DiscardUnknown() {
+	xxx_messageInfo_Resolver.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Resolver proto.InternalMessageInfo
+
+func (m *Resolver) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Resolver) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Resolver) GetMoniker() string {
+	if m != nil {
+		return m.Moniker
+	}
+	return ""
+}
+
+func (m *Resolver) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *Resolver) GetRegions() []string {
+	if m != nil {
+		return m.Regions
+	}
+	return nil
+}
+
+func (m *Resolver) GetDeposit() *hubpb.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *Resolver) GetCommission() string {
+	if m != nil {
+		return m.Commission
+	}
+	return ""
+}
+
+func (m *Resolver) GetMaxCommissionRate() string {
+	if m != nil {
+		return m.MaxCommissionRate
+	}
+	return ""
+}
+
+func (m *Resolver) GetMaxCommissionChangeRate() string {
+	if m != nil {
+		return m.MaxCommissionChangeRate
+	}
+	return ""
+}
+
+func (m *Resolver) GetCommissionUpdatedAt() int64 {
+	if m != nil {
+		return m.CommissionUpdatedAt
+	}
+	return 0
+}
+
+func (m *Resolver) GetBlacklisted() bool {
+	if m != nil {
+		return m.Blacklisted
+	}
+	return false
+}
+
+func (m *Resolver) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Resolver) GetStatusModifiedAt() int64 {
+	if m != nil {
+		return m.StatusModifiedAt
+	}
+	return 0
+}
+
+func (m *Resolver) GetUnbondingCompletionTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.UnbondingCompletionTime
+	}
+	return nil
+}
+
+type FreeClient struct {
+	NodeId               string           `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Client               string           `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	Bandwidth            *hubpb.Bandwidth `protobuf:"bytes,3,opt,name=bandwidth,proto3" json:"bandwidth,omitempty"`
+	ConsumedBandwidth    *hubpb.Bandwidth `protobuf:"bytes,4,opt,name=consumed_bandwidth,json=consumedBandwidth,proto3" json:"consumed_bandwidth,omitempty"`
+	ExpiresAt            int64            `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	MaxSubscriptions     uint64           `protobuf:"varint,6,opt,name=max_subscriptions,json=maxSubscriptions,proto3" json:"max_subscriptions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *FreeClient) Reset()         { *m = FreeClient{} }
+func (m *FreeClient) String() string { return proto.CompactTextString(m) }
+func (*FreeClient) ProtoMessage()    {}
+func (*FreeClient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9c838d61a176b8, []int{5}
+}
+
+func (m *FreeClient) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FreeClient.Unmarshal(m, b)
+}
+func (m *FreeClient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FreeClient.Marshal(b, m, deterministic)
+}
+func (m *FreeClient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FreeClient.Merge(m, src)
+}
+func (m *FreeClient) XXX_Size() int {
+	return xxx_messageInfo_FreeClient.Size(m)
+}
+func (m *FreeClient) XXX_DiscardUnknown() {
+	xxx_messageInfo_FreeClient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FreeClient proto.InternalMessageInfo
+
+func (m *FreeClient) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *FreeClient) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *FreeClient) GetBandwidth() *hubpb.Bandwidth {
+	if m != nil {
+		return m.Bandwidth
+	}
+	return nil
+}
+
+func (m *FreeClient) GetConsumedBandwidth() *hubpb.Bandwidth {
+	if m != nil {
+		return m.ConsumedBandwidth
+	}
+	return nil
+}
+
+func (m *FreeClient) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *FreeClient) GetMaxSubscriptions() uint64 {
+	if m != nil {
+		return m.MaxSubscriptions
+	}
+	return 0
+}
+
+// Params carries the slash fraction as a decimal string.
+type Params struct {
+	FreeNodesCount           uint64             `protobuf:"varint,1,opt,name=free_nodes_count,json=freeNodesCount,proto3" json:"free_nodes_count,omitempty"`
+	Deposit                  *hubpb.Coin        `protobuf:"bytes,2,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	SessionInactiveInterval  int64              `protobuf:"varint,3,opt,name=session_inactive_interval,json=sessionInactiveInterval,proto3" json:"session_inactive_interval,omitempty"`
+	NodeInactiveInterval     int64              `protobuf:"varint,4,opt,name=node_inactive_interval,json=nodeInactiveInterval,proto3" json:"node_inactive_interval,omitempty"`
+	SlashFraction            string             `protobuf:"bytes,5,opt,name=slash_fraction,json=slashFraction,proto3" json:"slash_fraction,omitempty"`
+	NodeUnbondingPeriod      *duration.Duration `protobuf:"bytes,6,opt,name=node_unbonding_period,json=nodeUnbondingPeriod,proto3" json:"node_unbonding_period,omitempty"`
+	ResolverUnbondingPeriod  *duration.Duration `protobuf:"bytes,7,opt,name=resolver_unbonding_period,json=resolverUnbondingPeriod,proto3" json:"resolver_unbonding_period,omitempty"`
+	MaxPricesPerGb           []*hubpb.Coin      `protobuf:"bytes,8,rep,name=max_prices_per_gb,json=maxPricesPerGb,proto3" json:"max_prices_per_gb,omitempty"`
+	ResolverMinDeposit       *hubpb.Coin        `protobuf:"bytes,9,opt,name=resolver_min_deposit,json=resolverMinDeposit,proto3" json:"resolver_min_deposit,omitempty"`
+	CommissionChangeInterval int64              `protobuf:"varint,10,opt,name=commission_change_interval,json=commissionChangeInterval,proto3" json:"commission_change_interval,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{}           `json:"-"`
+	XXX_unrecognized         []byte             `json:"-"`
+	XXX_sizecache            int32              `json:"-"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9c838d61a176b8, []int{6}
+}
+
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Params.Unmarshal(m, b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return xxx_messageInfo_Params.Size(m)
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetFreeNodesCount() uint64 {
+	if m != nil {
+		return m.FreeNodesCount
+	}
+	return 0
+}
+
+func (m *Params) GetDeposit() *hubpb.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *Params) GetSessionInactiveInterval() int64 {
+	if m != nil {
+		return m.SessionInactiveInterval
+	}
+	return 0
+}
+
+func (m *Params) GetNodeInactiveInterval() int64 {
+	if m != nil {
+		return m.NodeInactiveInterval
+	}
+	return 0
+}
+
+func (m *Params) GetSlashFraction() string {
+	if m != nil {
+		return m.SlashFraction
+	}
+	return ""
+}
+
+func (m *Params) GetNodeUnbondingPeriod() *duration.Duration {
+	if m != nil {
+		return m.NodeUnbondingPeriod
+	}
+	return nil
+}
+
+func (m *Params) GetResolverUnbondingPeriod() *duration.Duration {
+	if m != nil {
+		return m.ResolverUnbondingPeriod
+	}
+	return nil
+}
+
+func (m *Params) GetMaxPricesPerGb() []*hubpb.Coin {
+	if m != nil {
+		return m.MaxPricesPerGb
+	}
+	return nil
+}
+
+func (m *Params) GetResolverMinDeposit() *hubpb.Coin {
+	if m != nil {
+		return m.ResolverMinDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetCommissionChangeInterval() int64 {
+	if m != nil {
+		return m.CommissionChangeInterval
+	}
+	return 0
+}
+
+type QueryNodeRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryNodeRequest) Reset()         { *m = QueryNodeRequest{} }
+func (m *QueryNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNodeRequest) ProtoMessage()    {}
+func (*QueryNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9c838d61a176b8, []int{7}
+}
+
+func (m *QueryNodeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryNodeRequest.Unmarshal(m, b)
+}
+func (m *QueryNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryNodeRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNodeRequest.Merge(m, src)
+}
+func (m *QueryNodeRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryNodeRequest.Size(m)
+}
+func (m *QueryNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNodeRequest proto.InternalMessageInfo
+
+func (m *QueryNodeRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type QueryNodeResponse struct {
+	Node                 *Node    `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryNodeResponse) Reset()         { *m = QueryNodeResponse{} }
+func (m *QueryNodeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNodeResponse) ProtoMessage()    {}
+func (*QueryNodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9c838d61a176b8, []int{8}
+}
+
+func (m *QueryNodeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryNodeResponse.Unmarshal(m, b)
+}
+func (m *QueryNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryNodeResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryNodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNodeResponse.Merge(m, src)
+}
+func (m *QueryNodeResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryNodeResponse.Size(m)
+}
+func (m *QueryNodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNodeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNodeResponse proto.InternalMessageInfo
+
+func (m *QueryNodeResponse) GetNode() *Node {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
+// QueryNodesRequest lists the nodes of the owner, or every node if the owner is empty.
+type QueryNodesRequest struct {
+	Owner                string             `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Pagination           *hubpb.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *QueryNodesRequest) Reset()         { *m = QueryNodesRequest{} }
+func (m *QueryNodesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNodesRequest) ProtoMessage()    {}
+func (*QueryNodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9c838d61a176b8, []int{9}
+}
+
+func (m *QueryNodesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryNodesRequest.Unmarshal(m, b)
+}
+func (m *QueryNodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryNodesRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryNodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNodesRequest.Merge(m, src)
+}
+func (m *QueryNodesRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryNodesRequest.Size(m)
+}
+func (m *QueryNodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNodesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNodesRequest proto.InternalMessageInfo
+
+func (m *QueryNodesRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryNodesRequest) GetPagination() *hubpb.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryNodesResponse struct {
+	Nodes                []*Node             `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Pagination           *hubpb.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *QueryNodesResponse) Reset()         { *m = QueryNodesResponse{} }
+func (m *QueryNodesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNodesResponse) ProtoMessage()    {}
+func (*QueryNodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9c838d61a176b8, []int{10}
+}
+
+func (m *QueryNodesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryNodesResponse.Unmarshal(m, b)
+}
+func (m *QueryNodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryNodesResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryNodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNodesResponse.Merge(m, src)
+}
+func (m *QueryNodesResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryNodesResponse.Size(m)
+}
+func (m *QueryNodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNodesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNodesResponse proto.InternalMessageInfo
+
+func (m *QueryNodesResponse) GetNodes() []*Node {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *QueryNodesResponse) GetPagination() *hubpb.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QuerySubscriptionRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QuerySubscriptionRequest) Reset()         { *m = QuerySubscriptionRequest{} }
+func (m *QuerySubscriptionRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySubscriptionRequest) ProtoMessage()    {}
+func (*QuerySubscriptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9c838d61a176b8, []int{11}
+}
+
+func (m *QuerySubscriptionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuerySubscriptionRequest.Unmarshal(m, b)
+}
+func (m *QuerySubscriptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuerySubscriptionRequest.Marshal(b, m, deterministic)
+}
+func (m *QuerySubscriptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubscriptionRequest.Merge(m, src)
+}
+func (m *QuerySubscriptionRequest) XXX_Size() int {
+	return xxx_messageInfo_QuerySubscriptionRequest.Size(m)
+}
+func (m *QuerySubscriptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubscriptionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySubscriptionRequest proto.InternalMessageInfo
+
+func (m *QuerySubscriptionRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type QuerySubscriptionResponse struct {
+	Subscription         *Subscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *QuerySubscriptionResponse) Reset()         { *m = QuerySubscriptionResponse{} }
+func (m *QuerySubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySubscriptionResponse) ProtoMessage()    {}
+func (*QuerySubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9c838d61a176b8, []int{12}
+}
+
+func (m *QuerySubscriptionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuerySubscriptionResponse.Unmarshal(m, b)
+}
+func (m *QuerySubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuerySubscriptionResponse.Marshal(b, m, deterministic)
+}
+func (m *QuerySubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubscriptionResponse.Merge(m, src)
+}
+func (m *QuerySubscriptionResponse) XXX_Size() int {
+	return xxx_messageInfo_QuerySubscriptionResponse.Size(m)
+}
+func (m *QuerySubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubscriptionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySubscriptionResponse proto.InternalMessageInfo
+
+func (m *QuerySubscriptionResponse) GetSubscription() *Subscription {
+	if m != nil {
+		return m.Subscription
+	}
+	return nil
+}
+
+// QuerySubscriptionsRequest lists the subscriptions of the node or of the client, or every subscription if
+// both are empty. Only one of them may be set.
+type QuerySubscriptionsRequest struct {
+	NodeId               string             `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Client               string             `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	Pagination           *hubpb.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *QuerySubscriptionsRequest) Reset()         { *m = QuerySubscriptionsRequest{} }
+func (m *QuerySubscriptionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySubscriptionsRequest) ProtoMessage()    {}
+func (*QuerySubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9c838d61a176b8, []int{13}
+}
+
+func (m *QuerySubscriptionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuerySubscriptionsRequest.Unmarshal(m, b)
+}
+func (m *QuerySubscriptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuerySubscriptionsRequest.Marshal(b, m, deterministic)
+}
+func (m *QuerySubscriptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubscriptionsRequest.Merge(m, src)
+}
+func (m *QuerySubscriptionsRequest) XXX_Size() int {
+	return xxx_messageInfo_QuerySubscriptionsRequest.Size(m)
+}
+func (m *QuerySubscriptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubscriptionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySubscriptionsRequest proto.InternalMessageInfo
+
+func (m *QuerySubscriptionsRequest) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *QuerySubscriptionsRequest) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *QuerySubscriptionsRequest) GetPagination() *hubpb.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QuerySubscriptionsResponse struct {
+	Subscriptions        []*Subscription     `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	Pagination           *hubpb.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *QuerySubscriptionsResponse) Reset()         { *m = QuerySubscriptionsResponse{} }
+func (m *QuerySubscriptionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySubscriptionsResponse) ProtoMessage()    {}
+func (*QuerySubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9c838d61a176b8, []int{14}
+}
+
+func (m *QuerySubscriptionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuerySubscriptionsResponse.Unmarshal(m, b)
+}
+func (m *QuerySubscriptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuerySubscriptionsResponse.Marshal(b, m, deterministic)
+}
+func (m *QuerySubscriptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubscriptionsResponse.Merge(m, src)
+}
+func (m *QuerySubscriptionsResponse) XXX_Size() int {
+	return xxx_messageInfo_QuerySubscriptionsResponse.Size(m)
+}
+func (m *QuerySubscriptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubscriptionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySubscriptionsResponse proto.InternalMessageInfo
+
+func (m *QuerySubscriptionsResponse) GetSubscriptions() []*Subscription {
+	if m != nil {
+		return m.Subscriptions
+	}
+	return nil
+}
+
+func (m *QuerySubscriptionsResponse) GetPagination() *hubpb.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QuerySessionRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QuerySessionRequest) Reset()         { *m = QuerySessionRequest{} }
+func (m *QuerySessionRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySessionRequest) ProtoMessage()    {}
+func (*QuerySessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9c838d61a176b8, []int{15}
+}
+
+func (m *QuerySessionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuerySessionRequest.Unmarshal(m, b)
+}
+func (m *QuerySessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuerySessionRequest.Marshal(b, m, deterministic)
+}
+func (m *QuerySessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySessionRequest.Merge(m, src)
+}
+func (m *QuerySessionRequest) XXX_Size() int {
+	return xxx_messageInfo_QuerySessionRequest.Size(m)
+}
+func (m *QuerySessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySessionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySessionRequest proto.InternalMessageInfo
+
+func (m *QuerySessionRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type QuerySessionResponse struct {
+	Session              *Session `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QuerySessionResponse) Reset()         { *m = QuerySessionResponse{} }
+func (m *QuerySessionResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySessionResponse) ProtoMessage()    {}
+func (*QuerySessionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9c838d61a176b8, []int{16}
+}
+
+func (m *QuerySessionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuerySessionResponse.Unmarshal(m, b)
+}
+func (m *QuerySessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuerySessionResponse.Marshal(b, m, deterministic)
+}
+func (m *QuerySessionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySessionResponse.Merge(m, src)
+}
+func (m *QuerySessionResponse) XXX_Size() int {
+	return xxx_messageInfo_QuerySessionResponse.Size(m)
+}
+func (m *QuerySessionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySessionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySessionResponse proto.InternalMessageInfo
+
+func (m *QuerySessionResponse) GetSession() *Session {
+	if m != nil {
+		return m.Session
+	}
+	return nil
+}
+
+// QuerySessionsRequest lists the sessions of the subscription, or every session if it is empty.
+type QuerySessionsRequest struct {
+	SubscriptionId       string             `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	Pagination           *hubpb.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *QuerySessionsRequest) Reset()         { *m = QuerySessionsRequest{} }
+func (m *QuerySessionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySessionsRequest) ProtoMessage()    {}
+func (*QuerySessionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9c838d61a176b8, []int{17}
+}
+
+func (m *QuerySessionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuerySessionsRequest.Unmarshal(m, b)
+}
+func (m *QuerySessionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuerySessionsRequest.Marshal(b, m, deterministic)
+}
+func (m *QuerySessionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySessionsRequest.Merge(m, src)
+}
+func (m *QuerySessionsRequest) XXX_Size() int {
+	return xxx_messageInfo_QuerySessionsRequest.Size(m)
+}
+func (m *QuerySessionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySessionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySessionsRequest proto.InternalMessageInfo
+
+func (m *QuerySessionsRequest) GetSubscriptionId() string {
+	if m != nil {
+		return m.SubscriptionId
+	}
+	return ""
+}
+
+func (m *QuerySessionsRequest) GetPagination() *hubpb.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QuerySessionsResponse struct {
+	Sessions             []*Session          `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	Pagination           *hubpb.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *QuerySessionsResponse) Reset()         { *m = QuerySessionsResponse{} }
+func (m *QuerySessionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySessionsResponse) ProtoMessage()    {}
+func (*QuerySessionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9c838d61a176b8, []int{18}
+}
+
+func (m *QuerySessionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuerySessionsResponse.Unmarshal(m, b)
+}
+func (m *QuerySessionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuerySessionsResponse.Marshal(b, m, deterministic)
+}
+func (m *QuerySessionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySessionsResponse.Merge(m, src)
+}
+func (m *QuerySessionsResponse) XXX_Size() int {
+	return xxx_messageInfo_QuerySessionsResponse.Size(m)
+}
+func (m *QuerySessionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySessionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySessionsResponse proto.InternalMessageInfo
+
+func (m *QuerySessionsResponse) GetSessions() []*Session {
+	if m != nil {
+		return m.Sessions
+	}
+	return nil
+}
+
+func (m *QuerySessionsResponse) GetPagination() *hubpb.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryResolverRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryResolverRequest) Reset()         { *m = QueryResolverRequest{} }
+func (m *QueryResolverRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryResolverRequest) ProtoMessage()    {}
+func (*QueryResolverRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9c838d61a176b8, []int{19}
+}
+
+func (m *QueryResolverRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryResolverRequest.Unmarshal(m, b)
+}
+func (m *QueryResolverRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryResolverRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryResolverRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResolverRequest.Merge(m, src)
+}
+func (m *QueryResolverRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryResolverRequest.Size(m)
+}
+func (m *QueryResolverRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResolverRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResolverRequest proto.InternalMessageInfo
+
+func (m *QueryResolverRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type QueryResolverResponse struct {
+	Resolver             *Resolver `protobuf:"bytes,1,opt,name=resolver,proto3" json:"resolver,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *QueryResolverResponse) Reset()         { *m = QueryResolverResponse{} }
+func (m *QueryResolverResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResolverResponse) ProtoMessage()    {}
+func (*QueryResolverResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9c838d61a176b8, []int{20}
+}
+
+func (m *QueryResolverResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryResolverResponse.Unmarshal(m, b)
+}
+func (m *QueryResolverResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryResolverResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryResolverResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResolverResponse.Merge(m, src)
+}
+func (m *QueryResolverResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryResolverResponse.Size(m)
+}
+func (m *QueryResolverResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResolverResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResolverResponse proto.InternalMessageInfo
+
+func (m *QueryResolverResponse) GetResolver() *Resolver {
+	if m != nil {
+		return m.Resolver
+	}
+	return nil
+}
+
+// QueryResolversRequest lists the resolvers of the owner, or every resolver if the owner is empty.
+type QueryResolversRequest struct {
+	Owner                string             `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Pagination           *hubpb.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *QueryResolversRequest) Reset()         { *m = QueryResolversRequest{} }
+func (m *QueryResolversRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryResolversRequest) ProtoMessage()    {}
+func (*QueryResolversRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9c838d61a176b8, []int{21}
+}
+
+func (m *QueryResolversRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryResolversRequest.Unmarshal(m, b)
+}
+func (m *QueryResolversRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryResolversRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryResolversRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResolversRequest.Merge(m, src)
+}
+func (m *QueryResolversRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryResolversRequest.Size(m)
+}
+func (m *QueryResolversRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResolversRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResolversRequest proto.InternalMessageInfo
+
+func (m *QueryResolversRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryResolversRequest) GetPagination() *hubpb.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryResolversResponse struct {
+	Resolvers            []*Resolver         `protobuf:"bytes,1,rep,name=resolvers,proto3" json:"resolvers,omitempty"`
+	Pagination           *hubpb.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *QueryResolversResponse) Reset()         { *m = QueryResolversResponse{} }
+func (m *QueryResolversResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResolversResponse) ProtoMessage()    {}
+func (*QueryResolversResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9c838d61a176b8, []int{22}
+}
+
+func (m *QueryResolversResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryResolversResponse.Unmarshal(m, b)
+}
+func (m *QueryResolversResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryResolversResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryResolversResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResolversResponse.Merge(m, src)
+}
+func (m *QueryResolversResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryResolversResponse.Size(m)
+}
+func (m *QueryResolversResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResolversResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResolversResponse proto.InternalMessageInfo
+
+func (m *QueryResolversResponse) GetResolvers() []*Resolver {
+	if m != nil {
+		return m.Resolvers
+	}
+	return nil
+}
+
+func (m *QueryResolversResponse) GetPagination() *hubpb.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryFreeClientRequest struct {
+	NodeId               string   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Client               string   `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryFreeClientRequest) Reset()         { *m = QueryFreeClientRequest{} }
+func (m *QueryFreeClientRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFreeClientRequest) ProtoMessage()    {}
+func (*QueryFreeClientRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9c838d61a176b8, []int{23}
+}
+
+func (m *QueryFreeClientRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryFreeClientRequest.Unmarshal(m, b)
+}
+func (m *QueryFreeClientRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryFreeClientRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryFreeClientRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFreeClientRequest.Merge(m, src)
+}
+func (m *QueryFreeClientRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryFreeClientRequest.Size(m)
+}
+func (m *QueryFreeClientRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFreeClientRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFreeClientRequest proto.InternalMessageInfo
+
+func (m *QueryFreeClientRequest) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *QueryFreeClientRequest) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+type QueryFreeClientResponse struct {
+	FreeClient           *FreeClient `protobuf:"bytes,1,opt,name=free_client,json=freeClient,proto3" json:"free_client,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *QueryFreeClientResponse) Reset()         { *m = QueryFreeClientResponse{} }
+func (m *QueryFreeClientResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFreeClientResponse) ProtoMessage()    {}
+func (*QueryFreeClientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9c838d61a176b8, []int{24}
+}
+
+func (m *QueryFreeClientResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryFreeClientResponse.Unmarshal(m, b)
+}
+func (m *QueryFreeClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryFreeClientResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryFreeClientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFreeClientResponse.Merge(m, src)
+}
+func (m *QueryFreeClientResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryFreeClientResponse.Size(m)
+}
+func (m *QueryFreeClientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFreeClientResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFreeClientResponse proto.InternalMessageInfo
+
+func (m *QueryFreeClientResponse) GetFreeClient() *FreeClient {
+	if m != nil {
+		return m.FreeClient
+	}
+	return nil
+}
+
+// QueryFreeClientsRequest lists the free clients of the node or the grants of the client, or every free
+// client if both are empty. Only one of them may be set.
+type QueryFreeClientsRequest struct {
+	NodeId               string             `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Client               string             `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	Pagination           *hubpb.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *QueryFreeClientsRequest) Reset()         { *m = QueryFreeClientsRequest{} }
+func (m *QueryFreeClientsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFreeClientsRequest) ProtoMessage()    {}
+func (*QueryFreeClientsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9c838d61a176b8, []int{25}
+}
+
+func (m *QueryFreeClientsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryFreeClientsRequest.Unmarshal(m, b)
+}
+func (m *QueryFreeClientsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryFreeClientsRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryFreeClientsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFreeClientsRequest.Merge(m, src)
+}
+func (m *QueryFreeClientsRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryFreeClientsRequest.Size(m)
+}
+func (m *QueryFreeClientsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFreeClientsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFreeClientsRequest proto.InternalMessageInfo
+
+func (m *QueryFreeClientsRequest) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *QueryFreeClientsRequest) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *QueryFreeClientsRequest) GetPagination() *hubpb.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryFreeClientsResponse struct {
+	FreeClients          []*FreeClient       `protobuf:"bytes,1,rep,name=free_clients,json=freeClients,proto3" json:"free_clients,omitempty"`
+	Pagination           *hubpb.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *QueryFreeClientsResponse) Reset()         { *m = QueryFreeClientsResponse{} }
+func (m *QueryFreeClientsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFreeClientsResponse) ProtoMessage()    {}
+func (*QueryFreeClientsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9c838d61a176b8, []int{26}
+}
+
+func (m *QueryFreeClientsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryFreeClientsResponse.Unmarshal(m, b)
+}
+func (m *QueryFreeClientsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryFreeClientsResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryFreeClientsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFreeClientsResponse.Merge(m, src)
+}
+func (m *QueryFreeClientsResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryFreeClientsResponse.Size(m)
+}
+func (m *QueryFreeClientsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFreeClientsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFreeClientsResponse proto.InternalMessageInfo
+
+func (m *QueryFreeClientsResponse) GetFreeClients() []*FreeClient {
+	if m != nil {
+		return m.FreeClients
+	}
+	return nil
+}
+
+func (m *QueryFreeClientsResponse) GetPagination() *hubpb.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryParamsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9c838d61a176b8, []int{27}
+}
+
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryParamsRequest.Unmarshal(m, b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryParamsRequest.Size(m)
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params               *Params  `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9c838d61a176b8, []int{28}
+}
+
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryParamsResponse.Unmarshal(m, b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryParamsResponse.Size(m)
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Plan)(nil), "sentinel.vpn.v1.Plan")
+	proto.RegisterType((*Node)(nil), "sentinel.vpn.v1.Node")
+	proto.RegisterType((*Subscription)(nil), "sentinel.vpn.v1.Subscription")
+	proto.RegisterType((*Session)(nil), "sentinel.vpn.v1.Session")
+	proto.RegisterType((*Resolver)(nil), "sentinel.vpn.v1.Resolver")
+	proto.RegisterType((*FreeClient)(nil), "sentinel.vpn.v1.FreeClient")
+	proto.RegisterType((*Params)(nil), "sentinel.vpn.v1.Params")
+	proto.RegisterType((*QueryNodeRequest)(nil), "sentinel.vpn.v1.QueryNodeRequest")
+	proto.RegisterType((*QueryNodeResponse)(nil), "sentinel.vpn.v1.QueryNodeResponse")
+	proto.RegisterType((*QueryNodesRequest)(nil), "sentinel.vpn.v1.QueryNodesRequest")
+	proto.RegisterType((*QueryNodesResponse)(nil), "sentinel.vpn.v1.QueryNodesResponse")
+	proto.RegisterType((*QuerySubscriptionRequest)(nil), "sentinel.vpn.v1.QuerySubscriptionRequest")
+	proto.RegisterType((*QuerySubscriptionResponse)(nil), "sentinel.vpn.v1.QuerySubscriptionResponse")
+	proto.RegisterType((*QuerySubscriptionsRequest)(nil), "sentinel.vpn.v1.QuerySubscriptionsRequest")
+	proto.RegisterType((*QuerySubscriptionsResponse)(nil), "sentinel.vpn.v1.QuerySubscriptionsResponse")
+	proto.RegisterType((*QuerySessionRequest)(nil), "sentinel.vpn.v1.QuerySessionRequest")
+	proto.RegisterType((*QuerySessionResponse)(nil), "sentinel.vpn.v1.QuerySessionResponse")
+	proto.RegisterType((*QuerySessionsRequest)(nil), "sentinel.vpn.v1.QuerySessionsRequest")
+	proto.RegisterType((*QuerySessionsResponse)(nil), "sentinel.vpn.v1.QuerySessionsResponse")
+	proto.RegisterType((*QueryResolverRequest)(nil), "sentinel.vpn.v1.QueryResolverRequest")
+	proto.RegisterType((*QueryResolverResponse)(nil), "sentinel.vpn.v1.QueryResolverResponse")
+	proto.RegisterType((*QueryResolversRequest)(nil), "sentinel.vpn.v1.QueryResolversRequest")
+	proto.RegisterType((*QueryResolversResponse)(nil), "sentinel.vpn.v1.QueryResolversResponse")
+	proto.RegisterType((*QueryFreeClientRequest)(nil), "sentinel.vpn.v1.QueryFreeClientRequest")
+	proto.RegisterType((*QueryFreeClientResponse)(nil), "sentinel.vpn.v1.QueryFreeClientResponse")
+	proto.RegisterType((*QueryFreeClientsRequest)(nil), "sentinel.vpn.v1.QueryFreeClientsRequest")
+	proto.RegisterType((*QueryFreeClientsResponse)(nil), "sentinel.vpn.v1.QueryFreeClientsResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "sentinel.vpn.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "sentinel.vpn.v1.QueryParamsResponse")
+}
+
+func init() { proto.RegisterFile("sentinel/vpn/v1/query.proto", fileDescriptor_2e9c838d61a176b8) }
+
+var fileDescriptor_2e9c838d61a176b8 = []byte{
+	// 1772 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xdd, 0x8e, 0xdb, 0xc6,
+	0x15, 0x06, 0x57, 0xd4, 0x4a, 0x3a, 0xfa, 0xf1, 0x7a, 0x76, 0xd7, 0xe2, 0x2a, 0x89, 0xa3, 0xb2,
+	0xb1, 0xb3, 0x8e, 0x53, 0x09, 0x76, 0x93, 0xa6, 0x49, 0xdd, 0xa0, 0xeb, 0x0d, 0x1c, 0x6c, 0x8a,
+	0x35, 0xb6, 0xdc, 0x3a, 0x45, 0x8b, 0xa2, 0x04, 0x45, 0xce, 0x4a, 0x53, 0x8b, 0x43, 0x86, 0xa4,
+	0x94, 0xf5, 0x45, 0x81, 0x02, 0x05, 0x8a, 0xa2, 0x28, 0x8a, 0xde, 0xf6, 0xae, 0xcf, 0xd0, 0x47,
+	0xc8, 0x55, 0x1f, 0xa7, 0x8f, 0x50, 0x70, 0xfe, 0xc4, 0x1f, 0xfd, 0xc5, 0x8b, 0x22, 0x77, 0xe2,
+	0x9c, 0xef, 0x3b, 0x73, 0xce, 0x9c, 0x33, 0xdf, 0x0c, 0x29, 0x78, 0x23, 0xc6, 0x34, 0x21, 0x14,
+	0x4f, 0x87, 0xf3, 0x90, 0x0e, 0xe7, 0x8f, 0x86, 0x5f, 0xcd, 0x70, 0xf4, 0x6a, 0x10, 0x46, 0x41,
+	0x12, 0xa0, 0x5b, 0xd2, 0x38, 0x98, 0x87, 0x74, 0x30, 0x7f, 0xd4, 0xbb, 0x3b, 0x0e, 0x82, 0xf1,
+	0x14, 0x0f, 0x99, 0x79, 0x34, 0xbb, 0x1a, 0x7a, 0xb3, 0xc8, 0x49, 0x48, 0x40, 0x39, 0xa1, 0xf7,
+	0x76, 0xd1, 0x9e, 0x10, 0x1f, 0xc7, 0x89, 0xe3, 0x87, 0x02, 0xf0, 0xa6, 0x9a, 0x6e, 0x32, 0x1b,
+	0xa5, 0xd3, 0xb9, 0x81, 0xef, 0x4b, 0xba, 0xf9, 0x5f, 0x0d, 0xf4, 0x8b, 0xa9, 0x43, 0x11, 0x02,
+	0x3d, 0x79, 0x15, 0x62, 0x43, 0xeb, 0x6b, 0xc7, 0x0d, 0x8b, 0xfd, 0x46, 0x0f, 0xa1, 0x1a, 0x46,
+	0xc4, 0xc5, 0xc6, 0x4e, 0x5f, 0x3b, 0x6e, 0x3e, 0x3e, 0x1c, 0xa8, 0xe0, 0x26, 0xb3, 0xd1, 0x60,
+	0xfe, 0x68, 0x70, 0x1a, 0x10, 0x6a, 0x71, 0x0c, 0xfa, 0x31, 0x34, 0x46, 0x0e, 0xf5, 0xbe, 0x26,
+	0x5e, 0x32, 0x31, 0x2a, 0x8c, 0xd0, 0x2b, 0x11, 0x9e, 0x4a, 0x84, 0xb5, 0x00, 0xa3, 0x0f, 0xa1,
+	0x2e, 0x93, 0x32, 0x74, 0x46, 0x3c, 0x1a, 0xf0, 0xac, 0x06, 0x32, 0xab, 0xc1, 0x67, 0x02, 0x60,
+	0x29, 0x28, 0xfa, 0x11, 0x74, 0x7d, 0xe7, 0xda, 0x76, 0x03, 0xea, 0xce, 0xa2, 0x08, 0xd3, 0xc4,
+	0x8e, 0x71, 0x1c, 0x93, 0x80, 0xc6, 0x46, 0xb5, 0xaf, 0x1d, 0xeb, 0xd6, 0xa1, 0xef, 0x5c, 0x9f,
+	0x2a, 0xeb, 0xa5, 0x30, 0x9a, 0xff, 0xd1, 0x41, 0x7f, 0x1e, 0x78, 0x18, 0x75, 0x60, 0x87, 0x78,
+	0x22, 0xe1, 0x1d, 0xe2, 0xa1, 0x03, 0xa8, 0x06, 0x5f, 0x53, 0x1c, 0xb1, 0x74, 0x1b, 0x16, 0x7f,
+	0x40, 0x43, 0xa8, 0x79, 0x38, 0x0c, 0x62, 0x92, 0x18, 0x95, 0x75, 0xcb, 0x20, 0x51, 0x6a, 0x25,
+	0xf5, 0xcc, 0x4a, 0x1a, 0x50, 0x9b, 0xe3, 0x28, 0x9d, 0x9f, 0xc5, 0xd6, 0xb0, 0xe4, 0x63, 0x6a,
+	0xf1, 0x03, 0x4a, 0x5e, 0xe2, 0xc8, 0xd8, 0xe5, 0x16, 0xf1, 0x88, 0x3e, 0x86, 0x36, 0x5b, 0xd9,
+	0xd8, 0x0e, 0x71, 0x64, 0x8f, 0x47, 0x46, 0xad, 0x5f, 0x59, 0x3d, 0x7d, 0x93, 0x63, 0x2f, 0x70,
+	0xf4, 0xf9, 0x88, 0x15, 0x6e, 0xea, 0xd0, 0xd8, 0xa8, 0x17, 0x29, 0xbc, 0xab, 0x06, 0x69, 0xc9,
+	0x2d, 0x8e, 0x41, 0x27, 0xd0, 0x21, 0x34, 0xc1, 0x11, 0xc5, 0x89, 0x1d, 0x87, 0x18, 0x7b, 0x46,
+	0x63, 0x63, 0xf5, 0xda, 0x92, 0x71, 0x99, 0x12, 0xd0, 0x5d, 0x00, 0x4c, 0xdd, 0xe8, 0x55, 0xc8,
+	0x6a, 0x08, 0x2c, 0x8f, 0xcc, 0x08, 0xba, 0x03, 0xbb, 0xbf, 0x77, 0xc8, 0x14, 0x7b, 0x46, 0xb3,
+	0xaf, 0x1d, 0xd7, 0x2d, 0xf1, 0x84, 0xfa, 0xd0, 0x1c, 0x4d, 0x1d, 0xf7, 0xe5, 0x94, 0xc4, 0x09,
+	0xf6, 0x8c, 0x16, 0x33, 0x66, 0x87, 0x52, 0x66, 0x9c, 0x38, 0xc9, 0x2c, 0x36, 0xda, 0xcc, 0xab,
+	0x78, 0x42, 0xef, 0x03, 0xe2, 0xbf, 0x6c, 0x3f, 0xf0, 0xc8, 0x15, 0xc1, 0x9e, 0xed, 0x24, 0x46,
+	0xa7, 0xaf, 0x1d, 0x57, 0xac, 0x3d, 0x6e, 0x39, 0x17, 0x86, 0x93, 0x04, 0x7d, 0x09, 0x47, 0x33,
+	0x3a, 0x0a, 0xa8, 0x47, 0xe8, 0xd8, 0x76, 0x03, 0x3f, 0x9c, 0xe2, 0x34, 0x2e, 0x3b, 0xdd, 0x2b,
+	0xc6, 0x2d, 0x91, 0x6d, 0xb1, 0xe5, 0x7e, 0x29, 0x37, 0x92, 0xd5, 0x55, 0xe4, 0x53, 0xc5, 0x4d,
+	0xad, 0xe6, 0x37, 0x3a, 0xb4, 0x2e, 0x67, 0xa3, 0xd8, 0x8d, 0x08, 0x4f, 0xb4, 0xd8, 0x52, 0x6f,
+	0x43, 0x33, 0xc2, 0x71, 0x30, 0x9d, 0xe3, 0xc8, 0x26, 0x9e, 0x68, 0x2c, 0x90, 0x43, 0x67, 0x1e,
+	0xea, 0x42, 0x8d, 0x06, 0x1e, 0x4e, 0x8d, 0x15, 0x9e, 0x60, 0xfa, 0x78, 0xc6, 0x12, 0x77, 0xa7,
+	0x04, 0xd3, 0x44, 0xf4, 0x91, 0x78, 0x42, 0x1f, 0x41, 0x8b, 0x55, 0x5a, 0x36, 0x45, 0x75, 0x5d,
+	0x4f, 0x02, 0x83, 0xf2, 0x9e, 0xb8, 0x0b, 0x90, 0xee, 0x7c, 0xc2, 0x76, 0x81, 0xe8, 0xb5, 0xcc,
+	0x08, 0x7a, 0x00, 0x7a, 0xda, 0x0f, 0x46, 0xad, 0xaf, 0xad, 0x6e, 0x19, 0x06, 0x41, 0x1f, 0x03,
+	0xe0, 0xeb, 0x90, 0x44, 0x38, 0x4e, 0x17, 0xbd, 0xbe, 0x71, 0xfd, 0x1a, 0x02, 0x7d, 0x92, 0xa0,
+	0x4f, 0xa0, 0x9d, 0x04, 0x89, 0x33, 0xb5, 0xe5, 0x9e, 0x6a, 0xac, 0x8b, 0xbf, 0xc5, 0xb0, 0x9f,
+	0x89, 0x8d, 0xf5, 0x14, 0x6e, 0x47, 0xd8, 0x77, 0x08, 0x4d, 0xab, 0x28, 0xf9, 0xb0, 0x8e, 0xbf,
+	0xa7, 0xf0, 0xd2, 0xc7, 0xcf, 0x61, 0x7f, 0xe1, 0x63, 0xa1, 0x57, 0xcd, 0x8d, 0x1d, 0x8f, 0x14,
+	0x4d, 0x8d, 0x65, 0x9a, 0xb3, 0xb5, 0x45, 0x73, 0xb6, 0x97, 0x37, 0xa7, 0xf9, 0x8d, 0x06, 0x35,
+	0x21, 0x4e, 0xa5, 0xfe, 0x79, 0x17, 0x6e, 0xc5, 0x99, 0xfe, 0x5a, 0xf4, 0x50, 0x27, 0x3b, 0x7c,
+	0xe6, 0xdd, 0x40, 0x7d, 0x17, 0x49, 0xe8, 0x5b, 0x24, 0x51, 0x5d, 0x91, 0xc4, 0xbf, 0x75, 0xa8,
+	0x5b, 0xa2, 0xad, 0xb7, 0x14, 0xd6, 0x8c, 0xf2, 0x55, 0xf2, 0xca, 0xd7, 0x83, 0x3a, 0xa6, 0x5e,
+	0x18, 0x10, 0xd5, 0xfd, 0xea, 0x39, 0x65, 0x45, 0x78, 0x2c, 0x54, 0xbe, 0x92, 0xb2, 0xc4, 0x63,
+	0x56, 0xa8, 0x77, 0xb7, 0x12, 0xea, 0xfc, 0x8e, 0xa8, 0x95, 0x76, 0xc4, 0x00, 0xf6, 0xf9, 0x01,
+	0x23, 0x47, 0xec, 0xc8, 0x49, 0x30, 0xeb, 0xf7, 0x86, 0x75, 0x9b, 0x1d, 0x2e, 0xd2, 0x62, 0x39,
+	0x09, 0x46, 0x3f, 0x81, 0x5e, 0x01, 0xef, 0x4e, 0x1c, 0x3a, 0xc6, 0x9c, 0xd6, 0x60, 0xb4, 0x6e,
+	0x8e, 0x76, 0xca, 0xec, 0x8c, 0xfc, 0x18, 0x0e, 0x33, 0xc4, 0x59, 0xe8, 0x39, 0x09, 0x5f, 0x71,
+	0x60, 0x2b, 0xbe, 0xbf, 0x30, 0xbe, 0xe0, 0xb6, 0x93, 0xa4, 0x28, 0x9f, 0xcd, 0x75, 0xf2, 0x79,
+	0x83, 0x0e, 0x5d, 0x2f, 0x9f, 0x9d, 0xd7, 0x97, 0xcf, 0xbf, 0xef, 0x00, 0x3c, 0x8b, 0x30, 0x3e,
+	0xe5, 0xd2, 0x96, 0xd1, 0x42, 0x6d, 0x85, 0x16, 0xee, 0xe4, 0xb4, 0xf0, 0xf5, 0x9b, 0xfe, 0x0c,
+	0x90, 0x1b, 0xd0, 0x78, 0xe6, 0x63, 0x2f, 0xa3, 0x02, 0xfa, 0x46, 0x17, 0xb7, 0x25, 0x4b, 0x0d,
+	0xa1, 0xb7, 0x72, 0x62, 0xc8, 0xf7, 0x47, 0x46, 0xf0, 0x1e, 0x42, 0xda, 0x29, 0x76, 0x76, 0xbb,
+	0xc6, 0xac, 0x3f, 0x75, 0x6b, 0xcf, 0x77, 0xae, 0xb3, 0xa7, 0x47, 0x6c, 0xfe, 0xa9, 0x0a, 0xbb,
+	0x17, 0x4e, 0xe4, 0xf8, 0x31, 0x3a, 0x86, 0xbd, 0xab, 0x08, 0x63, 0x3b, 0x5d, 0x82, 0xd8, 0x76,
+	0x83, 0x19, 0x4d, 0xd8, 0xaa, 0xe8, 0x56, 0x27, 0x1d, 0x4f, 0x2f, 0x30, 0xf1, 0x69, 0x3a, 0x9a,
+	0xed, 0xfb, 0x9d, 0xad, 0xfa, 0xfe, 0x13, 0x38, 0x12, 0x37, 0x25, 0x9b, 0x50, 0xc7, 0x4d, 0xc8,
+	0x1c, 0xdb, 0xec, 0x3c, 0x9f, 0x3b, 0x53, 0xb6, 0x8c, 0x15, 0xab, 0x2b, 0x00, 0x67, 0xc2, 0x7e,
+	0x26, 0xcc, 0xe8, 0x03, 0xb8, 0xc3, 0x6b, 0x54, 0x22, 0xea, 0x8c, 0x78, 0xc0, 0x4a, 0x56, 0x64,
+	0xdd, 0x83, 0x4e, 0x3c, 0x75, 0xe2, 0x89, 0x7d, 0x15, 0xa5, 0x16, 0x75, 0x0b, 0x6a, 0xb3, 0xd1,
+	0x67, 0x62, 0x10, 0x9d, 0xc3, 0x21, 0x73, 0xbe, 0x68, 0xb6, 0x10, 0x47, 0x24, 0xf0, 0x8c, 0xdd,
+	0x4d, 0xb7, 0xc2, 0xfd, 0x94, 0xf7, 0x42, 0xd2, 0x2e, 0x18, 0x0b, 0xbd, 0x80, 0x23, 0x75, 0xf8,
+	0x96, 0x5c, 0xd6, 0x36, 0xb9, 0xec, 0x4a, 0x6e, 0xd1, 0xed, 0xcf, 0x78, 0x45, 0xf3, 0x77, 0xb3,
+	0xfa, 0xba, 0xbb, 0x59, 0xc7, 0x77, 0xae, 0x2f, 0x32, 0xd7, 0xb3, 0xcf, 0xe1, 0x40, 0x05, 0xe6,
+	0x13, 0xba, 0xdd, 0x59, 0x88, 0x24, 0xe5, 0x9c, 0x50, 0x79, 0x9a, 0x3d, 0x81, 0x5e, 0x59, 0x6d,
+	0x54, 0x45, 0xb8, 0x72, 0x18, 0x6e, 0x41, 0x6e, 0x64, 0x55, 0xbe, 0xd0, 0xeb, 0xcd, 0xbd, 0x96,
+	0xb5, 0xe7, 0x93, 0x31, 0x4f, 0xd9, 0x9e, 0x60, 0x32, 0x9e, 0x24, 0xa6, 0x09, 0x7b, 0xbf, 0x48,
+	0x5f, 0x49, 0xd2, 0x1e, 0xb3, 0xf0, 0x57, 0x33, 0x1c, 0x27, 0x45, 0x49, 0x37, 0x3f, 0x85, 0xdb,
+	0x19, 0x4c, 0x1c, 0x06, 0x34, 0xc6, 0xe9, 0x15, 0x22, 0xad, 0x83, 0xa1, 0x15, 0xf3, 0x10, 0x57,
+	0x08, 0x06, 0x66, 0x10, 0x73, 0x9c, 0xe1, 0xc7, 0x72, 0x12, 0x75, 0x4e, 0x68, 0xd9, 0x73, 0xe2,
+	0x09, 0x40, 0xe8, 0x8c, 0x09, 0xe5, 0x2f, 0x08, 0xbc, 0xc5, 0xdf, 0x2c, 0xad, 0xd1, 0x85, 0x33,
+	0x96, 0xc1, 0x5a, 0x19, 0xbc, 0xf9, 0x47, 0x0d, 0x50, 0x76, 0x26, 0x11, 0xea, 0x43, 0xa8, 0xb2,
+	0x9d, 0x65, 0x68, 0x2b, 0x6e, 0xc8, 0x2c, 0x56, 0x8e, 0x41, 0x3f, 0x5d, 0x12, 0xc1, 0x5b, 0x2b,
+	0x22, 0xe0, 0xfe, 0x73, 0x21, 0xbc, 0x07, 0x06, 0x8b, 0x20, 0xbb, 0xd7, 0x57, 0xad, 0xeb, 0xef,
+	0xe0, 0x68, 0x09, 0x56, 0x04, 0x7d, 0x02, 0xad, 0xac, 0x8e, 0x18, 0x5a, 0x31, 0x12, 0x11, 0x7b,
+	0x8e, 0x9c, 0xa3, 0x98, 0x7f, 0xd5, 0x96, 0x4c, 0xa0, 0x0a, 0xf0, 0xad, 0x15, 0x38, 0x5f, 0x9b,
+	0xca, 0xb7, 0xac, 0xcd, 0xbf, 0x34, 0xe8, 0x2d, 0x0b, 0x46, 0xa4, 0x7b, 0x0a, 0xed, 0xbc, 0x6c,
+	0xf2, 0x5a, 0x6d, 0xc8, 0x37, 0xcf, 0xb9, 0x69, 0xed, 0xee, 0xc1, 0x3e, 0x8f, 0x90, 0xeb, 0xe1,
+	0xaa, 0xb2, 0x7d, 0x01, 0x07, 0x79, 0x98, 0x48, 0xe1, 0x31, 0xd4, 0x84, 0x92, 0x8a, 0x62, 0x19,
+	0xe5, 0xe0, 0x05, 0x45, 0x02, 0xcd, 0x3f, 0xe4, 0x7d, 0xa9, 0xe2, 0x2c, 0xb9, 0x0b, 0x6a, 0x4b,
+	0xef, 0x82, 0x37, 0xdb, 0x30, 0x7f, 0xd3, 0xe0, 0xb0, 0x30, 0xbf, 0x48, 0xe6, 0x03, 0xa8, 0xab,
+	0x37, 0x6c, 0x5e, 0x8a, 0xd5, 0xd9, 0x28, 0xe4, 0x4d, 0x0b, 0x70, 0x5f, 0xac, 0x86, 0xbc, 0x5c,
+	0xae, 0xaa, 0xc0, 0x73, 0x38, 0x2c, 0xe0, 0x44, 0xd4, 0x1f, 0x42, 0x5d, 0x2a, 0xa7, 0xa8, 0xc1,
+	0x51, 0x29, 0x6a, 0x45, 0x52, 0x50, 0xf3, 0x65, 0xc1, 0xdf, 0xff, 0x55, 0xa4, 0xfe, 0xa1, 0xc1,
+	0x9d, 0xe2, 0x6c, 0x22, 0xfc, 0x8f, 0xa0, 0x21, 0x63, 0x92, 0xab, 0xbe, 0x26, 0xfe, 0x05, 0xf6,
+	0xa6, 0xeb, 0x7e, 0x26, 0x22, 0x5a, 0xdc, 0xcf, 0x5e, 0x57, 0x24, 0xcc, 0x5f, 0x41, 0xb7, 0xe4,
+	0x4a, 0x64, 0xf7, 0x04, 0x9a, 0xec, 0x96, 0x23, 0x78, 0xbc, 0x3e, 0x6f, 0x94, 0xf2, 0xcb, 0x30,
+	0xe1, 0x4a, 0xfd, 0x36, 0xff, 0xa2, 0x95, 0x3c, 0x7f, 0x57, 0x52, 0xf6, 0x4f, 0x0d, 0x8c, 0x72,
+	0x28, 0x22, 0xcb, 0x4f, 0xa1, 0x95, 0xc9, 0x52, 0x96, 0x71, 0x6d, 0x9a, 0xcd, 0x45, 0x9a, 0x37,
+	0x2e, 0xe5, 0x81, 0x38, 0x01, 0xf9, 0xcd, 0x52, 0x44, 0x6f, 0x3e, 0x83, 0xfd, 0xdc, 0xa8, 0x88,
+	0x75, 0x08, 0xbb, 0x21, 0x1b, 0x11, 0xc5, 0xe8, 0x96, 0x3f, 0x04, 0x70, 0x82, 0x80, 0x3d, 0xfe,
+	0x73, 0x1d, 0x5a, 0x42, 0x2f, 0xa2, 0x79, 0xfa, 0x21, 0xf0, 0x5c, 0x7c, 0x5e, 0xfb, 0x5e, 0x89,
+	0x59, 0xbc, 0x55, 0xf4, 0xcc, 0x75, 0x10, 0x11, 0xd0, 0x05, 0x54, 0x9f, 0xb3, 0x53, 0x78, 0x0d,
+	0x58, 0x26, 0xd5, 0xfb, 0xfe, 0x5a, 0x8c, 0xf0, 0x88, 0x0b, 0x1f, 0x6d, 0x1e, 0x2c, 0x27, 0x2d,
+	0x39, 0xae, 0x7b, 0xef, 0x6d, 0x03, 0x15, 0xd3, 0x4c, 0xa0, 0x7d, 0x99, 0x3b, 0x8a, 0xb6, 0x20,
+	0xab, 0x44, 0x1e, 0x6e, 0x85, 0x15, 0x33, 0x7d, 0xb9, 0xf8, 0x80, 0xf0, 0xce, 0x0a, 0x5e, 0xee,
+	0xf8, 0xea, 0xdd, 0xdb, 0x80, 0x12, 0x7e, 0x7f, 0x0d, 0xf5, 0x4b, 0x29, 0xe3, 0xeb, 0x29, 0x2a,
+	0xee, 0xfb, 0x9b, 0x60, 0x0b, 0xd7, 0xea, 0x73, 0xc1, 0x0a, 0xd7, 0x05, 0xc5, 0xef, 0xdd, 0xdf,
+	0x04, 0x13, 0xae, 0x7f, 0x0b, 0x0d, 0x4b, 0xa9, 0xe0, 0x06, 0x92, 0x8a, 0xfb, 0xdd, 0x8d, 0x38,
+	0xe1, 0xdd, 0xce, 0xbd, 0xb2, 0xae, 0xa0, 0x95, 0x44, 0xb3, 0x77, 0xbc, 0x19, 0x28, 0x26, 0x18,
+	0x41, 0xf3, 0x59, 0x66, 0xef, 0x6f, 0x24, 0xaa, 0x14, 0x1e, 0x6c, 0x81, 0x14, 0x73, 0x5c, 0xaa,
+	0xd7, 0xcc, 0x15, 0x1b, 0x26, 0x27, 0x15, 0xbd, 0x77, 0xd6, 0x83, 0xb8, 0xd3, 0xa7, 0x83, 0xdf,
+	0xbc, 0x3f, 0x26, 0x49, 0x2a, 0x46, 0x6e, 0xe0, 0x0f, 0x25, 0xe3, 0x07, 0xc1, 0xd5, 0x15, 0x71,
+	0x89, 0xc3, 0xff, 0x7e, 0xb8, 0xe6, 0xff, 0x79, 0x84, 0x34, 0x1c, 0x8d, 0x76, 0xd9, 0x3b, 0xd7,
+	0x0f, 0xff, 0x37, 0x00, 0x2c, 0x1d, 0xf6, 0xcc, 0x10, 0x19, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryServiceClient is the client API for QueryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryServiceClient interface {
+	Node(ctx context.Context, in *QueryNodeRequest, opts ...grpc.CallOption) (*QueryNodeResponse, error)
+	Nodes(ctx context.Context, in *QueryNodesRequest, opts ...grpc.CallOption) (*QueryNodesResponse, error)
+	Subscription(ctx context.Context, in *QuerySubscriptionRequest, opts ...grpc.CallOption) (*QuerySubscriptionResponse, error)
+	Subscriptions(ctx context.Context, in *QuerySubscriptionsRequest, opts ...grpc.CallOption) (*QuerySubscriptionsResponse, error)
+	Session(ctx context.Context, in *QuerySessionRequest, opts ...grpc.CallOption) (*QuerySessionResponse, error)
+	Sessions(ctx context.Context, in *QuerySessionsRequest, opts ...grpc.CallOption) (*QuerySessionsResponse, error)
+	Resolver(ctx context.Context, in *QueryResolverRequest, opts ...grpc.CallOption) (*QueryResolverResponse, error)
+	Resolvers(ctx context.Context, in *QueryResolversRequest, opts ...grpc.CallOption) (*QueryResolversResponse, error)
+	FreeClient(ctx context.Context, in *QueryFreeClientRequest, opts ...grpc.CallOption) (*QueryFreeClientResponse, error)
+	FreeClients(ctx context.Context, in *QueryFreeClientsRequest, opts ...grpc.CallOption) (*QueryFreeClientsResponse, error)
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewQueryServiceClient(cc *grpc.ClientConn) QueryServiceClient {
+	return &queryServiceClient{cc}
+}
+
+func (c *queryServiceClient) Node(ctx context.Context, in *QueryNodeRequest, opts ...grpc.CallOption) (*QueryNodeResponse, error) {
+	out := new(QueryNodeResponse)
+	err := c.cc.Invoke(ctx, "/sentinel.vpn.v1.QueryService/Node", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) Nodes(ctx context.Context, in *QueryNodesRequest, opts ...grpc.CallOption) (*QueryNodesResponse, error) {
+	out := new(QueryNodesResponse)
+	err := c.cc.Invoke(ctx, "/sentinel.vpn.v1.QueryService/Nodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) Subscription(ctx context.Context, in *QuerySubscriptionRequest, opts ...grpc.CallOption) (*QuerySubscriptionResponse, error) {
+	out := new(QuerySubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/sentinel.vpn.v1.QueryService/Subscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) Subscriptions(ctx context.Context, in *QuerySubscriptionsRequest, opts ...grpc.CallOption) (*QuerySubscriptionsResponse, error) {
+	out := new(QuerySubscriptionsResponse)
+	err := c.cc.Invoke(ctx, "/sentinel.vpn.v1.QueryService/Subscriptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) Session(ctx context.Context, in *QuerySessionRequest, opts ...grpc.CallOption) (*QuerySessionResponse, error) {
+	out := new(QuerySessionResponse)
+	err := c.cc.Invoke(ctx, "/sentinel.vpn.v1.QueryService/Session", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) Sessions(ctx context.Context, in *QuerySessionsRequest, opts ...grpc.CallOption) (*QuerySessionsResponse, error) {
+	out := new(QuerySessionsResponse)
+	err := c.cc.Invoke(ctx, "/sentinel.vpn.v1.QueryService/Sessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) Resolver(ctx context.Context, in *QueryResolverRequest, opts ...grpc.CallOption) (*QueryResolverResponse, error) {
+	out := new(QueryResolverResponse)
+	err := c.cc.Invoke(ctx, "/sentinel.vpn.v1.QueryService/Resolver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) Resolvers(ctx context.Context, in *QueryResolversRequest, opts ...grpc.CallOption) (*QueryResolversResponse, error) {
+	out := new(QueryResolversResponse)
+	err := c.cc.Invoke(ctx, "/sentinel.vpn.v1.QueryService/Resolvers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) FreeClient(ctx context.Context, in *QueryFreeClientRequest, opts ...grpc.CallOption) (*QueryFreeClientResponse, error) {
+	out := new(QueryFreeClientResponse)
+	err := c.cc.Invoke(ctx, "/sentinel.vpn.v1.QueryService/FreeClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) FreeClients(ctx context.Context, in *QueryFreeClientsRequest, opts ...grpc.CallOption) (*QueryFreeClientsResponse, error) {
+	out := new(QueryFreeClientsResponse)
+	err := c.cc.Invoke(ctx, "/sentinel.vpn.v1.QueryService/FreeClients", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/sentinel.vpn.v1.QueryService/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServiceServer is the server API for QueryService service.
+type QueryServiceServer interface {
+	Node(context.Context, *QueryNodeRequest) (*QueryNodeResponse, error)
+	Nodes(context.Context, *QueryNodesRequest) (*QueryNodesResponse, error)
+	Subscription(context.Context, *QuerySubscriptionRequest) (*QuerySubscriptionResponse, error)
+	Subscriptions(context.Context, *QuerySubscriptionsRequest) (*QuerySubscriptionsResponse, error)
+	Session(context.Context, *QuerySessionRequest) (*QuerySessionResponse, error)
+	Sessions(context.Context, *QuerySessionsRequest) (*QuerySessionsResponse, error)
+	Resolver(context.Context, *QueryResolverRequest) (*QueryResolverResponse, error)
+	Resolvers(context.Context, *QueryResolversRequest) (*QueryResolversResponse, error)
+	FreeClient(context.Context, *QueryFreeClientRequest) (*QueryFreeClientResponse, error)
+	FreeClients(context.Context, *QueryFreeClientsRequest) (*QueryFreeClientsResponse, error)
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQueryServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServiceServer struct {
+}
+
+func (*UnimplementedQueryServiceServer) Node(ctx context.Context, req *QueryNodeRequest) (*QueryNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Node not implemented")
+}
+func (*UnimplementedQueryServiceServer) Nodes(ctx context.Context, req *QueryNodesRequest) (*QueryNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Nodes not implemented")
+}
+func (*UnimplementedQueryServiceServer) Subscription(ctx context.Context, req *QuerySubscriptionRequest) (*QuerySubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Subscription not implemented")
+}
+func (*UnimplementedQueryServiceServer) Subscriptions(ctx context.Context, req *QuerySubscriptionsRequest) (*QuerySubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Subscriptions not implemented")
+}
+func (*UnimplementedQueryServiceServer) Session(ctx context.Context, req *QuerySessionRequest) (*QuerySessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Session not implemented")
+}
+func (*UnimplementedQueryServiceServer) Sessions(ctx context.Context, req *QuerySessionsRequest) (*QuerySessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sessions not implemented")
+}
+func (*UnimplementedQueryServiceServer) Resolver(ctx context.Context, req *QueryResolverRequest) (*QueryResolverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resolver not implemented")
+}
+func (*UnimplementedQueryServiceServer) Resolvers(ctx context.Context, req *QueryResolversRequest) (*QueryResolversResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resolvers not implemented")
+}
+func (*UnimplementedQueryServiceServer) FreeClient(ctx context.Context, req *QueryFreeClientRequest) (*QueryFreeClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreeClient not implemented")
+}
+func (*UnimplementedQueryServiceServer) FreeClients(ctx context.Context, req *QueryFreeClientsRequest) (*QueryFreeClientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreeClients not implemented")
+}
+func (*UnimplementedQueryServiceServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterQueryServiceServer(s *grpc.Server, srv QueryServiceServer) {
+	s.RegisterService(&_QueryService_serviceDesc, srv)
+}
+
+func _QueryService_Node_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Node(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sentinel.vpn.v1.QueryService/Node",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Node(ctx, req.(*QueryNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_Nodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Nodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sentinel.vpn.v1.QueryService/Nodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Nodes(ctx, req.(*QueryNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_Subscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Subscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sentinel.vpn.v1.QueryService/Subscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Subscription(ctx, req.(*QuerySubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_Subscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Subscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sentinel.vpn.v1.QueryService/Subscriptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Subscriptions(ctx, req.(*QuerySubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_Session_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Session(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sentinel.vpn.v1.QueryService/Session",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Session(ctx, req.(*QuerySessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_Sessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Sessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sentinel.vpn.v1.QueryService/Sessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Sessions(ctx, req.(*QuerySessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_Resolver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResolverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Resolver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sentinel.vpn.v1.QueryService/Resolver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Resolver(ctx, req.(*QueryResolverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_Resolvers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResolversRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Resolvers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sentinel.vpn.v1.QueryService/Resolvers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Resolvers(ctx, req.(*QueryResolversRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_FreeClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFreeClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).FreeClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sentinel.vpn.v1.QueryService/FreeClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).FreeClient(ctx, req.(*QueryFreeClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_FreeClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFreeClientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).FreeClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sentinel.vpn.v1.QueryService/FreeClients",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).FreeClients(ctx, req.(*QueryFreeClientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sentinel.vpn.v1.QueryService/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _QueryService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sentinel.vpn.v1.QueryService",
+	HandlerType: (*QueryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Node",
+			Handler:    _QueryService_Node_Handler,
+		},
+		{
+			MethodName: "Nodes",
+			Handler:    _QueryService_Nodes_Handler,
+		},
+		{
+			MethodName: "Subscription",
+			Handler:    _QueryService_Subscription_Handler,
+		},
+		{
+			MethodName: "Subscriptions",
+			Handler:    _QueryService_Subscriptions_Handler,
+		},
+		{
+			MethodName: "Session",
+			Handler:    _QueryService_Session_Handler,
+		},
+		{
+			MethodName: "Sessions",
+			Handler:    _QueryService_Sessions_Handler,
+		},
+		{
+			MethodName: "Resolver",
+			Handler:    _QueryService_Resolver_Handler,
+		},
+		{
+			MethodName: "Resolvers",
+			Handler:    _QueryService_Resolvers_Handler,
+		},
+		{
+			MethodName: "FreeClient",
+			Handler:    _QueryService_FreeClient_Handler,
+		},
+		{
+			MethodName: "FreeClients",
+			Handler:    _QueryService_FreeClients_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _QueryService_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sentinel/vpn/v1/query.proto",
+}
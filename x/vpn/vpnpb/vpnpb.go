@@ -0,0 +1,142 @@
+package vpnpb
+
+import (
+	"time"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	
+	"github.com/sentinel-official/hub/types/hubpb"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func newDec(d sdk.Dec) string {
+	if d == (sdk.Dec{}) {
+		return ""
+	}
+	
+	return d.String()
+}
+
+func newDuration(d time.Duration) *duration.Duration {
+	return ptypes.DurationProto(d)
+}
+
+func newTimestamp(t time.Time) *timestamp.Timestamp {
+	ts, err := ptypes.TimestampProto(t)
+	if err != nil {
+		return nil
+	}
+	
+	return ts
+}
+
+func NewPlan(plan types.Plan) *Plan {
+	return &Plan{
+		Type:                  plan.Type,
+		Price:                 hubpb.NewCoin(plan.Price),
+		Bandwidth:             hubpb.NewBandwidth(plan.Bandwidth),
+		Duration:              newDuration(plan.Duration),
+		MaxConcurrentSessions: plan.MaxConcurrentSessions,
+	}
+}
+
+func NewNode(node types.Node) *Node {
+	plans := make([]*Plan, 0, len(node.Plans))
+	for _, plan := range node.Plans {
+		plans = append(plans, NewPlan(plan))
+	}
+	
+	return &Node{
+		Id:                      node.ID.String(),
+		Owner:                   node.Owner.String(),
+		Deposit:                 hubpb.NewCoin(node.Deposit),
+		Type:                    node.Type,
+		Version:                 node.Version,
+		Moniker:                 node.Moniker,
+		PricesPerGb:             hubpb.NewCoins(node.PricesPerGB),
+		Plans:                   plans,
+		InternetSpeed:           hubpb.NewBandwidth(node.InternetSpeed),
+		Encryption:              node.Encryption,
+		Jailed:                  node.Jailed,
+		Blacklisted:             node.Blacklisted,
+		Status:                  node.Status,
+		StatusModifiedAt:        node.StatusModifiedAt,
+		UnbondingCompletionTime: newTimestamp(node.UnbondingCompletionTime),
+	}
+}
+
+func NewSubscription(subscription types.Subscription) *Subscription {
+	return &Subscription{
+		Id:                 subscription.ID.String(),
+		ResolverId:         subscription.ResolverID.String(),
+		NodeId:             subscription.NodeID.String(),
+		Client:             subscription.Client.String(),
+		PricePerGb:         hubpb.NewCoin(subscription.PricePerGB),
+		Commission:         newDec(subscription.Commission),
+		Plan:               NewPlan(subscription.Plan),
+		ExpiresAt:          newTimestamp(subscription.ExpiresAt),
+		TotalDeposit:       hubpb.NewCoin(subscription.TotalDeposit),
+		RemainingDeposit:   hubpb.NewCoin(subscription.RemainingDeposit),
+		RemainingBandwidth: hubpb.NewBandwidth(subscription.RemainingBandwidth),
+		Status:             subscription.Status,
+		StatusModifiedAt:   subscription.StatusModifiedAt,
+	}
+}
+
+func NewSession(session types.Session) *Session {
+	return &Session{
+		Id:               session.ID.String(),
+		SubscriptionId:   session.SubscriptionID.String(),
+		Bandwidth:        hubpb.NewBandwidth(session.Bandwidth),
+		Status:           session.Status,
+		StatusModifiedAt: session.StatusModifiedAt,
+	}
+}
+
+func NewResolver(resolver types.Resolver) *Resolver {
+	return &Resolver{
+		Id:                      resolver.ID.String(),
+		Owner:                   resolver.Owner.String(),
+		Moniker:                 resolver.Moniker,
+		Endpoint:                resolver.Endpoint,
+		Regions:                 resolver.Regions,
+		Deposit:                 hubpb.NewCoin(resolver.Deposit),
+		Commission:              newDec(resolver.Commission),
+		MaxCommissionRate:       newDec(resolver.MaxCommissionRate),
+		MaxCommissionChangeRate: newDec(resolver.MaxCommissionChangeRate),
+		CommissionUpdatedAt:     resolver.CommissionUpdatedAt,
+		Blacklisted:             resolver.Blacklisted,
+		Status:                  resolver.Status,
+		StatusModifiedAt:        resolver.StatusModifiedAt,
+		UnbondingCompletionTime: newTimestamp(resolver.UnbondingCompletionTime),
+	}
+}
+
+func NewFreeClient(freeClient types.FreeClient) *FreeClient {
+	return &FreeClient{
+		NodeId:            freeClient.NodeID.String(),
+		Client:            freeClient.Client.String(),
+		Bandwidth:         hubpb.NewBandwidth(freeClient.Bandwidth),
+		ConsumedBandwidth: hubpb.NewBandwidth(freeClient.ConsumedBandwidth),
+		ExpiresAt:         freeClient.ExpiresAt,
+		MaxSubscriptions:  freeClient.MaxSubscriptions,
+	}
+}
+
+func NewParams(params types.Params) *Params {
+	return &Params{
+		FreeNodesCount:           params.FreeNodesCount,
+		Deposit:                  hubpb.NewCoin(params.Deposit),
+		SessionInactiveInterval:  params.SessionInactiveInterval,
+		NodeInactiveInterval:     params.NodeInactiveInterval,
+		SlashFraction:            newDec(params.SlashFraction),
+		NodeUnbondingPeriod:      newDuration(params.NodeUnbondingPeriod),
+		ResolverUnbondingPeriod:  newDuration(params.ResolverUnbondingPeriod),
+		MaxPricesPerGb:           hubpb.NewCoins(params.MaxPricesPerGB),
+		ResolverMinDeposit:       hubpb.NewCoin(params.ResolverMinDeposit),
+		CommissionChangeInterval: params.CommissionChangeInterval,
+	}
+}